@@ -47,3 +47,9 @@ var JobModifiedConcurrently = api.Error{
 	Logref:  "dc00b05825b44934afeb9454f42a6440",
 	Message: "Job was modified concurrently",
 }
+
+var WorkflowInUse = api.Error{
+	Code:    "wfx.workflowInUse",
+	Logref:  "32f166da569db88f05c21618bc295d6b",
+	Message: "Workflow is used by jobs in a non-final state",
+}
@@ -82,6 +82,10 @@ func (jq JQFilter) VisitGetJobsEventsResponse(w http.ResponseWriter) error {
 	return applyFilter(w, jq.body, jq.filter)
 }
 
+func (jq JQFilter) VisitGetJobsStatsResponse(w http.ResponseWriter) error {
+	return applyFilter(w, jq.body, jq.filter)
+}
+
 func (jq JQFilter) VisitDeleteJobsIdResponse(w http.ResponseWriter) error {
 	return applyFilter(w, jq.body, jq.filter)
 }
@@ -137,3 +141,11 @@ func (jq JQFilter) VisitDeleteWorkflowsNameResponse(w http.ResponseWriter) error
 func (jq JQFilter) VisitGetWorkflowsNameResponse(w http.ResponseWriter) error {
 	return applyFilter(w, jq.body, jq.filter)
 }
+
+func (jq JQFilter) VisitPutWorkflowsNameResponse(w http.ResponseWriter) error {
+	return applyFilter(w, jq.body, jq.filter)
+}
+
+func (jq JQFilter) VisitGetWorkflowsNameStatsResponse(w http.ResponseWriter) error {
+	return applyFilter(w, jq.body, jq.filter)
+}
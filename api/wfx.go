@@ -31,11 +31,14 @@ import (
 	"github.com/siemens/wfx/internal/handler/workflow"
 	"github.com/siemens/wfx/middleware/logging"
 	"github.com/siemens/wfx/middleware/sse"
+	"github.com/siemens/wfx/middleware/ws"
 	"github.com/siemens/wfx/persistence"
 )
 
 const (
 	defaultPageLimit = 10
+	// default length of a time interval when bucketing job stats
+	defaultStatsInterval = 24 * time.Hour
 )
 
 type contextKey string
@@ -53,10 +56,32 @@ type WfxServer struct {
 type SSEOpts struct {
 	PingInterval  time.Duration
 	GraceInterval time.Duration
+	// WSOrigins are the origins (host patterns) from which browsers may open WebSocket connections in addition to
+	// the same origin.
+	WSOrigins []string
 }
 
 func NewWfxServer(storage persistence.Storage) *WfxServer {
-	checker := health.NewChecker(
+	wfx := &WfxServer{
+		storage: storage,
+		checker: newChecker(storage),
+		sseOpts: SSEOpts{
+			PingInterval:  config.DefaultSSEPingInterval,
+			GraceInterval: config.DefaultSSEGraceInterval,
+		},
+	}
+	return wfx
+}
+
+// WithHealthChecks adds checks which are reported in addition to the persistence check.
+// It must be called before Start.
+func (server *WfxServer) WithHealthChecks(checks ...health.Check) *WfxServer {
+	server.checker = newChecker(server.storage, checks...)
+	return server
+}
+
+func newChecker(storage persistence.Storage, checks ...health.Check) health.Checker {
+	return health.NewChecker(
 		health.WithTimeout(10*time.Second),
 		health.WithPeriodicCheck(30*time.Second, 0, health.Check{
 			Name: "persistence",
@@ -64,18 +89,10 @@ func NewWfxServer(storage persistence.Storage) *WfxServer {
 				return fault.Wrap(storage.CheckHealth(ctx))
 			},
 		}),
+		health.WithChecks(checks...),
 		health.WithStatusListener(healthStatusListener),
 		health.WithDisabledAutostart(),
 	)
-	wfx := &WfxServer{
-		storage: storage,
-		checker: checker,
-		sseOpts: SSEOpts{
-			PingInterval:  config.DefaultSSEPingInterval,
-			GraceInterval: config.DefaultSSEGraceInterval,
-		},
-	}
-	return wfx
 }
 
 func (server *WfxServer) WithSSEOpts(sseOpts SSEOpts) *WfxServer {
@@ -147,6 +164,51 @@ func (server WfxServer) GetJobs(ctx context.Context, request api.GetJobsRequestO
 	return api.GetJobs200JSONResponse(*jobs), nil
 }
 
+func (server WfxServer) GetJobsStats(ctx context.Context, request api.GetJobsStatsRequestObject) (api.GetJobsStatsResponseObject, error) {
+	filter := persistence.FilterParams{
+		ClientID: request.Params.ParamClientID,
+		State:    request.Params.ParamState,
+		Workflow: request.Params.ParamWorkflow,
+	}
+	if request.Params.ParamGroup != nil {
+		filter.Group = *request.Params.ParamGroup
+	}
+	if request.Params.ParamTag != nil {
+		filter.Tags = *request.Params.ParamTag
+	}
+
+	var statsParams persistence.StatsParams
+	if request.Params.ParamGroupBy != nil {
+		statsParams.GroupBy = *request.Params.ParamGroupBy
+	}
+	if request.Params.ParamBucket != nil {
+		interval := int64(defaultStatsInterval / time.Second)
+		if request.Params.ParamInterval != nil {
+			interval = *request.Params.ParamInterval
+		}
+		statsParams.Bucket = &persistence.TimeBucket{
+			Field:    *request.Params.ParamBucket,
+			Interval: time.Duration(interval) * time.Second,
+		}
+	}
+
+	stats, err := job.JobStats(ctx, server.storage, filter, statsParams)
+	if err != nil {
+		if ftag.Get(err) == ftag.InvalidArgument {
+			err2 := InvalidRequest
+			err2.Message = err.Error()
+			return api.GetJobsStats400JSONResponse(api.ErrorResponse{
+				Errors: &[]api.Error{err2},
+			}), nil
+		}
+		return nil, fault.Wrap(err)
+	}
+	if request.Params.XResponseFilter != nil {
+		return NewJQFilter(*request.Params.XResponseFilter, *stats), nil
+	}
+	return api.GetJobsStats200JSONResponse(*stats), nil
+}
+
 func (server WfxServer) PostJobs(ctx context.Context, request api.PostJobsRequestObject) (api.PostJobsResponseObject, error) {
 	job, err := job.CreateJob(ctx, server.storage, request.Body)
 	if err != nil {
@@ -168,17 +230,34 @@ func (server WfxServer) PostJobs(ctx context.Context, request api.PostJobsReques
 }
 
 func (server WfxServer) GetJobsEvents(ctx context.Context, request api.GetJobsEventsRequestObject) (api.GetJobsEventsResponseObject, error) {
+	subscriber, ok := server.addSubscriber(ctx, request.Params)
+	if !ok {
+		return api.GetJobsEvents400JSONResponse{Errors: &[]api.Error{InvalidRequest}}, nil
+	}
+	return sse.NewResponder(ctx, server.sseOpts.PingInterval, subscriber), nil
+}
+
+func (server WfxServer) GetJobsEventsWs(ctx context.Context, request api.GetJobsEventsWsRequestObject) (api.GetJobsEventsWsResponseObject, error) {
+	subscriber, ok := server.addSubscriber(ctx, api.GetJobsEventsParams(request.Params))
+	if !ok {
+		return api.GetJobsEventsWs400JSONResponse{Errors: &[]api.Error{InvalidRequest}}, nil
+	}
+	return ws.NewResponder(ctx, server.sseOpts.PingInterval, server.sseOpts.WSOrigins, subscriber), nil
+}
+
+// addSubscriber subscribes to the job events matching the params. It returns false if the params are invalid.
+func (server WfxServer) addSubscriber(ctx context.Context, params api.GetJobsEventsParams) (*events.Subscriber, bool) {
 	var filter events.FilterParams
-	if ids := request.Params.JobIds; ids != nil {
+	if ids := params.JobIds; ids != nil {
 		filter.JobIDs = strings.Split(*ids, ",")
 	}
-	if ids := request.Params.ClientIDs; ids != nil {
+	if ids := params.ClientIDs; ids != nil {
 		filter.ClientIDs = strings.Split(*ids, ",")
 	}
-	if wfs := request.Params.Workflows; wfs != nil {
+	if wfs := params.Workflows; wfs != nil {
 		filter.Workflows = strings.Split(*wfs, ",")
 	}
-	if s := request.Params.Actions; s != nil {
+	if s := params.Actions; s != nil {
 		filter.Actions = make([]events.Action, 0)
 		for action := range strings.SplitSeq(*s, ",") {
 			action = strings.ToUpper(action)
@@ -196,17 +275,16 @@ func (server WfxServer) GetJobsEvents(ctx context.Context, request api.GetJobsEv
 			case string(events.ActionUpdateDefinition):
 				filter.Actions = append(filter.Actions, events.ActionUpdateDefinition)
 			default:
-				return api.GetJobsEvents400JSONResponse{Errors: &[]api.Error{InvalidRequest}}, nil
+				return nil, false
 			}
 		}
 	}
 
 	var tags []string
-	if s := request.Params.Tags; s != nil {
+	if s := params.Tags; s != nil {
 		tags = strings.Split(*s, ",")
 	}
-	subscriber := events.AddSubscriber(ctx, server.sseOpts.GraceInterval, filter, tags)
-	return sse.NewResponder(ctx, server.sseOpts.PingInterval, subscriber), nil
+	return events.AddSubscriber(ctx, server.sseOpts.GraceInterval, filter, tags), true
 }
 
 func (server WfxServer) DeleteJobsId(ctx context.Context, request api.DeleteJobsIdRequestObject) (api.DeleteJobsIdResponseObject, error) {
@@ -494,6 +572,50 @@ func (server WfxServer) DeleteWorkflowsName(ctx context.Context, request api.Del
 	return api.DeleteWorkflowsName204Response{}, nil
 }
 
+func (server WfxServer) PutWorkflowsName(ctx context.Context, request api.PutWorkflowsNameRequestObject) (api.PutWorkflowsNameResponseObject, error) {
+	if request.Body.Name != request.Name {
+		err := InvalidRequest
+		err.Message = fmt.Sprintf("Workflow name '%s' does not match '%s'", request.Body.Name, request.Name)
+		return api.PutWorkflowsName400JSONResponse(api.ErrorResponse{
+			Errors: &[]api.Error{err},
+		}), nil
+	}
+	force := false
+	if request.Params.ParamForce != nil {
+		force = *request.Params.ParamForce
+	}
+
+	wf, err := workflow.UpdateWorkflow(ctx, server.storage, request.Body, force)
+	if err != nil {
+		switch ftag.Get(err) {
+		case ftag.InvalidArgument:
+			err2 := WorkflowInvalid
+			err2.Message = err.Error()
+			return api.PutWorkflowsName400JSONResponse(api.ErrorResponse{
+				Errors: &[]api.Error{err2},
+			}), nil
+		case ftag.NotFound:
+			err2 := WorkflowNotFound
+			err2.Message = fmt.Sprintf("Workflow '%s' not found", request.Name)
+			return api.PutWorkflowsName404JSONResponse(api.ErrorResponse{
+				Errors: &[]api.Error{err2},
+			}), nil
+		case errkind.Conflict:
+			err2 := WorkflowInUse
+			err2.Message = err.Error()
+			return api.PutWorkflowsName409JSONResponse(api.ErrorResponse{
+				Errors: &[]api.Error{err2},
+			}), nil
+		default:
+			return nil, fault.Wrap(err)
+		}
+	}
+	if request.Params.XResponseFilter != nil {
+		return NewJQFilter(*request.Params.XResponseFilter, *wf), nil
+	}
+	return api.PutWorkflowsName200JSONResponse(*wf), nil
+}
+
 func (server WfxServer) GetWorkflowsName(ctx context.Context, request api.GetWorkflowsNameRequestObject) (api.GetWorkflowsNameResponseObject, error) {
 	workflow, err := workflow.GetWorkflow(ctx, server.storage, request.Name)
 	if err != nil {
@@ -510,6 +632,37 @@ func (server WfxServer) GetWorkflowsName(ctx context.Context, request api.GetWor
 	return api.GetWorkflowsName200JSONResponse(*workflow), nil
 }
 
+func (server WfxServer) GetWorkflowsNameStats(ctx context.Context, request api.GetWorkflowsNameStatsRequestObject) (api.GetWorkflowsNameStatsResponseObject, error) {
+	params := persistence.WorkflowStatsParams{
+		Since: request.Params.ParamSince,
+		Until: request.Params.ParamUntil,
+	}
+	if request.Params.ParamTag != nil {
+		params.Tags = *request.Params.ParamTag
+	}
+	if params.Since != nil && params.Until != nil && params.Until.Before(*params.Since) {
+		err := InvalidRequest
+		err.Message = "until must not be before since"
+		return api.GetWorkflowsNameStats400JSONResponse(api.ErrorResponse{
+			Errors: &[]api.Error{err},
+		}), nil
+	}
+
+	stats, err := workflow.WorkflowStats(ctx, server.storage, request.Name, params)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			return api.GetWorkflowsNameStats404JSONResponse(api.ErrorResponse{
+				Errors: &[]api.Error{WorkflowNotFound},
+			}), nil
+		}
+		return nil, fault.Wrap(err)
+	}
+	if request.Params.XResponseFilter != nil {
+		return NewJQFilter(*request.Params.XResponseFilter, *stats), nil
+	}
+	return api.GetWorkflowsNameStats200JSONResponse(*stats), nil
+}
+
 func (server WfxServer) GetHealth(ctx context.Context, _ api.GetHealthRequestObject) (api.GetHealthResponseObject, error) {
 	result := server.checker.Check(ctx)
 	details := make(map[string]api.CheckResult, len(result.Details))
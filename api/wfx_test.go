@@ -10,16 +10,19 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/alexliesenfeld/health"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/persistence/entgo"
 	"github.com/siemens/wfx/persistence"
+	"github.com/siemens/wfx/workflow/dau"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -59,6 +62,122 @@ func TestGetJobsEvents(t *testing.T) {
 	assert.NotNil(t, response)
 }
 
+func TestGetJobsStats(t *testing.T) {
+	db := newSQLiteStorage(t)
+	wfx := NewWfxServer(db)
+
+	wf, err := db.CreateWorkflow(t.Context(), dau.DirectWorkflow())
+	require.NoError(t, err)
+	_, err = db.CreateJob(t.Context(), &api.Job{ClientID: "foo", Workflow: wf, Status: &api.JobStatus{State: "INSTALL"}})
+	require.NoError(t, err)
+
+	groupBy := []api.JobStatsProperty{api.JobStatsPropertyClientId}
+	bucket := api.Mtime
+	response, err := wfx.GetJobsStats(t.Context(), api.GetJobsStatsRequestObject{
+		Params: api.GetJobsStatsParams{ParamGroupBy: &groupBy, ParamBucket: &bucket},
+	})
+	require.NoError(t, err)
+	stats, ok := response.(api.GetJobsStats200JSONResponse)
+	require.True(t, ok)
+	require.Len(t, stats.Content, 1)
+	assert.Equal(t, "foo", *stats.Content[0].ClientID)
+	assert.Equal(t, int64(1), stats.Content[0].Count)
+	// default interval is one day
+	assert.Equal(t, stats.Content[0].Bucket.Truncate(24*time.Hour), *stats.Content[0].Bucket)
+}
+
+func TestGetJobsStats_InvalidInterval(t *testing.T) {
+	wfx := NewWfxServer(newSQLiteStorage(t))
+	bucket := api.Stime
+	var interval int64
+	response, err := wfx.GetJobsStats(t.Context(), api.GetJobsStatsRequestObject{
+		Params: api.GetJobsStatsParams{ParamBucket: &bucket, ParamInterval: &interval},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, api.GetJobsStats400JSONResponse{}, response)
+}
+
+func TestGetWorkflowsNameStats(t *testing.T) {
+	db := newSQLiteStorage(t)
+	wfx := NewWfxServer(db)
+
+	wf, err := db.CreateWorkflow(t.Context(), dau.DirectWorkflow())
+	require.NoError(t, err)
+	job, err := db.CreateJob(t.Context(), &api.Job{ClientID: "foo", Workflow: wf, Status: &api.JobStatus{State: "INSTALL"}})
+	require.NoError(t, err)
+	_, err = db.UpdateJob(t.Context(), job, persistence.JobUpdate{Status: &api.JobStatus{State: "INSTALLING"}})
+	require.NoError(t, err)
+
+	response, err := wfx.GetWorkflowsNameStats(t.Context(), api.GetWorkflowsNameStatsRequestObject{Name: wf.Name})
+	require.NoError(t, err)
+	stats, ok := response.(api.GetWorkflowsNameStats200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, int64(1), stats.Jobs)
+	assert.Equal(t, []api.TransitionStats{{From: "INSTALL", To: "INSTALLING", Count: 1}}, stats.Transitions)
+}
+
+func TestGetWorkflowsNameStats_NotFound(t *testing.T) {
+	wfx := NewWfxServer(newSQLiteStorage(t))
+	response, err := wfx.GetWorkflowsNameStats(t.Context(), api.GetWorkflowsNameStatsRequestObject{Name: "foo"})
+	require.NoError(t, err)
+	assert.IsType(t, api.GetWorkflowsNameStats404JSONResponse{}, response)
+}
+
+func TestGetWorkflowsNameStats_InvalidWindow(t *testing.T) {
+	wfx := NewWfxServer(newSQLiteStorage(t))
+	since := time.Now()
+	until := since.Add(-time.Hour)
+	response, err := wfx.GetWorkflowsNameStats(t.Context(), api.GetWorkflowsNameStatsRequestObject{
+		Name:   "foo",
+		Params: api.GetWorkflowsNameStatsParams{ParamSince: &since, ParamUntil: &until},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, api.GetWorkflowsNameStats400JSONResponse{}, response)
+}
+
+func TestPutWorkflowsName(t *testing.T) {
+	db := newSQLiteStorage(t)
+	wfx := NewWfxServer(db)
+
+	wf, err := db.CreateWorkflow(t.Context(), dau.DirectWorkflow())
+	require.NoError(t, err)
+	_, err = db.CreateJob(t.Context(), &api.Job{ClientID: "foo", Workflow: wf, Status: &api.JobStatus{State: "INSTALL"}})
+	require.NoError(t, err)
+
+	updated := dau.DirectWorkflow()
+	updated.Description = "updated"
+
+	response, err := wfx.PutWorkflowsName(t.Context(), api.PutWorkflowsNameRequestObject{Name: wf.Name, Body: updated})
+	require.NoError(t, err)
+	assert.IsType(t, api.PutWorkflowsName409JSONResponse{}, response)
+
+	force := true
+	response, err = wfx.PutWorkflowsName(t.Context(), api.PutWorkflowsNameRequestObject{
+		Name:   wf.Name,
+		Params: api.PutWorkflowsNameParams{ParamForce: &force},
+		Body:   updated,
+	})
+	require.NoError(t, err)
+	result, ok := response.(api.PutWorkflowsName200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, "updated", result.Description)
+}
+
+func TestPutWorkflowsName_NameMismatch(t *testing.T) {
+	wfx := NewWfxServer(newSQLiteStorage(t))
+	response, err := wfx.PutWorkflowsName(t.Context(), api.PutWorkflowsNameRequestObject{Name: "foo", Body: dau.DirectWorkflow()})
+	require.NoError(t, err)
+	assert.IsType(t, api.PutWorkflowsName400JSONResponse{}, response)
+}
+
+func TestPutWorkflowsName_NotFound(t *testing.T) {
+	wfx := NewWfxServer(newSQLiteStorage(t))
+	wf := dau.DirectWorkflow()
+	response, err := wfx.PutWorkflowsName(t.Context(), api.PutWorkflowsNameRequestObject{Name: wf.Name, Body: wf})
+	require.NoError(t, err)
+	assert.IsType(t, api.PutWorkflowsName404JSONResponse{}, response)
+}
+
 func TestPutJobsIdStatusConcurrent(t *testing.T) {
 	db := newSQLiteStorage(t)
 	wfx := NewWfxServer(db)
@@ -137,3 +256,21 @@ func newSQLiteStorage(t *testing.T) persistence.Storage {
 	t.Cleanup(db.Shutdown)
 	return db
 }
+
+func TestGetHealth_AdditionalChecks(t *testing.T) {
+	wfx := NewWfxServer(persistence.NewHealthyMockStorage(t)).
+		WithHealthChecks(health.Check{
+			Name:  "plugin foo",
+			Check: func(context.Context) error { return errors.New("plugin is unavailable") },
+		})
+	wfx.Start()
+	defer wfx.Stop()
+
+	response, err := wfx.GetHealth(t.Context(), api.GetHealthRequestObject{})
+	require.NoError(t, err)
+	body := response.(api.GetHealth503JSONResponse).Body
+	assert.Equal(t, api.Down, body.Status)
+	require.NotNil(t, body.Details)
+	assert.Equal(t, "plugin is unavailable", (*body.Details)["plugin foo"].Error)
+	assert.Contains(t, *body.Details, "persistence")
+}
@@ -10,6 +10,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -22,6 +23,8 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/siemens/wfx/cmd/wfx-viewer/output"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
 	"github.com/siemens/wfx/cmd/wfx/metadata"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/cmd/man"
@@ -31,8 +34,19 @@ import (
 const (
 	outputFlag       = "output"
 	outputFormatFlag = "output-format"
+	statsFlag        = "stats"
+	jobFlag          = "job"
+	jobIDFlag        = "job-id"
+	jobsFlag         = "jobs"
+	aggregateFlag    = "aggregate"
+	tagFlag          = "tag"
+	wfxURLFlag       = "wfx-url"
 )
 
+const defaultOutputFormat = "mermaid"
+
+const defaultWfxURL = "http://localhost:8081/api/wfx/v1"
+
 func init() {
 	rootCmd.Version = metadata.Version
 	rootCmd.AddCommand(man.NewCommand())
@@ -47,6 +61,13 @@ func init() {
 		zerolog.PanicLevel.String()))
 
 	f.String(outputFlag, "", "output file (default: stdout)")
+	f.String(statsFlag, "", "annotate transitions with the statistics from the given file (output of 'wfxctl workflow stats')")
+	f.String(jobFlag, "", "highlight the current state and history of the job from the given file (output of 'wfxctl job get --history')")
+	f.String(jobIDFlag, "", "highlight the current state and history of the job with the given ID (fetched from wfx)")
+	f.String(jobsFlag, "", "color states by the number of jobs from the given file (output of 'wfxctl job query')")
+	f.Bool(aggregateFlag, false, "color states by the number of jobs using the workflow (fetched from wfx)")
+	f.StringSlice(tagFlag, nil, "only count jobs having one of the given tags (used with --"+aggregateFlag+")")
+	f.String(wfxURLFlag, defaultWfxURL, "base URL of the wfx management API (used with --"+jobIDFlag+" and --"+aggregateFlag+")")
 
 	allFormats := make([]string, 0, len(output.Generators))
 	for format, gen := range output.Generators {
@@ -54,7 +75,7 @@ func init() {
 		gen.RegisterFlags(f)
 	}
 	sort.Strings(allFormats)
-	f.String(outputFormatFlag, allFormats[0], fmt.Sprintf("output format. possible values: %s", strings.Join(allFormats, ",")))
+	f.String(outputFormatFlag, defaultOutputFormat, fmt.Sprintf("output format. possible values: %s", strings.Join(allFormats, ",")))
 }
 
 var rootCmd = &cobra.Command{
@@ -62,11 +83,14 @@ var rootCmd = &cobra.Command{
 	Short: "Visualize workflows.",
 	Long: `Visualize workflows.
 
-Note: svg generation sends your workflow to a remote Kroki server.
+Note: --svg-renderer=kroki sends your workflow to a remote Kroki server.
 Do not use this for confidential information.
 `,
-	Example: "wfx-viewer --output-format svg --output wfx.workflow.dau.direct.svg wfx.workflow.dau.direct.yml",
-	Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	Example: `wfx-viewer --output-format svg --output wfx.workflow.dau.direct.svg wfx.workflow.dau.direct.yml
+wfx-viewer --output-format png --output wfx.workflow.dau.direct.png wfx.workflow.dau.direct.yml
+wfx-viewer --output-format svg --job-id 0a2a5e1c-7f2d-4b1e-9d5c-3c6b1d0e8f00 wfx.workflow.dau.direct.yml
+wfx-viewer --output-format mermaid --aggregate --tag canary wfx.workflow.dau.direct.yml`,
+	Args: cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
 	ValidArgsFunction: func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 		return []string{"yaml", "yml"}, cobra.ShellCompDirectiveFilterFileExt
 	},
@@ -134,6 +158,36 @@ Do not use this for confidential information.
 		if !ok {
 			return fmt.Errorf("unsupported output format: %s", format)
 		}
+		statsFile, err := f.GetString(statsFlag)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+		var workflowStats *stats.Stats
+		if statsFile != "" {
+			workflowStats, err = readFile(statsFile, stats.Load)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			if name := workflowStats.Workflow(); name != workflow.Name {
+				log.Warn().Str("expected", workflow.Name).Str("actual", name).Msg("Statistics belong to a different workflow")
+			}
+		}
+		if annotator, ok := gen.(output.Annotator); ok {
+			annotator.SetStats(workflowStats)
+		} else if workflowStats != nil {
+			log.Warn().Str("format", format).Msg("Output format does not support statistics")
+		}
+
+		jobOverlay, err := loadOverlay(cmd, &workflow)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+		if overlayer, ok := gen.(output.Overlayer); ok {
+			overlayer.SetOverlay(jobOverlay)
+		} else if jobOverlay != nil {
+			log.Warn().Str("format", format).Msg("Output format does not support highlighting jobs")
+		}
+
 		log.Debug().Msg("Generating output")
 		if err := gen.Generate(outWriter, &workflow); err != nil {
 			return fault.Wrap(err)
@@ -143,3 +197,84 @@ Do not use this for confidential information.
 		return nil
 	},
 }
+
+// loadOverlay creates the overlay requested by the flags. The result is nil if no overlay was requested.
+func loadOverlay(cmd *cobra.Command, workflow *api.Workflow) (*overlay.Overlay, error) {
+	f := cmd.PersistentFlags()
+	jobFile, _ := f.GetString(jobFlag)
+	jobID, _ := f.GetString(jobIDFlag)
+	jobsFile, _ := f.GetString(jobsFlag)
+	aggregate, _ := f.GetBool(aggregateFlag)
+
+	requested := 0
+	for _, ok := range []bool{jobFile != "", jobID != "", jobsFile != "", aggregate} {
+		if ok {
+			requested++
+		}
+	}
+	switch {
+	case requested == 0:
+		return nil, nil
+	case requested > 1:
+		return nil, fmt.Errorf("at most one of --%s, --%s, --%s and --%s may be given", jobFlag, jobIDFlag, jobsFlag, aggregateFlag)
+	}
+
+	var client *api.ClientWithResponses
+	if jobID != "" || aggregate {
+		wfxURL, _ := f.GetString(wfxURLFlag)
+		var err error
+		client, err = api.NewClientWithResponses(wfxURL)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+	}
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	switch {
+	case jobFile != "" || jobID != "":
+		var (
+			job *api.Job
+			err error
+		)
+		if jobFile != "" {
+			job, err = readFile(jobFile, overlay.LoadJob)
+		} else {
+			job, err = overlay.FetchJob(ctx, client, jobID)
+		}
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		if job.Workflow != nil && job.Workflow.Name != "" && job.Workflow.Name != workflow.Name {
+			log.Warn().Str("expected", workflow.Name).Str("actual", job.Workflow.Name).Msg("Job belongs to a different workflow")
+		}
+		return overlay.FromJob(workflow, job), nil
+	default:
+		var (
+			jobs []api.Job
+			err  error
+		)
+		if jobsFile != "" {
+			jobs, err = readFile(jobsFile, overlay.LoadJobs)
+		} else {
+			tags, _ := f.GetStringSlice(tagFlag)
+			jobs, err = overlay.QueryJobs(ctx, client, workflow.Name, tags)
+		}
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		return overlay.FromJobs(workflow, jobs), nil
+	}
+}
+
+func readFile[T any](fname string, parse func(io.Reader) (T, error)) (T, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		var zero T
+		return zero, fault.Wrap(err)
+	}
+	defer f.Close()
+	return parse(f)
+}
@@ -2,10 +2,15 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/goccy/go-yaml"
+	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/workflow/dau"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -115,3 +120,105 @@ endlegend
 `
 	assert.Equal(t, expected, actual)
 }
+
+func TestPlantUML_Stats(t *testing.T) {
+	f := rootCmd.PersistentFlags()
+	_ = f.Set(outputFlag, "")
+	_ = f.Set(outputFormatFlag, "plantuml")
+
+	statsFile, _ := os.CreateTemp(os.TempDir(), "TestPlantUML.*.json")
+	_, _ = statsFile.WriteString(`{"workflow":"wfx.workflow.dau.direct","jobs":1,"states":[],"transitions":[{"from":"INSTALL","to":"TERMINATED","count":1}]}`)
+	_ = statsFile.Close()
+	t.Cleanup(func() {
+		_ = os.Remove(statsFile.Name())
+		_ = f.Set(statsFlag, "")
+	})
+	_ = f.Set(statsFlag, statsFile.Name())
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+
+	tmpFile, _ := os.CreateTemp(os.TempDir(), "TestPlantUML.*")
+	b, _ := yaml.Marshal(dau.DirectWorkflow())
+	_, _ = tmpFile.Write(b)
+	_ = tmpFile.Close()
+	t.Cleanup(func() { _ = os.Remove(tmpFile.Name()) })
+
+	rootCmd.SetArgs([]string{tmpFile.Name()})
+	err := rootCmd.Execute()
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "INSTALL --> TERMINATED: CLIENT\\n1x\n")
+}
+
+func TestPlantUML_Job(t *testing.T) {
+	f := rootCmd.PersistentFlags()
+	_ = f.Set(outputFlag, "")
+	_ = f.Set(outputFormatFlag, "plantuml")
+
+	jobFile := filepath.Join(t.TempDir(), "job.json")
+	_ = os.WriteFile(jobFile, []byte(`{"status":{"state":"INSTALLING"},"history":[{"status":{"state":"INSTALL"}}]}`), 0o644)
+	t.Cleanup(func() { _ = f.Set(jobFlag, "") })
+	_ = f.Set(jobFlag, jobFile)
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+
+	wfFile := filepath.Join(t.TempDir(), "workflow.yml")
+	b, _ := yaml.Marshal(dau.DirectWorkflow())
+	_ = os.WriteFile(wfFile, b, 0o644)
+
+	rootCmd.SetArgs([]string{wfFile})
+	err := rootCmd.Execute()
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "INSTALL -[#red,bold]-> INSTALLING: CLIENT\\n#1\n")
+}
+
+func TestPlantUML_Aggregate(t *testing.T) {
+	f := rootCmd.PersistentFlags()
+	_ = f.Set(outputFlag, "")
+	_ = f.Set(outputFormatFlag, "plantuml")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/wfx/v1/jobs", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.PaginatedJobList{Content: []api.Job{{Status: &api.JobStatus{State: "ACTIVATED"}}}})
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	t.Cleanup(func() {
+		_ = f.Set(aggregateFlag, "false")
+		_ = f.Set(wfxURLFlag, defaultWfxURL)
+	})
+	_ = f.Set(aggregateFlag, "true")
+	_ = f.Set(wfxURLFlag, ts.URL+"/api/wfx/v1")
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+
+	wfFile := filepath.Join(t.TempDir(), "workflow.yml")
+	b, _ := yaml.Marshal(dau.DirectWorkflow())
+	_ = os.WriteFile(wfFile, b, 0o644)
+
+	rootCmd.SetArgs([]string{wfFile})
+	err := rootCmd.Execute()
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "ACTIVATED: <b>1 job(s)</b>\n")
+}
+
+func TestOverlay_Conflict(t *testing.T) {
+	f := rootCmd.PersistentFlags()
+	t.Cleanup(func() {
+		_ = f.Set(jobIDFlag, "")
+		_ = f.Set(aggregateFlag, "false")
+	})
+	_ = f.Set(jobIDFlag, "1")
+	_ = f.Set(aggregateFlag, "true")
+
+	wfFile := filepath.Join(t.TempDir(), "workflow.yml")
+	b, _ := yaml.Marshal(dau.DirectWorkflow())
+	_ = os.WriteFile(wfFile, b, 0o644)
+
+	err := rootCmd.RunE(rootCmd, []string{wfFile})
+	assert.ErrorContains(t, err, "at most one of")
+}
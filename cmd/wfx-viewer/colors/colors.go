@@ -122,3 +122,16 @@ func (cp *ColorPalette) StateToGroup(state string) *string {
 	}
 	return &group
 }
+
+// HeatColor maps a fraction in [0, 1] to a color between white (0) and red (1).
+// The foreground color is chosen to be readable on the background.
+func HeatColor(fraction float64) (string, string) {
+	fraction = min(max(fraction, 0), 1)
+	level := uint8(0xff * (1 - fraction))
+	c, _ := colors.RGBA(0xff, level, level, 1)
+	fgColor := "black"
+	if c.IsDark() {
+		fgColor = "white"
+	}
+	return fgColor, c.ToHEX().String()
+}
@@ -36,3 +36,18 @@ func TestStateColor_Fallback(t *testing.T) {
 	assert.Equal(t, DefaultFgColor, fg)
 	assert.Equal(t, DefaultBgColor, bg)
 }
+
+func TestHeatColor(t *testing.T) {
+	fg, bg := HeatColor(0)
+	assert.Equal(t, "black", fg)
+	assert.Equal(t, "#ffffff", bg)
+
+	_, bg = HeatColor(0.5)
+	assert.Equal(t, "#ff7f7f", bg)
+
+	_, bg = HeatColor(1)
+	assert.Equal(t, "#ff0000", bg)
+
+	_, bg = HeatColor(2)
+	assert.Equal(t, "#ff0000", bg)
+}
@@ -0,0 +1,501 @@
+package layout
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Spacing between the elements of the diagram.
+const (
+	Margin       = 20.0
+	NodeSep      = 30.0
+	LayerSep     = 60.0
+	ClusterSep   = 30.0
+	ClusterPad   = 15.0
+	ClusterLabel = 20.0
+	LoopWidth    = 20.0
+	dummyWidth   = 10.0
+	sweeps       = 8
+)
+
+// Point is a position in the diagram; the origin is the top left corner.
+type Point struct {
+	X, Y float64
+}
+
+// Size is the extent of an element.
+type Size struct {
+	Width, Height float64
+}
+
+// Node is a box in the diagram. X and Y denote its center.
+type Node struct {
+	ID    string
+	Group string
+	Size
+	X, Y  float64
+	Layer int
+
+	dummy bool
+	extra float64 // width needed right of the node for self-loops or an edge label
+	pos   int     // position within the layer
+	index int
+}
+
+// Edge connects two nodes. After the layout, Points contains the polyline from the source to the target
+// and LabelPos the center of the label.
+type Edge struct {
+	From, To string
+	Label    Size
+	Points   []Point
+	LabelPos Point
+
+	reversed bool
+	chain    []*Node
+}
+
+// SelfLoop reports whether the edge starts and ends at the same node.
+func (e *Edge) SelfLoop() bool {
+	return e.From == e.To
+}
+
+// Cluster is the bounding box of the nodes belonging to a group.
+type Cluster struct {
+	Name string
+	Point
+	Size
+}
+
+// Graph is a directed graph whose nodes are arranged in layers (Sugiyama-style):
+// cycles are broken, nodes are assigned to layers by their longest path from a source, edges spanning
+// several layers are split by dummy nodes, crossings are reduced with the barycenter heuristic and finally
+// coordinates are assigned. Nodes of the same group are placed in a common vertical band, so that the
+// clusters do not overlap.
+type Graph struct {
+	Nodes    []*Node
+	Edges    []*Edge
+	Clusters []Cluster
+	Size
+
+	byID   map[string]*Node
+	groups []string
+}
+
+func New() *Graph {
+	return &Graph{byID: make(map[string]*Node)}
+}
+
+// AddNode adds a node; group may be empty.
+func (g *Graph) AddNode(id, group string, size Size) *Node {
+	n := &Node{ID: id, Group: group, Size: size, index: len(g.Nodes)}
+	g.Nodes = append(g.Nodes, n)
+	g.byID[id] = n
+	if group != "" && !slices.Contains(g.groups, group) {
+		g.groups = append(g.groups, group)
+	}
+	return n
+}
+
+// AddEdge adds an edge between two existing nodes. Edges referring to unknown nodes are ignored by the layout.
+func (g *Graph) AddEdge(from, to string, label Size) *Edge {
+	e := &Edge{From: from, To: to, Label: label}
+	g.Edges = append(g.Edges, e)
+	return e
+}
+
+// Layout computes the coordinates of all nodes, edges and clusters.
+func (g *Graph) Layout() {
+	edges := g.validEdges()
+	g.breakCycles(edges)
+	layers := g.assignLayers(edges)
+	layers = g.insertDummies(edges, layers)
+	g.order(layers, edges)
+	g.assignCoordinates(layers)
+	g.routeEdges(edges)
+}
+
+func (g *Graph) validEdges() []*Edge {
+	result := make([]*Edge, 0, len(g.Edges))
+	for _, e := range g.Edges {
+		from, to := g.byID[e.From], g.byID[e.To]
+		if from == nil || to == nil {
+			continue
+		}
+		if e.SelfLoop() {
+			from.extra = max(from.extra, LoopWidth+e.Label.Width+4)
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// breakCycles reverses the back edges found by a depth-first search.
+func (g *Graph) breakCycles(edges []*Edge) {
+	out := make(map[*Node][]*Edge, len(g.Nodes))
+	for _, e := range edges {
+		from := g.byID[e.From]
+		out[from] = append(out[from], e)
+	}
+	const (
+		white = iota
+		gray
+		black
+	)
+	color := make(map[*Node]int, len(g.Nodes))
+	var visit func(n *Node)
+	visit = func(n *Node) {
+		color[n] = gray
+		for _, e := range out[n] {
+			to := g.byID[e.To]
+			switch color[to] {
+			case white:
+				visit(to)
+			case gray:
+				e.reversed = true
+			}
+		}
+		color[n] = black
+	}
+	for _, n := range g.Nodes {
+		if color[n] == white {
+			visit(n)
+		}
+	}
+}
+
+// source and target return the endpoints of an edge after cycle breaking.
+func (g *Graph) source(e *Edge) *Node {
+	if e.reversed {
+		return g.byID[e.To]
+	}
+	return g.byID[e.From]
+}
+
+func (g *Graph) target(e *Edge) *Node {
+	if e.reversed {
+		return g.byID[e.From]
+	}
+	return g.byID[e.To]
+}
+
+// assignLayers places each node one layer below its deepest predecessor.
+func (g *Graph) assignLayers(edges []*Edge) [][]*Node {
+	indegree := make(map[*Node]int, len(g.Nodes))
+	out := make(map[*Node][]*Node, len(g.Nodes))
+	for _, e := range edges {
+		src, dst := g.source(e), g.target(e)
+		out[src] = append(out[src], dst)
+		indegree[dst]++
+	}
+	queue := make([]*Node, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		n.Layer = 0
+		if indegree[n] == 0 {
+			queue = append(queue, n)
+		}
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, m := range out[n] {
+			m.Layer = max(m.Layer, n.Layer+1)
+			indegree[m]--
+			if indegree[m] == 0 {
+				queue = append(queue, m)
+			}
+		}
+	}
+	var layers [][]*Node
+	for _, n := range g.Nodes {
+		for len(layers) <= n.Layer {
+			layers = append(layers, nil)
+		}
+		layers[n.Layer] = append(layers[n.Layer], n)
+	}
+	return layers
+}
+
+// insertDummies splits edges spanning several layers, such that every segment connects adjacent layers.
+// The dummy nodes stay in the band of the source node, the middle one reserves space for the edge label.
+func (g *Graph) insertDummies(edges []*Edge, layers [][]*Node) [][]*Node {
+	for _, e := range edges {
+		src, dst := g.source(e), g.target(e)
+		e.chain = []*Node{src}
+		for layer := src.Layer + 1; layer < dst.Layer; layer++ {
+			d := &Node{dummy: true, Group: src.Group, Layer: layer, Size: Size{Width: dummyWidth}, index: len(layers[layer])}
+			layers[layer] = append(layers[layer], d)
+			e.chain = append(e.chain, d)
+		}
+		e.chain = append(e.chain, dst)
+		if len(e.chain) > 2 && e.Label.Width > 0 {
+			d := e.chain[len(e.chain)/2]
+			d.Height = e.Label.Height
+			d.extra = e.Label.Width + 4
+		}
+	}
+	return layers
+}
+
+// order reduces edge crossings using the barycenter heuristic. The bands (one per group and one for
+// ungrouped nodes) are ordered by the average position of their nodes in an unconstrained ordering first,
+// then nodes are ordered within their band.
+func (g *Graph) order(layers [][]*Node, edges []*Edge) {
+	upper := make(map[*Node][]*Node)
+	lower := make(map[*Node][]*Node)
+	for _, e := range edges {
+		for i := 1; i < len(e.chain); i++ {
+			a, b := e.chain[i-1], e.chain[i]
+			lower[a] = append(lower[a], b)
+			upper[b] = append(upper[b], a)
+		}
+	}
+	for _, layer := range layers {
+		for i, n := range layer {
+			n.pos = i
+		}
+	}
+
+	bandRank := map[string]int{}
+	g.sweep(layers, upper, lower, bandRank)
+
+	// rank the bands by the average relative position of their nodes
+	sum := map[string]float64{}
+	count := map[string]int{}
+	for _, layer := range layers {
+		for _, n := range layer {
+			band := n.Group
+			sum[band] += (float64(n.pos) + 0.5) / float64(len(layer))
+			count[band]++
+		}
+	}
+	bands := append([]string{""}, g.groups...)
+	slices.SortStableFunc(bands, func(a, b string) int {
+		return cmp.Compare(sum[a]/float64(max(count[a], 1)), sum[b]/float64(max(count[b], 1)))
+	})
+	for i, band := range bands {
+		bandRank[band] = i
+	}
+	g.sweep(layers, upper, lower, bandRank)
+}
+
+func (g *Graph) sweep(layers [][]*Node, upper, lower map[*Node][]*Node, bandRank map[string]int) {
+	sortLayer := func(layer []*Node, neighbors map[*Node][]*Node) {
+		bary := make(map[*Node]float64, len(layer))
+		for _, n := range layer {
+			adj := neighbors[n]
+			if len(adj) == 0 {
+				bary[n] = float64(n.pos)
+				continue
+			}
+			total := 0.0
+			for _, m := range adj {
+				total += float64(m.pos)
+			}
+			bary[n] = total / float64(len(adj))
+		}
+		slices.SortStableFunc(layer, func(a, b *Node) int {
+			return cmp.Or(
+				cmp.Compare(bandRank[a.Group], bandRank[b.Group]),
+				cmp.Compare(bary[a], bary[b]),
+				cmp.Compare(a.pos, b.pos),
+			)
+		})
+		for i, n := range layer {
+			n.pos = i
+		}
+	}
+	for range sweeps {
+		for i := 1; i < len(layers); i++ {
+			sortLayer(layers[i], upper)
+		}
+		for i := len(layers) - 2; i >= 0; i-- {
+			sortLayer(layers[i], lower)
+		}
+	}
+}
+
+// assignCoordinates places the bands next to each other and the nodes of each layer centered within their band.
+func (g *Graph) assignCoordinates(layers [][]*Node) {
+	type band struct {
+		name  string
+		x     float64
+		width float64
+	}
+	var bands []*band
+	byName := map[string]*band{}
+	rowWidth := func(layer []*Node, name string) float64 {
+		width := 0.0
+		for _, n := range layer {
+			if n.Group == name {
+				if width > 0 {
+					width += NodeSep
+				}
+				width += n.Width + n.extra
+			}
+		}
+		return width
+	}
+	for _, layer := range layers {
+		for _, n := range layer {
+			name := n.Group
+			if byName[name] == nil {
+				byName[name] = &band{name: name}
+				bands = append(bands, byName[name])
+			}
+		}
+	}
+	for _, b := range bands {
+		for _, layer := range layers {
+			b.width = max(b.width, rowWidth(layer, b.name))
+		}
+		if b.name != "" {
+			b.width += 2 * ClusterPad
+		}
+	}
+	// bands appear in the order of the first layer in which they occur, which follows the band rank
+	slices.SortStableFunc(bands, func(a, b *band) int {
+		return cmp.Compare(g.firstPos(layers, a.name), g.firstPos(layers, b.name))
+	})
+	x := Margin
+	for _, b := range bands {
+		b.x = x
+		x += b.width + ClusterSep
+	}
+	g.Width = x - ClusterSep + Margin
+
+	y := Margin
+	if len(g.groups) > 0 {
+		y += ClusterLabel + ClusterPad
+	}
+	for _, layer := range layers {
+		height := 0.0
+		for _, n := range layer {
+			height = max(height, n.Height)
+		}
+		for _, b := range bands {
+			cursor := b.x + (b.width-rowWidth(layer, b.name))/2
+			for _, n := range layer {
+				if n.Group != b.name {
+					continue
+				}
+				n.X = cursor + n.Width/2
+				n.Y = y + height/2
+				cursor += n.Width + n.extra + NodeSep
+			}
+		}
+		y += height + LayerSep
+	}
+	g.Height = y - LayerSep + Margin
+	if len(g.groups) > 0 {
+		g.Height += ClusterPad
+	}
+
+	g.Clusters = g.Clusters[:0]
+	for _, name := range g.groups {
+		b := byName[name]
+		if b == nil {
+			continue
+		}
+		top, bottom := g.Height, 0.0
+		for _, n := range g.Nodes {
+			if n.Group == name {
+				top = min(top, n.Y-n.Height/2)
+				bottom = max(bottom, n.Y+n.Height/2)
+			}
+		}
+		top -= ClusterPad + ClusterLabel
+		g.Clusters = append(g.Clusters, Cluster{
+			Name:  name,
+			Point: Point{X: b.x, Y: top},
+			Size:  Size{Width: b.width, Height: bottom + ClusterPad - top},
+		})
+	}
+}
+
+func (g *Graph) firstPos(layers [][]*Node, band string) float64 {
+	for _, layer := range layers {
+		for _, n := range layer {
+			if n.Group == band {
+				return float64(n.Layer*len(g.Nodes)*2) + float64(n.pos)
+			}
+		}
+	}
+	return 0
+}
+
+// routeEdges computes the polylines. Edges leaving (or entering) a node are spread along its bottom (or top) side.
+func (g *Graph) routeEdges(edges []*Edge) {
+	outgoing := map[*Node][]*Edge{}
+	incoming := map[*Node][]*Edge{}
+	for _, e := range edges {
+		outgoing[e.chain[0]] = append(outgoing[e.chain[0]], e)
+		last := e.chain[len(e.chain)-1]
+		incoming[last] = append(incoming[last], e)
+	}
+	ports := func(n *Node, list []*Edge, next func(e *Edge) *Node, y float64) map[*Edge]Point {
+		slices.SortStableFunc(list, func(a, b *Edge) int {
+			return cmp.Compare(next(a).X, next(b).X)
+		})
+		result := make(map[*Edge]Point, len(list))
+		for i, e := range list {
+			result[e] = Point{X: n.X - n.Width/2 + n.Width*float64(i+1)/float64(len(list)+1), Y: y}
+		}
+		return result
+	}
+	start := map[*Edge]Point{}
+	end := map[*Edge]Point{}
+	for n, list := range outgoing {
+		for e, p := range ports(n, list, func(e *Edge) *Node { return e.chain[1] }, n.Y+n.Height/2) {
+			start[e] = p
+		}
+	}
+	for n, list := range incoming {
+		for e, p := range ports(n, list, func(e *Edge) *Node { return e.chain[len(e.chain)-2] }, n.Y-n.Height/2) {
+			end[e] = p
+		}
+	}
+
+	for _, e := range edges {
+		points := []Point{start[e]}
+		for _, d := range e.chain[1 : len(e.chain)-1] {
+			points = append(points, Point{X: d.X, Y: d.Y})
+		}
+		points = append(points, end[e])
+		if e.reversed {
+			slices.Reverse(points)
+		}
+		e.Points = points
+		if len(e.chain) > 2 {
+			d := e.chain[len(e.chain)/2]
+			e.LabelPos = Point{X: d.X + d.Width/2 + 2 + e.Label.Width/2, Y: d.Y}
+		} else {
+			a, b := points[0], points[1]
+			e.LabelPos = Point{X: (a.X+b.X)/2 + e.Label.Width/2 + 4, Y: (a.Y + b.Y) / 2}
+		}
+	}
+
+	for _, e := range g.Edges {
+		n := g.byID[e.From]
+		if !e.SelfLoop() || n == nil {
+			continue
+		}
+		right := n.X + n.Width/2
+		dy := n.Height / 4
+		e.Points = []Point{
+			{X: right, Y: n.Y - dy},
+			{X: right + LoopWidth, Y: n.Y - dy},
+			{X: right + LoopWidth, Y: n.Y + dy},
+			{X: right, Y: n.Y + dy},
+		}
+		e.LabelPos = Point{X: right + LoopWidth + 4 + e.Label.Width/2, Y: n.Y}
+	}
+}
@@ -0,0 +1,114 @@
+package layout
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var box = Size{Width: 60, Height: 30}
+
+func overlaps(a, b *Node) bool {
+	return a.X-a.Width/2 < b.X+b.Width/2 && b.X-b.Width/2 < a.X+a.Width/2 &&
+		a.Y-a.Height/2 < b.Y+b.Height/2 && b.Y-b.Height/2 < a.Y+a.Height/2
+}
+
+func TestLayout_Chain(t *testing.T) {
+	g := New()
+	for _, id := range []string{"A", "B", "C"} {
+		g.AddNode(id, "", box)
+	}
+	ab := g.AddEdge("A", "B", Size{Width: 20, Height: 13})
+	bc := g.AddEdge("B", "C", Size{})
+	ac := g.AddEdge("A", "C", Size{})
+	g.Layout()
+
+	a, b, c := g.byID["A"], g.byID["B"], g.byID["C"]
+	assert.Equal(t, []int{0, 1, 2}, []int{a.Layer, b.Layer, c.Layer})
+	assert.Less(t, a.Y, b.Y)
+	assert.Less(t, b.Y, c.Y)
+
+	require.Len(t, ab.Points, 2)
+	assert.Equal(t, a.Y+a.Height/2, ab.Points[0].Y)
+	assert.Equal(t, b.Y-b.Height/2, ab.Points[1].Y)
+	assert.Len(t, bc.Points, 2)
+	// the long edge is routed via a dummy node in the middle layer
+	require.Len(t, ac.Points, 3)
+	assert.Equal(t, b.Y, ac.Points[1].Y)
+	assert.NotEqual(t, b.X, ac.Points[1].X)
+
+	for _, n := range g.Nodes {
+		assert.GreaterOrEqual(t, n.X-n.Width/2, Margin)
+		assert.LessOrEqual(t, n.X+n.Width/2, g.Width)
+		assert.LessOrEqual(t, n.Y+n.Height/2, g.Height)
+	}
+}
+
+func TestLayout_Cycle(t *testing.T) {
+	g := New()
+	g.AddNode("A", "", box)
+	g.AddNode("B", "", box)
+	forward := g.AddEdge("A", "B", Size{})
+	back := g.AddEdge("B", "A", Size{})
+	loop := g.AddEdge("B", "B", Size{Width: 30, Height: 13})
+	unknown := g.AddEdge("B", "X", Size{})
+	g.Layout()
+
+	a, b := g.byID["A"], g.byID["B"]
+	assert.Less(t, a.Y, b.Y)
+	// the back edge keeps its direction
+	assert.Equal(t, forward.Points[0].Y, back.Points[len(back.Points)-1].Y)
+	assert.Equal(t, forward.Points[len(forward.Points)-1].Y, back.Points[0].Y)
+
+	require.Len(t, loop.Points, 4)
+	assert.Equal(t, b.X+b.Width/2, loop.Points[0].X)
+	assert.Greater(t, loop.LabelPos.X, b.X+b.Width/2)
+	assert.LessOrEqual(t, loop.LabelPos.X+loop.Label.Width/2, g.Width)
+
+	assert.Empty(t, unknown.Points)
+}
+
+func TestLayout_Clusters(t *testing.T) {
+	g := New()
+	g.AddNode("start", "", box)
+	g.AddNode("A1", "A", box)
+	g.AddNode("A2", "A", box)
+	g.AddNode("B1", "B", box)
+	g.AddNode("B2", "B", box)
+	g.AddNode("end", "", box)
+	g.AddEdge("start", "A1", Size{})
+	g.AddEdge("start", "B1", Size{})
+	g.AddEdge("A1", "B2", Size{})
+	g.AddEdge("B1", "A2", Size{})
+	g.AddEdge("A2", "end", Size{})
+	g.AddEdge("B2", "end", Size{})
+	g.Layout()
+
+	for i, a := range g.Nodes {
+		for _, b := range g.Nodes[i+1:] {
+			assert.False(t, overlaps(a, b), "%s overlaps %s", a.ID, b.ID)
+		}
+	}
+
+	require.Len(t, g.Clusters, 2)
+	c1, c2 := g.Clusters[0], g.Clusters[1]
+	assert.Equal(t, "A", c1.Name)
+	assert.Equal(t, "B", c2.Name)
+	assert.True(t, c1.X+c1.Width <= c2.X || c2.X+c2.Width <= c1.X, "clusters overlap")
+	for _, c := range g.Clusters {
+		for _, n := range g.Nodes {
+			inside := n.X-n.Width/2 >= c.X && n.X+n.Width/2 <= c.X+c.Width &&
+				n.Y-n.Height/2 >= c.Y && n.Y+n.Height/2 <= c.Y+c.Height
+			assert.Equal(t, n.Group == c.Name, inside, "node %s, cluster %s", n.ID, c.Name)
+		}
+	}
+}
@@ -11,10 +11,16 @@ package output
 import (
 	"io"
 
+	"github.com/siemens/wfx/cmd/wfx-viewer/output/d2"
+	"github.com/siemens/wfx/cmd/wfx-viewer/output/dot"
+	"github.com/siemens/wfx/cmd/wfx-viewer/output/jsongraph"
 	"github.com/siemens/wfx/cmd/wfx-viewer/output/mermaid"
 	"github.com/siemens/wfx/cmd/wfx-viewer/output/plantuml"
+	"github.com/siemens/wfx/cmd/wfx-viewer/output/png"
 	"github.com/siemens/wfx/cmd/wfx-viewer/output/smcat"
 	"github.com/siemens/wfx/cmd/wfx-viewer/output/svg"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/spf13/pflag"
 )
@@ -24,11 +30,25 @@ type Generator interface {
 	Generate(out io.Writer, workflow *api.Workflow) error
 }
 
+// Annotator is implemented by generators which can annotate the diagram with statistics.
+type Annotator interface {
+	SetStats(stats *stats.Stats)
+}
+
+// Overlayer is implemented by generators which can highlight jobs on the diagram.
+type Overlayer interface {
+	SetOverlay(overlay *overlay.Overlay)
+}
+
 var Generators = make(map[string]Generator)
 
 func init() {
 	Generators["svg"] = svg.NewGenerator()
+	Generators["png"] = png.NewGenerator()
 	Generators["plantuml"] = plantuml.NewGenerator()
 	Generators["smcat"] = smcat.NewGenerator()
 	Generators["mermaid"] = mermaid.NewGenerator()
+	Generators["dot"] = dot.NewGenerator()
+	Generators["d2"] = d2.NewGenerator()
+	Generators["json"] = jsongraph.NewGenerator()
 }
@@ -19,6 +19,11 @@ func TestSVG(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestPNG(t *testing.T) {
+	_, ok := Generators["png"]
+	assert.True(t, ok)
+}
+
 func TestPlantUML(t *testing.T) {
 	_, ok := Generators["plantuml"]
 	assert.True(t, ok)
@@ -33,3 +38,18 @@ func TestMermaid(t *testing.T) {
 	_, ok := Generators["mermaid"]
 	assert.True(t, ok)
 }
+
+func TestDOT(t *testing.T) {
+	_, ok := Generators["dot"]
+	assert.True(t, ok)
+}
+
+func TestD2(t *testing.T) {
+	_, ok := Generators["d2"]
+	assert.True(t, ok)
+}
+
+func TestJSON(t *testing.T) {
+	_, ok := Generators["json"]
+	assert.True(t, ok)
+}
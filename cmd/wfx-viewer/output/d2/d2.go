@@ -0,0 +1,186 @@
+package d2
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/cmd/wfx-viewer/colors"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+	"github.com/spf13/pflag"
+)
+
+const directionFlag = "d2-direction"
+
+const (
+	initialNode = "_initial"
+	finalNode   = "_final"
+)
+
+// Generator creates D2 diagrams (https://d2lang.com). Groups are rendered as containers, the eligible actor
+// and the action of a transition are attached to the edge as classes (e.g. `class: [WFX; IMMEDIATE]`).
+type Generator struct {
+	f       *pflag.FlagSet
+	stats   *stats.Stats
+	overlay *overlay.Overlay
+}
+
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+func (g *Generator) RegisterFlags(f *pflag.FlagSet) {
+	f.String(directionFlag, "down", "direction of the graph layout (used for d2), one of: up, down, left, right")
+	g.f = f
+}
+
+func (g *Generator) SetStats(stats *stats.Stats) {
+	g.stats = stats
+}
+
+func (g *Generator) SetOverlay(overlay *overlay.Overlay) {
+	g.overlay = overlay
+}
+
+func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
+	direction := "down"
+	if g.f != nil {
+		var err error
+		if direction, err = g.f.GetString(directionFlag); err != nil {
+			return fault.Wrap(err)
+		}
+	}
+	switch direction {
+	case "up", "down", "left", "right":
+	default:
+		return fmt.Errorf("invalid %s: %s", directionFlag, direction)
+	}
+
+	cp := colors.NewColorPalette(wf)
+
+	_, _ = fmt.Fprintf(out, "direction: %s\n\n", direction)
+	_, _ = out.Write([]byte(`classes: {
+  CLIENT: {}
+  WFX: {}
+  WAIT: {}
+  IMMEDIATE: {
+    style.stroke-dash: 3
+  }
+}
+
+`))
+	_, _ = fmt.Fprintf(out, "%s: \"\" {\n  shape: circle\n  width: 16\n  height: 16\n  style.fill: black\n}\n", initialNode)
+	_, _ = fmt.Fprintf(out, "%s: \"\" {\n  shape: circle\n  width: 16\n  height: 16\n  style.fill: black\n  style.double-border: true\n}\n\n", finalNode)
+
+	states := make(map[string]api.State, len(wf.States))
+	for _, state := range wf.States {
+		states[state.Name] = state
+	}
+
+	// groups
+	for _, group := range wf.Groups {
+		_, _ = fmt.Fprintf(out, "%s: {\n", key(group.Name))
+		if group.Description != "" {
+			_, _ = fmt.Fprintf(out, "  tooltip: %s\n", quote(group.Description))
+		}
+		_, _ = fmt.Fprintf(out, "  style.stroke: %s\n", quote(cp.GroupColor(group.Name).ToHEX().String()))
+		for _, name := range group.States {
+			if state, ok := states[name]; ok {
+				g.writeState(out, "  ", &cp, state)
+			}
+		}
+		_, _ = out.Write([]byte("}\n"))
+	}
+	// states which don't belong to any group
+	for _, state := range wf.States {
+		if cp.StateToGroup(state.Name) == nil {
+			g.writeState(out, "", &cp, state)
+		}
+	}
+	_, _ = out.Write([]byte("\n"))
+
+	// transitions
+	if initial := workflow.FindInitialState(wf); initial != nil {
+		_, _ = fmt.Fprintf(out, "%s -> %s\n", initialNode, path(&cp, *initial))
+	}
+	for _, transition := range wf.Transitions {
+		action := api.WAIT
+		if transition.Action != nil {
+			action = *transition.Action
+		}
+		labels := []string{string(transition.Eligible)}
+		if stats := g.stats.EdgeLabel(transition.From, transition.To); stats != "" {
+			labels = append(labels, stats)
+		}
+		if steps := g.overlay.EdgeLabel(transition.From, transition.To); steps != "" {
+			labels = append(labels, steps)
+		}
+		_, _ = fmt.Fprintf(out, "%s -> %s: %s {\n", path(&cp, transition.From), path(&cp, transition.To), quote(strings.Join(labels, "\n")))
+		_, _ = fmt.Fprintf(out, "  class: [%s; %s]\n", transition.Eligible, action)
+		if g.overlay.Traversed(transition.From, transition.To) {
+			_, _ = fmt.Fprintf(out, "  style.stroke: %s\n  style.stroke-width: 3\n", overlay.HighlightColor)
+		}
+		_, _ = out.Write([]byte("}\n"))
+	}
+	for _, state := range workflow.FindFinalStates(wf) {
+		_, _ = fmt.Fprintf(out, "%s -> %s\n", path(&cp, state), finalNode)
+	}
+	return nil
+}
+
+func (g *Generator) writeState(out io.Writer, indent string, cp *colors.ColorPalette, state api.State) {
+	fgColor, bgColor := cp.StateColor(state.Name)
+	if g.overlay.Aggregate() {
+		fgColor, bgColor = g.overlay.StateColor(state.Name)
+		_, _ = fmt.Fprintf(out, "%s%s: %s {\n", indent, key(state.Name), quote(fmt.Sprintf("%s (%d)", state.Name, g.overlay.StateCount(state.Name))))
+	} else {
+		_, _ = fmt.Fprintf(out, "%s%s: {\n", indent, key(state.Name))
+	}
+	if state.Description != "" {
+		_, _ = fmt.Fprintf(out, "%s  tooltip: %s\n", indent, quote(state.Description))
+	}
+	_, _ = fmt.Fprintf(out, "%s  style.fill: %s\n", indent, quote(bgColor))
+	_, _ = fmt.Fprintf(out, "%s  style.font-color: %s\n", indent, quote(fgColor))
+	if g.overlay.IsCurrent(state.Name) {
+		_, _ = fmt.Fprintf(out, "%s  style.stroke: %s\n%s  style.stroke-width: 4\n", indent, overlay.HighlightColor, indent)
+	}
+	_, _ = fmt.Fprintf(out, "%s}\n", indent)
+}
+
+// path returns the fully qualified key of a state, i.e. including its group container.
+func path(cp *colors.ColorPalette, state string) string {
+	if group := cp.StateToGroup(state); group != nil {
+		return key(*group) + "." + key(state)
+	}
+	return key(state)
+}
+
+var plainKey = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// key returns a D2 key, quoted if necessary.
+func key(s string) string {
+	if plainKey.MatchString(s) {
+		return s
+	}
+	return quote(s)
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quote returns a D2 string literal.
+func quote(s string) string {
+	return `"` + escaper.Replace(s) + `"`
+}
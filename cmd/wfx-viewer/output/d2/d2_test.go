@@ -0,0 +1,84 @@
+package d2
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	actual := buf.String()
+	assert.Contains(t, actual, "direction: down\n")
+	assert.Contains(t, actual, `CLOSED: {
+  tooltip: "a successful update's terminal states"
+  style.stroke: "#4993dd"
+  ACTIVATED: {
+    tooltip: "client signaled activation success"
+    style.fill: "#4993dd"
+    style.font-color: "black"
+  }
+}
+`)
+	assert.Contains(t, actual, "_initial -> OPEN.INSTALL\n")
+	assert.Contains(t, actual, `OPEN.INSTALLED -> OPEN.ACTIVATE: "WFX" {
+  class: [WFX; IMMEDIATE]
+}
+`)
+	assert.Contains(t, actual, `OPEN.ACTIVATING -> CLOSED.ACTIVATED: "CLIENT" {
+  class: [CLIENT; WAIT]
+}
+`)
+	assert.Contains(t, actual, "FAILED.TERMINATED -> _final\n")
+}
+
+func TestGenerate_Ungrouped(t *testing.T) {
+	wf := &api.Workflow{
+		Name:        "test",
+		States:      []api.State{{Name: "NEW"}, {Name: "DONE state"}},
+		Transitions: []api.Transition{{From: "NEW", To: "DONE state", Eligible: api.CLIENT}},
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, NewGenerator().Generate(buf, wf))
+	assert.Contains(t, buf.String(), "\"DONE state\": {\n  style.fill: \"#000000\"\n  style.font-color: \"white\"\n}\n")
+	assert.Contains(t, buf.String(), "NEW -> \"DONE state\": \"CLIENT\" {\n")
+}
+
+func TestGenerate_Direction(t *testing.T) {
+	f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	gen := NewGenerator()
+	gen.RegisterFlags(f)
+	require.NoError(t, f.Set(directionFlag, "right"))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, gen.Generate(buf, dau.DirectWorkflow()))
+	assert.Contains(t, buf.String(), "direction: right\n")
+
+	require.NoError(t, f.Set(directionFlag, "diagonal"))
+	assert.ErrorContains(t, gen.Generate(buf, dau.DirectWorkflow()), "invalid d2-direction: diagonal")
+}
+
+func TestGenerate_Aggregate(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	gen.SetOverlay(overlay.FromJobs(dau.DirectWorkflow(), []api.Job{{Status: &api.JobStatus{State: "ACTIVATED"}}}))
+	require.NoError(t, gen.Generate(buf, dau.DirectWorkflow()))
+	assert.Contains(t, buf.String(), "  ACTIVATED: \"ACTIVATED (1)\" {\n")
+}
@@ -0,0 +1,175 @@
+package dot
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/cmd/wfx-viewer/colors"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+	"github.com/spf13/pflag"
+)
+
+const rankDirFlag = "dot-rankdir"
+
+const (
+	initialNode = "__initial"
+	finalNode   = "__final"
+)
+
+// Generator creates Graphviz DOT diagrams. Groups are rendered as clusters, the eligible actor and the
+// action of a transition are available as the custom edge attributes `eligible` and `action`.
+type Generator struct {
+	f       *pflag.FlagSet
+	stats   *stats.Stats
+	overlay *overlay.Overlay
+}
+
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+func (g *Generator) RegisterFlags(f *pflag.FlagSet) {
+	f.String(rankDirFlag, "TB", "direction of the graph layout (used for dot), one of: TB, LR, BT, RL")
+	g.f = f
+}
+
+func (g *Generator) SetStats(stats *stats.Stats) {
+	g.stats = stats
+}
+
+func (g *Generator) SetOverlay(overlay *overlay.Overlay) {
+	g.overlay = overlay
+}
+
+func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
+	rankDir := "TB"
+	if g.f != nil {
+		var err error
+		if rankDir, err = g.f.GetString(rankDirFlag); err != nil {
+			return fault.Wrap(err)
+		}
+	}
+	switch rankDir {
+	case "TB", "LR", "BT", "RL":
+	default:
+		return fmt.Errorf("invalid %s: %s", rankDirFlag, rankDir)
+	}
+
+	cp := colors.NewColorPalette(wf)
+
+	_, _ = fmt.Fprintf(out, "digraph %s {\n", quote(wf.Name))
+	_, _ = fmt.Fprintf(out, "  rankdir=%s;\n", rankDir)
+	if wf.Description != "" {
+		_, _ = fmt.Fprintf(out, "  tooltip=%s;\n", quote(wf.Description))
+	}
+	_, _ = out.Write([]byte("  node [shape=box, style=\"rounded,filled\"];\n"))
+	_, _ = fmt.Fprintf(out, "  %s [shape=point, width=0.2, label=\"\"];\n", initialNode)
+	_, _ = fmt.Fprintf(out, "  %s [shape=doublecircle, style=filled, fillcolor=black, width=0.1, label=\"\"];\n", finalNode)
+
+	states := make(map[string]api.State, len(wf.States))
+	for _, state := range wf.States {
+		states[state.Name] = state
+	}
+
+	// groups
+	for _, group := range wf.Groups {
+		_, _ = fmt.Fprintf(out, "  subgraph %s {\n", quote("cluster_"+group.Name))
+		_, _ = fmt.Fprintf(out, "    label=%s;\n", quote(group.Name))
+		if group.Description != "" {
+			_, _ = fmt.Fprintf(out, "    tooltip=%s;\n", quote(group.Description))
+		}
+		_, _ = fmt.Fprintf(out, "    color=%s;\n", quote(cp.GroupColor(group.Name).ToHEX().String()))
+		for _, name := range group.States {
+			if state, ok := states[name]; ok {
+				g.writeState(out, "    ", &cp, state)
+			}
+		}
+		_, _ = out.Write([]byte("  }\n"))
+	}
+	// states which don't belong to any group
+	for _, state := range wf.States {
+		if cp.StateToGroup(state.Name) == nil {
+			g.writeState(out, "  ", &cp, state)
+		}
+	}
+
+	// transitions
+	if initial := workflow.FindInitialState(wf); initial != nil {
+		_, _ = fmt.Fprintf(out, "  %s -> %s;\n", initialNode, quote(*initial))
+	}
+	for _, transition := range wf.Transitions {
+		action := api.WAIT
+		if transition.Action != nil {
+			action = *transition.Action
+		}
+		label := string(transition.Eligible)
+		if transition.Action != nil {
+			label += " [" + string(action) + "]"
+		}
+		if stats := g.stats.EdgeLabel(transition.From, transition.To); stats != "" {
+			label += "\n" + stats
+		}
+		if steps := g.overlay.EdgeLabel(transition.From, transition.To); steps != "" {
+			label += "\n" + steps
+		}
+		attrs := []string{
+			"label=" + quote(label),
+			"eligible=" + quote(string(transition.Eligible)),
+			"action=" + quote(string(action)),
+		}
+		if action == api.IMMEDIATE {
+			attrs = append(attrs, "style=dashed")
+		}
+		if g.overlay.Traversed(transition.From, transition.To) {
+			attrs = append(attrs, "color="+quote(overlay.HighlightColor), "penwidth=2")
+		}
+		_, _ = fmt.Fprintf(out, "  %s -> %s [%s];\n", quote(transition.From), quote(transition.To), strings.Join(attrs, ", "))
+	}
+	for _, state := range workflow.FindFinalStates(wf) {
+		_, _ = fmt.Fprintf(out, "  %s -> %s;\n", quote(state), finalNode)
+	}
+
+	_, _ = out.Write([]byte("}\n"))
+	return nil
+}
+
+func (g *Generator) writeState(out io.Writer, indent string, cp *colors.ColorPalette, state api.State) {
+	fgColor, bgColor := cp.StateColor(state.Name)
+	label := state.Name
+	if g.overlay.Aggregate() {
+		fgColor, bgColor = g.overlay.StateColor(state.Name)
+		label = fmt.Sprintf("%s (%d)", state.Name, g.overlay.StateCount(state.Name))
+	}
+	attrs := []string{
+		"label=" + quote(label),
+		"fillcolor=" + quote(bgColor),
+		"fontcolor=" + quote(fgColor),
+	}
+	if state.Description != "" {
+		attrs = append(attrs, "tooltip="+quote(state.Description))
+	}
+	if g.overlay.IsCurrent(state.Name) {
+		attrs = append(attrs, "color="+quote(overlay.HighlightColor), "penwidth=3")
+	}
+	_, _ = fmt.Fprintf(out, "%s%s [%s];\n", indent, quote(state.Name), strings.Join(attrs, ", "))
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quote returns a DOT string literal.
+func quote(s string) string {
+	return `"` + escaper.Replace(s) + `"`
+}
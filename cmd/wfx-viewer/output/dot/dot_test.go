@@ -0,0 +1,107 @@
+package dot
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	expected := `digraph "wfx.workflow.dau.direct" {
+  rankdir=TB;
+  tooltip="a workflow for device artifact updates without confirmation";
+  node [shape=box, style="rounded,filled"];
+  __initial [shape=point, width=0.2, label=""];
+  __final [shape=doublecircle, style=filled, fillcolor=black, width=0.1, label=""];
+  subgraph "cluster_OPEN" {
+    label="OPEN";
+    tooltip="regular workflow-advancing states";
+    color="#00cc00";
+    "INSTALL" [label="INSTALL", fillcolor="#00cc00", fontcolor="black", tooltip="instruct client to start installation"];
+    "INSTALLING" [label="INSTALLING", fillcolor="#00cc00", fontcolor="black", tooltip="installation progress update from client"];
+    "INSTALLED" [label="INSTALLED", fillcolor="#00cc00", fontcolor="black", tooltip="client signaled installation success"];
+    "ACTIVATE" [label="ACTIVATE", fillcolor="#00cc00", fontcolor="black", tooltip="instruct client to start activation"];
+    "ACTIVATING" [label="ACTIVATING", fillcolor="#00cc00", fontcolor="black", tooltip="client activates update"];
+  }
+  subgraph "cluster_CLOSED" {
+    label="CLOSED";
+    tooltip="a successful update's terminal states";
+    color="#4993dd";
+    "ACTIVATED" [label="ACTIVATED", fillcolor="#4993dd", fontcolor="black", tooltip="client signaled activation success"];
+  }
+  subgraph "cluster_FAILED" {
+    label="FAILED";
+    tooltip="a failed update's terminal states";
+    color="#9393dd";
+    "TERMINATED" [label="TERMINATED", fillcolor="#9393dd", fontcolor="black", tooltip="client aborted update with error"];
+  }
+  __initial -> "INSTALL";
+  "INSTALL" -> "INSTALLING" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "INSTALL" -> "TERMINATED" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "INSTALLING" -> "INSTALLING" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "INSTALLING" -> "TERMINATED" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "INSTALLING" -> "INSTALLED" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "INSTALLED" -> "ACTIVATE" [label="WFX [IMMEDIATE]", eligible="WFX", action="IMMEDIATE", style=dashed];
+  "ACTIVATE" -> "ACTIVATING" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "ACTIVATE" -> "TERMINATED" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "ACTIVATING" -> "ACTIVATING" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "ACTIVATING" -> "TERMINATED" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "ACTIVATING" -> "ACTIVATED" [label="CLIENT", eligible="CLIENT", action="WAIT"];
+  "ACTIVATED" -> __final;
+  "TERMINATED" -> __final;
+}
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestGenerate_RankDir(t *testing.T) {
+	f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	gen := NewGenerator()
+	gen.RegisterFlags(f)
+	require.NoError(t, f.Set(rankDirFlag, "LR"))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, gen.Generate(buf, dau.DirectWorkflow()))
+	assert.Contains(t, buf.String(), "  rankdir=LR;\n")
+
+	require.NoError(t, f.Set(rankDirFlag, "XX"))
+	assert.ErrorContains(t, gen.Generate(buf, dau.DirectWorkflow()), "invalid dot-rankdir: XX")
+}
+
+func TestGenerate_StatsAndOverlay(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	gen.SetStats(stats.New(&api.WorkflowStats{
+		Transitions: []api.TransitionStats{{From: "INSTALL", To: "INSTALLING", Count: 3}},
+	}))
+	gen.SetOverlay(overlay.FromJob(dau.DirectWorkflow(), &api.Job{
+		Status:  &api.JobStatus{State: "INSTALLING"},
+		History: &[]api.History{{Status: &api.JobStatus{State: "INSTALL"}}},
+	}))
+	require.NoError(t, gen.Generate(buf, dau.DirectWorkflow()))
+	assert.Contains(t, buf.String(), `"INSTALL" -> "INSTALLING" [label="CLIENT\n3x\n#1", eligible="CLIENT", action="WAIT", color="red", penwidth=2];`)
+	assert.Contains(t, buf.String(), `"INSTALLING" [label="INSTALLING", fillcolor="#00cc00", fontcolor="black", tooltip="installation progress update from client", color="red", penwidth=3];`)
+}
+
+func TestQuote(t *testing.T) {
+	assert.Equal(t, `"a \"b\" \\ c\nd"`, quote("a \"b\" \\ c\nd"))
+}
@@ -0,0 +1,256 @@
+package jsongraph
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/cmd/wfx-viewer/colors"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+	"github.com/spf13/pflag"
+)
+
+const formatFlag = "json-format"
+
+const (
+	// FormatJGF is the JSON Graph Format, see https://jsongraphformat.info
+	FormatJGF = "jgf"
+	// FormatCytoscape is the elements JSON understood by Cytoscape.js, see https://js.cytoscape.org/#notation/elements-json
+	FormatCytoscape = "cytoscape"
+)
+
+// Generator creates a node/edge representation of the workflow in JSON.
+type Generator struct {
+	f *pflag.FlagSet
+}
+
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+func (g *Generator) RegisterFlags(f *pflag.FlagSet) {
+	f.String(formatFlag, FormatJGF, fmt.Sprintf("graph format (used for json), one of: %s, %s", FormatJGF, FormatCytoscape))
+	g.f = f
+}
+
+// node is the format-independent representation of a state.
+type node struct {
+	ID          string `json:"id"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+	Group       string `json:"group,omitempty"`
+	Color       string `json:"color"`
+	FontColor   string `json:"fontColor"`
+	Initial     bool   `json:"initial"`
+	Final       bool   `json:"final"`
+}
+
+// edge is the format-independent representation of a transition.
+type edge struct {
+	ID          string           `json:"id"`
+	Source      string           `json:"source"`
+	Target      string           `json:"target"`
+	Eligible    api.EligibleEnum `json:"eligible"`
+	Action      api.ActionEnum   `json:"action"`
+	Description string           `json:"description,omitempty"`
+}
+
+type group struct {
+	ID          string   `json:"id"`
+	Label       string   `json:"label"`
+	Description string   `json:"description,omitempty"`
+	Color       string   `json:"color"`
+	States      []string `json:"states"`
+}
+
+func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
+	format := FormatJGF
+	if g.f != nil {
+		var err error
+		if format, err = g.f.GetString(formatFlag); err != nil {
+			return fault.Wrap(err)
+		}
+	}
+
+	cp := colors.NewColorPalette(wf)
+	initial := workflow.FindInitialState(wf)
+	finalStates := workflow.FindFinalStates(wf)
+
+	nodes := make([]node, 0, len(wf.States))
+	for _, state := range wf.States {
+		fgColor, bgColor := cp.StateColor(state.Name)
+		n := node{
+			ID:          state.Name,
+			Label:       state.Name,
+			Description: state.Description,
+			Color:       bgColor,
+			FontColor:   fgColor,
+			Initial:     initial != nil && *initial == state.Name,
+			Final:       slices.Contains(finalStates, state.Name),
+		}
+		if grp := cp.StateToGroup(state.Name); grp != nil {
+			n.Group = *grp
+		}
+		nodes = append(nodes, n)
+	}
+	edges := make([]edge, 0, len(wf.Transitions))
+	for i, transition := range wf.Transitions {
+		action := api.WAIT
+		if transition.Action != nil {
+			action = *transition.Action
+		}
+		edges = append(edges, edge{
+			ID:          fmt.Sprintf("t%d", i),
+			Source:      transition.From,
+			Target:      transition.To,
+			Eligible:    transition.Eligible,
+			Action:      action,
+			Description: transition.Description,
+		})
+	}
+	groups := make([]group, 0, len(wf.Groups))
+	for _, grp := range wf.Groups {
+		groups = append(groups, group{
+			ID:          grp.Name,
+			Label:       grp.Name,
+			Description: grp.Description,
+			Color:       cp.GroupColor(grp.Name).ToHEX().String(),
+			States:      grp.States,
+		})
+	}
+
+	var doc any
+	switch format {
+	case FormatJGF:
+		doc = jgf(wf, nodes, edges, groups)
+	case FormatCytoscape:
+		doc = cytoscape(nodes, edges, groups)
+	default:
+		return fmt.Errorf("invalid %s: %s", formatFlag, format)
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return fault.Wrap(enc.Encode(doc))
+}
+
+// JSON Graph Format, version 2
+
+type jgfDocument struct {
+	Graph jgfGraph `json:"graph"`
+}
+
+type jgfGraph struct {
+	ID       string             `json:"id"`
+	Label    string             `json:"label"`
+	Directed bool               `json:"directed"`
+	Metadata jgfGraphMetadata   `json:"metadata"`
+	Nodes    map[string]jgfNode `json:"nodes"`
+	Edges    []jgfEdge          `json:"edges"`
+}
+
+type jgfGraphMetadata struct {
+	Description string  `json:"description,omitempty"`
+	Groups      []group `json:"groups"`
+}
+
+type jgfNode struct {
+	Label    string `json:"label"`
+	Metadata node   `json:"metadata"`
+}
+
+type jgfEdge struct {
+	ID       string `json:"id"`
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Relation string `json:"relation"`
+	Directed bool   `json:"directed"`
+	Metadata edge   `json:"metadata"`
+}
+
+func jgf(wf *api.Workflow, nodes []node, edges []edge, groups []group) jgfDocument {
+	result := jgfGraph{
+		ID:       wf.Name,
+		Label:    wf.Name,
+		Directed: true,
+		Metadata: jgfGraphMetadata{Description: wf.Description, Groups: groups},
+		Nodes:    make(map[string]jgfNode, len(nodes)),
+		Edges:    make([]jgfEdge, 0, len(edges)),
+	}
+	for _, n := range nodes {
+		result.Nodes[n.ID] = jgfNode{Label: n.Label, Metadata: n}
+	}
+	for _, e := range edges {
+		result.Edges = append(result.Edges, jgfEdge{
+			ID:       e.ID,
+			Source:   e.Source,
+			Target:   e.Target,
+			Relation: string(e.Eligible),
+			Directed: true,
+			Metadata: e,
+		})
+	}
+	return jgfDocument{Graph: result}
+}
+
+// Cytoscape.js elements; groups are compound (parent) nodes
+
+type cytoscapeDocument struct {
+	Elements cytoscapeElements `json:"elements"`
+}
+
+type cytoscapeElements struct {
+	Nodes []cytoscapeElement `json:"nodes"`
+	Edges []cytoscapeElement `json:"edges"`
+}
+
+type cytoscapeElement struct {
+	Data    any    `json:"data"`
+	Classes string `json:"classes,omitempty"`
+}
+
+type cytoscapeNode struct {
+	node
+	Parent string `json:"parent,omitempty"`
+}
+
+type cytoscapeGroup struct {
+	ID          string `json:"id"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+	Color       string `json:"color"`
+}
+
+func cytoscape(nodes []node, edges []edge, groups []group) cytoscapeDocument {
+	var result cytoscapeElements
+	result.Nodes = make([]cytoscapeElement, 0, len(groups)+len(nodes))
+	// group IDs are prefixed since groups and states may share a name
+	for _, grp := range groups {
+		result.Nodes = append(result.Nodes, cytoscapeElement{
+			Data:    cytoscapeGroup{ID: "group:" + grp.ID, Label: grp.Label, Description: grp.Description, Color: grp.Color},
+			Classes: "group",
+		})
+	}
+	for _, n := range nodes {
+		data := cytoscapeNode{node: n}
+		if n.Group != "" {
+			data.Parent = "group:" + n.Group
+		}
+		result.Nodes = append(result.Nodes, cytoscapeElement{Data: data, Classes: "state"})
+	}
+	result.Edges = make([]cytoscapeElement, 0, len(edges))
+	for _, e := range edges {
+		result.Edges = append(result.Edges, cytoscapeElement{Data: e, Classes: string(e.Eligible) + " " + string(e.Action)})
+	}
+	return cytoscapeDocument{Elements: result}
+}
@@ -0,0 +1,84 @@
+package jsongraph
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_JGF(t *testing.T) {
+	buf := new(bytes.Buffer)
+	require.NoError(t, NewGenerator().Generate(buf, dau.DirectWorkflow()))
+
+	var doc jgfDocument
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	graph := doc.Graph
+	assert.Equal(t, "wfx.workflow.dau.direct", graph.ID)
+	assert.True(t, graph.Directed)
+	assert.Len(t, graph.Nodes, 7)
+	assert.Len(t, graph.Edges, 11)
+	require.Len(t, graph.Metadata.Groups, 3)
+	assert.Equal(t, "OPEN", graph.Metadata.Groups[0].ID)
+
+	install := graph.Nodes["INSTALL"]
+	assert.Equal(t, "OPEN", install.Metadata.Group)
+	assert.Equal(t, "#00cc00", install.Metadata.Color)
+	assert.True(t, install.Metadata.Initial)
+	assert.True(t, graph.Nodes["TERMINATED"].Metadata.Final)
+
+	immediate := graph.Edges[5]
+	assert.Equal(t, "INSTALLED", immediate.Source)
+	assert.Equal(t, "ACTIVATE", immediate.Target)
+	assert.Equal(t, "WFX", immediate.Relation)
+	assert.EqualValues(t, "IMMEDIATE", immediate.Metadata.Action)
+	assert.EqualValues(t, "WAIT", graph.Edges[0].Metadata.Action)
+}
+
+func TestGenerate_Cytoscape(t *testing.T) {
+	f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	gen := NewGenerator()
+	gen.RegisterFlags(f)
+	require.NoError(t, f.Set(formatFlag, FormatCytoscape))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, gen.Generate(buf, dau.DirectWorkflow()))
+
+	var doc struct {
+		Elements struct {
+			Nodes []struct {
+				Data    map[string]any `json:"data"`
+				Classes string         `json:"classes"`
+			} `json:"nodes"`
+			Edges []struct {
+				Data    map[string]any `json:"data"`
+				Classes string         `json:"classes"`
+			} `json:"edges"`
+		} `json:"elements"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	// 3 groups + 7 states
+	require.Len(t, doc.Elements.Nodes, 10)
+	assert.Equal(t, "group:OPEN", doc.Elements.Nodes[0].Data["id"])
+	assert.Equal(t, "group", doc.Elements.Nodes[0].Classes)
+	assert.Equal(t, "INSTALL", doc.Elements.Nodes[3].Data["id"])
+	assert.Equal(t, "group:OPEN", doc.Elements.Nodes[3].Data["parent"])
+	require.Len(t, doc.Elements.Edges, 11)
+	assert.Equal(t, "WFX IMMEDIATE", doc.Elements.Edges[5].Classes)
+	assert.Equal(t, "INSTALLED", doc.Elements.Edges[5].Data["source"])
+
+	require.NoError(t, f.Set(formatFlag, "graphml"))
+	assert.ErrorContains(t, gen.Generate(buf, dau.DirectWorkflow()), "invalid json-format: graphml")
+}
@@ -14,12 +14,17 @@ import (
 	"strings"
 
 	"github.com/siemens/wfx/cmd/wfx-viewer/colors"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/workflow"
 	"github.com/spf13/pflag"
 )
 
-type Generator struct{}
+type Generator struct {
+	stats   *stats.Stats
+	overlay *overlay.Overlay
+}
 
 func NewGenerator() *Generator {
 	return &Generator{}
@@ -27,11 +32,24 @@ func NewGenerator() *Generator {
 
 func (g *Generator) RegisterFlags(_ *pflag.FlagSet) {}
 
+func (g *Generator) SetStats(stats *stats.Stats) {
+	g.stats = stats
+}
+
+func (g *Generator) SetOverlay(overlay *overlay.Overlay) {
+	g.overlay = overlay
+}
+
 func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
 	_, _ = out.Write([]byte("stateDiagram-v2\n"))
 
 	initialState := *workflow.FindInitialState(wf)
 	_, _ = fmt.Fprintf(out, "    [*] --> %s\n", initialState)
+	if g.overlay.Aggregate() {
+		for _, state := range wf.States {
+			_, _ = fmt.Fprintf(out, "    state \"%s (%d)\" as %s\n", state.Name, g.overlay.StateCount(state.Name), state.Name)
+		}
+	}
 	for _, transition := range wf.Transitions {
 		_, _ = out.Write([]byte("    "))
 		_, _ = out.Write([]byte(transition.From))
@@ -39,6 +57,14 @@ func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
 		_, _ = out.Write([]byte(transition.To))
 		_, _ = out.Write([]byte(": "))
 		_, _ = out.Write([]byte(transition.Eligible))
+		if label := g.stats.EdgeLabel(transition.From, transition.To); label != "" {
+			_, _ = out.Write([]byte("<br/>"))
+			_, _ = out.Write([]byte(label))
+		}
+		if label := g.overlay.EdgeLabel(transition.From, transition.To); label != "" {
+			_, _ = out.Write([]byte("<br/>"))
+			_, _ = out.Write([]byte(label))
+		}
 		_, _ = out.Write([]byte("\n"))
 	}
 
@@ -53,11 +79,23 @@ func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
 	cp := colors.NewColorPalette(wf)
 	for _, state := range wf.States {
 		fgColor, bgColor := cp.StateColor(state.Name)
-		_, _ = fmt.Fprintf(out, "    classDef cl_%s color:%s,fill:%s\n", state.Name, fgColor, bgColor)
+		if g.overlay.Aggregate() {
+			fgColor, bgColor = g.overlay.StateColor(state.Name)
+		}
+		style := fmt.Sprintf("color:%s,fill:%s", fgColor, bgColor)
+		if g.overlay.IsCurrent(state.Name) {
+			style += fmt.Sprintf(",stroke:%s,stroke-width:4px", overlay.HighlightColor)
+		}
+		_, _ = fmt.Fprintf(out, "    classDef cl_%s %s\n", state.Name, style)
 		_, _ = fmt.Fprintf(out, "    class %s cl_%s\n", state.Name, state.Name)
 	}
 
 	// add legend
+	if g.overlay.Aggregate() {
+		_, high := colors.HeatColor(1)
+		_, _ = fmt.Fprintf(out, "    Note right of %s: <b>Jobs per State</b><br/>white: 0<br/><font color=\"%s\">red</font>: %d\n", initialState, high, g.overlay.MaxCount())
+		return nil
+	}
 	_, _ = fmt.Fprintf(out, "    Note right of %s: <b>Group to Color Mapping</b><br/>", initialState)
 	lines := make([]string, 0, len(wf.Groups))
 	for _, group := range wf.Groups {
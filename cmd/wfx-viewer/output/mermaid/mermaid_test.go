@@ -12,6 +12,9 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
+	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/workflow/dau"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -56,3 +59,39 @@ func TestGenerate(t *testing.T) {
 `
 	assert.Equal(t, expected, actual)
 }
+
+func TestGenerate_Stats(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	gen.SetStats(stats.New(&api.WorkflowStats{
+		States:      []api.StateStats{{State: "INSTALL", Count: 3, P50: 2}},
+		Transitions: []api.TransitionStats{{From: "INSTALL", To: "INSTALLING", Count: 3}},
+	}))
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "    INSTALL --> INSTALLING: CLIENT<br/>3x, p50 2s\n")
+}
+
+func TestGenerate_Overlay(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	gen.SetOverlay(overlay.FromJob(dau.DirectWorkflow(), &api.Job{
+		Status:  &api.JobStatus{State: "INSTALLING"},
+		History: &[]api.History{{Status: &api.JobStatus{State: "INSTALL"}}},
+	}))
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "    INSTALL --> INSTALLING: CLIENT<br/>#1\n")
+	assert.Contains(t, buf.String(), "    classDef cl_INSTALLING color:black,fill:#00cc00,stroke:red,stroke-width:4px\n")
+}
+
+func TestGenerate_Aggregate(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	gen.SetOverlay(overlay.FromJobs(dau.DirectWorkflow(), []api.Job{{Status: &api.JobStatus{State: "INSTALLING"}}}))
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "    state \"INSTALLING (1)\" as INSTALLING\n")
+	assert.Contains(t, buf.String(), "    classDef cl_INSTALLING color:black,fill:#ff0000\n")
+	assert.Contains(t, buf.String(), "<b>Jobs per State</b>")
+}
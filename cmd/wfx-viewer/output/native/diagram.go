@@ -0,0 +1,171 @@
+package native
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+
+	"github.com/siemens/wfx/cmd/wfx-viewer/colors"
+	"github.com/siemens/wfx/cmd/wfx-viewer/layout"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+)
+
+// Text metrics; they match the fixed-size font used for PNG output and approximate a 12px monospace font in SVG.
+const (
+	charWidth  = 7.0
+	lineHeight = 13.0
+	paddingX   = 10.0
+	paddingY   = 8.0
+	markerSize = 16.0
+)
+
+const (
+	initialNode = "__initial"
+	finalNode   = "__final"
+)
+
+// Diagram is a laid out workflow, ready to be rendered.
+type Diagram struct {
+	Title  string
+	Graph  *layout.Graph
+	nodes  map[*layout.Node]*nodeStyle
+	edges  map[*layout.Edge]*edgeStyle
+	groups map[string]string
+}
+
+type nodeStyle struct {
+	Lines       []string
+	Tooltip     string
+	Fill        string
+	FontColor   string
+	Stroke      string
+	StrokeWidth float64
+	Initial     bool
+	Final       bool
+}
+
+type edgeStyle struct {
+	Lines   []string
+	Tooltip string
+	Color   string
+	Width   float64
+	Dashed  bool
+}
+
+// New lays out the workflow. Both stats and overlay are optional.
+func New(wf *api.Workflow, stats *stats.Stats, ov *overlay.Overlay) *Diagram {
+	cp := colors.NewColorPalette(wf)
+	d := &Diagram{
+		Title:  wf.Name,
+		Graph:  layout.New(),
+		nodes:  make(map[*layout.Node]*nodeStyle, len(wf.States)+2),
+		edges:  make(map[*layout.Edge]*edgeStyle, len(wf.Transitions)+2),
+		groups: make(map[string]string, len(wf.Groups)),
+	}
+	for _, group := range wf.Groups {
+		d.groups[group.Name] = cp.GroupColor(group.Name).ToHEX().String()
+	}
+
+	initial := workflow.FindInitialState(wf)
+	if initial != nil {
+		n := d.Graph.AddNode(initialNode, "", layout.Size{Width: markerSize, Height: markerSize})
+		d.nodes[n] = &nodeStyle{Initial: true, Fill: "black"}
+	}
+
+	for _, state := range wf.States {
+		fgColor, bgColor := cp.StateColor(state.Name)
+		label := state.Name
+		if ov.Aggregate() {
+			fgColor, bgColor = ov.StateColor(state.Name)
+			label = fmt.Sprintf("%s (%d)", state.Name, ov.StateCount(state.Name))
+		}
+		style := &nodeStyle{
+			Lines:       []string{label},
+			Tooltip:     state.Description,
+			Fill:        bgColor,
+			FontColor:   fgColor,
+			Stroke:      "black",
+			StrokeWidth: 1,
+		}
+		if ov.IsCurrent(state.Name) {
+			style.Stroke = overlay.HighlightColor
+			style.StrokeWidth = 3
+		}
+		group := ""
+		if grp := cp.StateToGroup(state.Name); grp != nil {
+			group = *grp
+		}
+		n := d.Graph.AddNode(state.Name, group, textSize(style.Lines, paddingX, paddingY))
+		d.nodes[n] = style
+	}
+
+	finalStates := workflow.FindFinalStates(wf)
+	if len(finalStates) > 0 {
+		n := d.Graph.AddNode(finalNode, "", layout.Size{Width: markerSize, Height: markerSize})
+		d.nodes[n] = &nodeStyle{Final: true, Fill: "black"}
+	}
+
+	plain := func(from, to string) {
+		e := d.Graph.AddEdge(from, to, layout.Size{})
+		d.edges[e] = &edgeStyle{Color: "black", Width: 1}
+	}
+	if initial != nil {
+		plain(initialNode, *initial)
+	}
+	for _, transition := range wf.Transitions {
+		label := string(transition.Eligible)
+		if transition.Action != nil {
+			label += " [" + string(*transition.Action) + "]"
+		}
+		lines := []string{label}
+		if stats := stats.EdgeLabel(transition.From, transition.To); stats != "" {
+			lines = append(lines, stats)
+		}
+		if steps := ov.EdgeLabel(transition.From, transition.To); steps != "" {
+			lines = append(lines, steps)
+		}
+		style := &edgeStyle{
+			Lines:   lines,
+			Tooltip: transition.Description,
+			Color:   "black",
+			Width:   1,
+			Dashed:  transition.Action != nil && *transition.Action == api.IMMEDIATE,
+		}
+		if ov.Traversed(transition.From, transition.To) {
+			style.Color = overlay.HighlightColor
+			style.Width = 2
+		}
+		e := d.Graph.AddEdge(transition.From, transition.To, textSize(lines, 0, 0))
+		d.edges[e] = style
+	}
+	for _, state := range finalStates {
+		plain(state, finalNode)
+	}
+
+	d.Graph.Layout()
+	return d
+}
+
+// textSize returns the extent of the given lines of text including padding on each side.
+func textSize(lines []string, padX, padY float64) layout.Size {
+	width := 0
+	for _, line := range lines {
+		width = max(width, len([]rune(line)))
+	}
+	if width == 0 {
+		return layout.Size{}
+	}
+	return layout.Size{
+		Width:  float64(width)*charWidth + 2*padX,
+		Height: float64(len(lines))*lineHeight + 2*padY,
+	}
+}
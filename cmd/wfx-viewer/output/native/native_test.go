@@ -0,0 +1,84 @@
+package native
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/color"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	d := New(dau.PhasedWorkflow(), nil, nil)
+	// states plus initial and final marker
+	assert.Len(t, d.Graph.Nodes, len(dau.PhasedWorkflow().States)+2)
+	assert.Len(t, d.Graph.Clusters, len(dau.PhasedWorkflow().Groups))
+	for _, e := range d.Graph.Edges {
+		assert.NotEmpty(t, e.Points, "%s -> %s", e.From, e.To)
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	job := api.Job{
+		Status:  &api.JobStatus{State: "INSTALLING"},
+		History: &[]api.History{{Status: &api.JobStatus{State: "INSTALL"}}},
+	}
+	buf := new(bytes.Buffer)
+	err := New(wf, nil, overlay.FromJob(wf, &job)).WriteSVG(buf)
+	require.NoError(t, err)
+
+	// well-formed XML
+	dec := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+	for {
+		_, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+	}
+	svg := buf.String()
+	assert.Contains(t, svg, "<title>wfx.workflow.dau.direct</title>")
+	assert.Contains(t, svg, ">WFX [IMMEDIATE]</text>")
+	assert.Contains(t, svg, `stroke-dasharray="5,3"`)
+	assert.Contains(t, svg, `stroke="red" stroke-width="3"`)
+	assert.Contains(t, svg, `marker-end="url(#arrow-red)"`)
+}
+
+func TestWritePNG(t *testing.T) {
+	d := New(dau.PhasedWorkflow(), nil, nil)
+	buf := new(bytes.Buffer)
+	require.NoError(t, d.WritePNG(buf))
+	img, err := png.Decode(buf)
+	require.NoError(t, err)
+	assert.Equal(t, int(d.Graph.Width+0.5), img.Bounds().Dx())
+
+	// the center of the initial marker is black
+	for _, n := range d.Graph.Nodes {
+		if n.ID == initialNode {
+			r, g, b, _ := img.At(int(n.X), int(n.Y)).RGBA()
+			assert.Zero(t, r+g+b)
+		}
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	assert.Equal(t, color.RGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff}, parseColor("#123456"))
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, parseColor("red"))
+	assert.Equal(t, color.RGBA{A: 0xff}, parseColor("bogus"))
+}
@@ -0,0 +1,208 @@
+package native
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/cmd/wfx-viewer/layout"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const arrowLength = 8.0
+
+// WritePNG renders the diagram as PNG image.
+func (d *Diagram) WritePNG(out io.Writer) error {
+	g := d.Graph
+	img := image.NewRGBA(image.Rect(0, 0, int(math.Ceil(g.Width)), int(math.Ceil(g.Height))))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	c := canvas{img: img}
+
+	for _, cl := range g.Clusters {
+		c.strokeRect(cl.X, cl.Y, cl.Width, cl.Height, 2, parseColor(d.groups[cl.Name]))
+	}
+
+	for _, e := range g.Edges {
+		style := d.edges[e]
+		if len(e.Points) < 2 {
+			continue
+		}
+		col := parseColor(style.Color)
+		points := e.Points
+		// shorten the last segment so that the line does not poke through the arrow head
+		last, prev := points[len(points)-1], points[len(points)-2]
+		dx, dy := last.X-prev.X, last.Y-prev.Y
+		length := math.Hypot(dx, dy)
+		if length == 0 {
+			continue
+		}
+		ux, uy := dx/length, dy/length
+		base := layout.Point{X: last.X - ux*arrowLength, Y: last.Y - uy*arrowLength}
+		dashOffset := 0.0
+		for i := 1; i < len(points); i++ {
+			to := points[i]
+			if i == len(points)-1 {
+				to = base
+			}
+			dashOffset = c.line(points[i-1], to, style.Width, col, style.Dashed, dashOffset)
+		}
+		c.triangle(last,
+			layout.Point{X: base.X - uy*arrowLength/2, Y: base.Y + ux*arrowLength/2},
+			layout.Point{X: base.X + uy*arrowLength/2, Y: base.Y - ux*arrowLength/2},
+			col)
+	}
+	// labels are drawn on top of all lines, with a white background to keep them readable
+	for _, cl := range g.Clusters {
+		x, y := cl.X+layout.ClusterPad/2, cl.Y+lineHeight+2
+		c.fillRect(x-1, y-lineHeight+2, float64(len([]rune(cl.Name)))*charWidth+2, lineHeight, color.White)
+		c.text(cl.Name, x, y, parseColor(d.groups[cl.Name]))
+	}
+	for _, e := range g.Edges {
+		style := d.edges[e]
+		if len(e.Points) < 2 || len(style.Lines) == 0 {
+			continue
+		}
+		c.fillRect(e.LabelPos.X-e.Label.Width/2-1, e.LabelPos.Y-e.Label.Height/2, e.Label.Width+2, e.Label.Height, color.White)
+		c.lines(style.Lines, e.LabelPos, e.Label.Height, parseColor(style.Color))
+	}
+
+	for _, n := range g.Nodes {
+		style := d.nodes[n]
+		switch {
+		case style.Initial:
+			c.circle(n.X, n.Y, n.Width/2, color.Black)
+		case style.Final:
+			c.circle(n.X, n.Y, n.Width/2, color.Black)
+			c.circle(n.X, n.Y, n.Width/2-1, color.White)
+			c.circle(n.X, n.Y, n.Width/2-3, color.Black)
+		default:
+			x, y := n.X-n.Width/2, n.Y-n.Height/2
+			c.fillRect(x, y, n.Width, n.Height, parseColor(style.Fill))
+			c.strokeRect(x, y, n.Width, n.Height, style.StrokeWidth, parseColor(style.Stroke))
+			c.lines(style.Lines, layout.Point{X: n.X, Y: n.Y}, n.Height-2*paddingY, parseColor(style.FontColor))
+		}
+	}
+	return fault.Wrap(png.Encode(out, img))
+}
+
+// canvas provides the drawing primitives needed for diagrams.
+type canvas struct {
+	img *image.RGBA
+}
+
+func (c *canvas) fillRect(x, y, w, h float64, col color.Color) {
+	r := image.Rect(int(math.Round(x)), int(math.Round(y)), int(math.Round(x+w)), int(math.Round(y+h)))
+	draw.Draw(c.img, r, image.NewUniform(col), image.Point{}, draw.Over)
+}
+
+func (c *canvas) strokeRect(x, y, w, h, width float64, col color.Color) {
+	c.fillRect(x, y, w, width, col)
+	c.fillRect(x, y+h-width, w, width, col)
+	c.fillRect(x, y, width, h, col)
+	c.fillRect(x+w-width, y, width, h, col)
+}
+
+// line draws a straight line and returns the updated dash offset, so that dashes continue across segments.
+func (c *canvas) line(from, to layout.Point, width float64, col color.Color, dashed bool, offset float64) float64 {
+	const dash, gap = 5.0, 3.0
+	length := math.Hypot(to.X-from.X, to.Y-from.Y)
+	steps := int(math.Ceil(length * 2))
+	for i := 0; i <= steps; i++ {
+		t := 0.0
+		if steps > 0 {
+			t = float64(i) / float64(steps)
+		}
+		if dashed && math.Mod(offset+t*length, dash+gap) >= dash {
+			continue
+		}
+		x := from.X + t*(to.X-from.X)
+		y := from.Y + t*(to.Y-from.Y)
+		c.fillRect(x-width/2, y-width/2, math.Max(width, 1), math.Max(width, 1), col)
+	}
+	return offset + length
+}
+
+func (c *canvas) triangle(a, b, p layout.Point, col color.Color) {
+	minX, maxX := math.Min(a.X, math.Min(b.X, p.X)), math.Max(a.X, math.Max(b.X, p.X))
+	minY, maxY := math.Min(a.Y, math.Min(b.Y, p.Y)), math.Max(a.Y, math.Max(b.Y, p.Y))
+	sign := func(p1, p2, p3 layout.Point) float64 {
+		return (p1.X-p3.X)*(p2.Y-p3.Y) - (p2.X-p3.X)*(p1.Y-p3.Y)
+	}
+	for y := int(math.Floor(minY)); y <= int(math.Ceil(maxY)); y++ {
+		for x := int(math.Floor(minX)); x <= int(math.Ceil(maxX)); x++ {
+			q := layout.Point{X: float64(x) + 0.5, Y: float64(y) + 0.5}
+			d1, d2, d3 := sign(q, a, b), sign(q, b, p), sign(q, p, a)
+			hasNeg := d1 < 0 || d2 < 0 || d3 < 0
+			hasPos := d1 > 0 || d2 > 0 || d3 > 0
+			if !(hasNeg && hasPos) {
+				c.img.Set(x, y, col)
+			}
+		}
+	}
+}
+
+func (c *canvas) circle(cx, cy, r float64, col color.Color) {
+	for y := int(math.Floor(cy - r)); y <= int(math.Ceil(cy+r)); y++ {
+		for x := int(math.Floor(cx - r)); x <= int(math.Ceil(cx+r)); x++ {
+			if math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy) <= r {
+				c.img.Set(x, y, col)
+			}
+		}
+	}
+}
+
+// lines draws lines of text centered at the given point.
+func (c *canvas) lines(lines []string, center layout.Point, height float64, col color.Color) {
+	top := center.Y - height/2
+	for i, line := range lines {
+		width := float64(len([]rune(line))) * charWidth
+		c.text(line, center.X-width/2, top+float64(i+1)*lineHeight-3, col)
+	}
+}
+
+// text draws a line of text; y denotes the baseline.
+func (c *canvas) text(s string, x, y float64, col color.Color) {
+	drawer := font.Drawer{
+		Dst:  c.img,
+		Src:  image.NewUniform(col),
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(int(math.Round(x)), int(math.Round(y))),
+	}
+	drawer.DrawString(s)
+}
+
+var namedColors = map[string]color.RGBA{
+	"black": {A: 0xff},
+	"white": {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	"red":   {R: 0xff, A: 0xff},
+}
+
+// parseColor understands the colors used by the diagrams: hex notation (#rrggbb) and a few names.
+// Unknown colors are rendered black.
+func parseColor(s string) color.RGBA {
+	if c, ok := namedColors[strings.ToLower(s)]; ok {
+		return c
+	}
+	if hex, ok := strings.CutPrefix(s, "#"); ok && len(hex) == 6 {
+		if v, err := strconv.ParseUint(hex, 16, 32); err == nil {
+			return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}
+		}
+	}
+	return namedColors["black"]
+}
@@ -0,0 +1,125 @@
+package native
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/cmd/wfx-viewer/layout"
+)
+
+// WriteSVG renders the diagram as a standalone SVG document.
+func (d *Diagram) WriteSVG(out io.Writer) error {
+	w := bufio.NewWriter(out)
+	g := d.Graph
+	_, _ = fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" font-family="monospace" font-size="12">`+"\n",
+		g.Width, g.Height, g.Width, g.Height)
+	_, _ = fmt.Fprintf(w, "<title>%s</title>\n", html.EscapeString(d.Title))
+	_, _ = w.WriteString("<defs>\n")
+	for _, color := range d.edgeColors() {
+		_, _ = fmt.Fprintf(w, `<marker id="%s" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" markerUnits="userSpaceOnUse" orient="auto"><path d="M0,0 L10,5 L0,10 z" fill="%s"/></marker>`+"\n",
+			markerID(color), html.EscapeString(color))
+	}
+	_, _ = w.WriteString("</defs>\n")
+	_, _ = fmt.Fprintf(w, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+
+	for _, c := range g.Clusters {
+		_, _ = fmt.Fprintf(w, `<g class="group"><title>%s</title>`, html.EscapeString(c.Name))
+		_, _ = fmt.Fprintf(w, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" rx="6" fill="none" stroke="%s" stroke-width="2"/>`,
+			c.X, c.Y, c.Width, c.Height, d.groups[c.Name])
+		_, _ = fmt.Fprintf(w, `<text x="%.1f" y="%.1f" font-weight="bold" fill="%s"`+halo+`>%s</text></g>`+"\n",
+			c.X+layout.ClusterPad/2, c.Y+lineHeight+2, d.groups[c.Name], html.EscapeString(c.Name))
+	}
+
+	for _, e := range g.Edges {
+		style := d.edges[e]
+		if len(e.Points) < 2 {
+			continue
+		}
+		_, _ = w.WriteString(`<g class="transition">`)
+		if style.Tooltip != "" {
+			_, _ = fmt.Fprintf(w, "<title>%s</title>", html.EscapeString(style.Tooltip))
+		}
+		var path strings.Builder
+		for i, p := range e.Points {
+			cmd := "L"
+			if i == 0 {
+				cmd = "M"
+			}
+			_, _ = fmt.Fprintf(&path, "%s%.1f,%.1f ", cmd, p.X, p.Y)
+		}
+		dash := ""
+		if style.Dashed {
+			dash = ` stroke-dasharray="5,3"`
+		}
+		_, _ = fmt.Fprintf(w, `<path d="%s" fill="none" stroke="%s" stroke-width="%.0f"%s marker-end="url(#%s)"/>`,
+			strings.TrimSpace(path.String()), html.EscapeString(style.Color), style.Width, dash, markerID(style.Color))
+		writeText(w, style.Lines, e.LabelPos, e.Label.Height, style.Color, halo)
+		_, _ = w.WriteString("</g>\n")
+	}
+
+	for _, n := range g.Nodes {
+		style := d.nodes[n]
+		switch {
+		case style.Initial:
+			_, _ = fmt.Fprintf(w, `<circle cx="%.1f" cy="%.1f" r="%.1f" fill="black"/>`+"\n", n.X, n.Y, n.Width/2)
+		case style.Final:
+			_, _ = fmt.Fprintf(w, `<circle cx="%.1f" cy="%.1f" r="%.1f" fill="white" stroke="black"/><circle cx="%.1f" cy="%.1f" r="%.1f" fill="black"/>`+"\n",
+				n.X, n.Y, n.Width/2, n.X, n.Y, n.Width/2-3)
+		default:
+			_, _ = w.WriteString(`<g class="state">`)
+			if style.Tooltip != "" {
+				_, _ = fmt.Fprintf(w, "<title>%s</title>", html.EscapeString(style.Tooltip))
+			}
+			_, _ = fmt.Fprintf(w, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" rx="8" fill="%s" stroke="%s" stroke-width="%.0f"/>`,
+				n.X-n.Width/2, n.Y-n.Height/2, n.Width, n.Height, html.EscapeString(style.Fill), html.EscapeString(style.Stroke), style.StrokeWidth)
+			writeText(w, style.Lines, layout.Point{X: n.X, Y: n.Y}, n.Height-2*paddingY, style.FontColor, "")
+			_, _ = w.WriteString("</g>\n")
+		}
+	}
+	_, _ = w.WriteString("</svg>\n")
+	return fault.Wrap(w.Flush())
+}
+
+// halo outlines text in white, so that it remains readable when crossed by lines.
+const halo = ` stroke="white" stroke-width="3" paint-order="stroke"`
+
+// writeText writes lines of text centered at the given point.
+func writeText(w io.Writer, lines []string, center layout.Point, height float64, color, attrs string) {
+	top := center.Y - height/2
+	for i, line := range lines {
+		// the baseline is slightly above the bottom of the line
+		y := top + float64(i+1)*lineHeight - 3
+		_, _ = fmt.Fprintf(w, `<text x="%.1f" y="%.1f" text-anchor="middle" fill="%s"%s>%s</text>`,
+			center.X, y, html.EscapeString(color), attrs, html.EscapeString(line))
+	}
+}
+
+// edgeColors returns the distinct colors used by edges, in order of appearance.
+func (d *Diagram) edgeColors() []string {
+	var result []string
+	seen := map[string]bool{}
+	for _, e := range d.Graph.Edges {
+		color := d.edges[e].Color
+		if !seen[color] {
+			seen[color] = true
+			result = append(result, color)
+		}
+	}
+	return result
+}
+
+func markerID(color string) string {
+	return "arrow-" + strings.TrimPrefix(color, "#")
+}
@@ -13,11 +13,16 @@ import (
 	"io"
 
 	"github.com/siemens/wfx/cmd/wfx-viewer/colors"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/spf13/pflag"
 )
 
-type Generator struct{}
+type Generator struct {
+	stats   *stats.Stats
+	overlay *overlay.Overlay
+}
 
 func NewGenerator() *Generator {
 	return &Generator{}
@@ -25,6 +30,14 @@ func NewGenerator() *Generator {
 
 func (g *Generator) RegisterFlags(_ *pflag.FlagSet) {}
 
+func (g *Generator) SetStats(stats *stats.Stats) {
+	g.stats = stats
+}
+
+func (g *Generator) SetOverlay(overlay *overlay.Overlay) {
+	g.overlay = overlay
+}
+
 func (g *Generator) Generate(out io.Writer, workflow *api.Workflow) error {
 	_, _ = out.Write([]byte("@startuml\n"))
 
@@ -37,27 +50,55 @@ func (g *Generator) Generate(out io.Writer, workflow *api.Workflow) error {
 
 	for _, state := range workflow.States {
 		fgColor, bgColor := cp.StateColor(state.Name)
+		if g.overlay.Aggregate() {
+			fgColor, bgColor = g.overlay.StateColor(state.Name)
+		}
+		if g.overlay.IsCurrent(state.Name) {
+			bgColor += ";line:" + overlay.HighlightColor + ";line.bold"
+		}
 		_, _ = fmt.Fprintf(out, "state %s as \"<color:%s>%s</color>\" %s: %s\n", state.Name, fgColor, state.Name, bgColor, state.Description)
+		if g.overlay.Aggregate() {
+			_, _ = fmt.Fprintf(out, "%s: <b>%d job(s)</b>\n", state.Name, g.overlay.StateCount(state.Name))
+		}
 	}
 
 	// add transitions
 	for _, transition := range workflow.Transitions {
-		_, _ = fmt.Fprintf(out, "%s --> %s: %s", transition.From, transition.To, string(transition.Eligible))
+		arrow := "-->"
+		if g.overlay.Traversed(transition.From, transition.To) {
+			arrow = fmt.Sprintf("-[#%s,bold]->", overlay.HighlightColor)
+		}
+		_, _ = fmt.Fprintf(out, "%s %s %s: %s", transition.From, arrow, transition.To, string(transition.Eligible))
 		if transition.Action != nil {
 			_, _ = fmt.Fprintf(out, " [%s]", string(*transition.Action))
 		}
+		if label := g.stats.EdgeLabel(transition.From, transition.To); label != "" {
+			_, _ = fmt.Fprintf(out, "\\n%s", label)
+		}
+		if label := g.overlay.EdgeLabel(transition.From, transition.To); label != "" {
+			_, _ = fmt.Fprintf(out, "\\n%s", label)
+		}
 		_, _ = out.Write([]byte("\n"))
 	}
 
 	// add legend
 	_, _ = out.Write([]byte("legend right\n"))
-	_, _ = out.Write([]byte("  | Color | Group | Description |\n"))
-	for _, group := range workflow.Groups {
-		color := cp.GroupColor(group.Name)
-		hex := color.ToHEX().String()
-		_, _ = fmt.Fprintf(out, "  | <%s> | %s | %s |\n", hex, group.Name, group.Description)
+	if g.overlay.Aggregate() {
+		// states are colored by the number of jobs instead of their group
+		_, low := colors.HeatColor(0)
+		_, high := colors.HeatColor(1)
+		_, _ = out.Write([]byte("  | Color | Jobs |\n"))
+		_, _ = fmt.Fprintf(out, "  | <%s> | 0 |\n", low)
+		_, _ = fmt.Fprintf(out, "  | <%s> | %d |\n", high, g.overlay.MaxCount())
+	} else {
+		_, _ = out.Write([]byte("  | Color | Group | Description |\n"))
+		for _, group := range workflow.Groups {
+			color := cp.GroupColor(group.Name)
+			hex := color.ToHEX().String()
+			_, _ = fmt.Fprintf(out, "  | <%s> | %s | %s |\n", hex, group.Name, group.Description)
+		}
+		_, _ = fmt.Fprintf(out, "  | <%s> | %s | %s |\n", colors.DefaultBgColor, "", "The state doesn't belong to any group.")
 	}
-	_, _ = fmt.Fprintf(out, "  | <%s> | %s | %s |\n", colors.DefaultBgColor, "", "The state doesn't belong to any group.")
 	_, _ = out.Write([]byte("endlegend\n"))
 
 	_, _ = out.Write([]byte("@enduml\n"))
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
+	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/workflow/dau"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -45,3 +48,45 @@ endlegend
 `
 	assert.Equal(t, exepcted, actual)
 }
+
+func TestGenerate_Stats(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	gen.SetStats(stats.New(&api.WorkflowStats{
+		States:      []api.StateStats{{State: "INSTALL", Count: 3, P50: 2}},
+		Transitions: []api.TransitionStats{{From: "INSTALL", To: "INSTALLING", Count: 3}},
+	}))
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "INSTALL --> INSTALLING: CLIENT\\n3x, p50 2s\n")
+}
+
+func TestGenerate_Overlay(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	gen.SetOverlay(overlay.FromJob(dau.DirectWorkflow(), &api.Job{
+		Status:  &api.JobStatus{State: "INSTALLING"},
+		History: &[]api.History{{Status: &api.JobStatus{State: "INSTALL"}}},
+	}))
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `state INSTALLING as "<color:black>INSTALLING</color>" #00cc00;line:red;line.bold: installation progress update from client`+"\n")
+	assert.Contains(t, buf.String(), "INSTALL -[#red,bold]-> INSTALLING: CLIENT\\n#1\n")
+	assert.Contains(t, buf.String(), "INSTALL --> TERMINATED: CLIENT\n")
+}
+
+func TestGenerate_Aggregate(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gen := NewGenerator()
+	gen.SetOverlay(overlay.FromJobs(dau.DirectWorkflow(), []api.Job{
+		{Status: &api.JobStatus{State: "INSTALLING"}},
+		{Status: &api.JobStatus{State: "INSTALLING"}},
+		{Status: &api.JobStatus{State: "ACTIVATED"}},
+	}))
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `state INSTALLING as "<color:black>INSTALLING</color>" #ff0000: installation progress update from client`+"\nINSTALLING: <b>2 job(s)</b>\n")
+	assert.Contains(t, buf.String(), `state ACTIVATED as "<color:black>ACTIVATED</color>" #ff7f7f: client signaled activation success`+"\nACTIVATED: <b>1 job(s)</b>\n")
+	assert.Contains(t, buf.String(), "  | <#ff0000> | 2 |\n")
+	assert.NotContains(t, buf.String(), "OPEN")
+}
@@ -0,0 +1,43 @@
+package png
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"io"
+
+	"github.com/siemens/wfx/cmd/wfx-viewer/output/native"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/spf13/pflag"
+)
+
+// Generator renders the workflow as PNG image without any external service.
+type Generator struct {
+	stats   *stats.Stats
+	overlay *overlay.Overlay
+}
+
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+func (g *Generator) RegisterFlags(_ *pflag.FlagSet) {}
+
+func (g *Generator) SetStats(stats *stats.Stats) {
+	g.stats = stats
+}
+
+func (g *Generator) SetOverlay(overlay *overlay.Overlay) {
+	g.overlay = overlay
+}
+
+func (g *Generator) Generate(out io.Writer, workflow *api.Workflow) error {
+	return native.New(workflow, g.stats, g.overlay).WritePNG(out)
+}
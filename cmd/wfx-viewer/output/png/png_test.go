@@ -0,0 +1,30 @@
+package png
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := NewGenerator().Generate(buf, dau.PhasedWorkflow())
+	require.NoError(t, err)
+
+	img, err := png.Decode(buf)
+	require.NoError(t, err)
+	assert.Positive(t, img.Bounds().Dx())
+	assert.Positive(t, img.Bounds().Dy())
+}
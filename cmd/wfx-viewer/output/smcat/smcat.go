@@ -14,12 +14,17 @@ import (
 	"strings"
 
 	"github.com/siemens/wfx/cmd/wfx-viewer/colors"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/workflow"
 	"github.com/spf13/pflag"
 )
 
-type Generator struct{}
+type Generator struct {
+	stats   *stats.Stats
+	overlay *overlay.Overlay
+}
 
 func NewGenerator() *Generator {
 	return &Generator{}
@@ -27,6 +32,14 @@ func NewGenerator() *Generator {
 
 func (g *Generator) RegisterFlags(_ *pflag.FlagSet) {}
 
+func (g *Generator) SetStats(stats *stats.Stats) {
+	g.stats = stats
+}
+
+func (g *Generator) SetOverlay(overlay *overlay.Overlay) {
+	g.overlay = overlay
+}
+
 func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
 	cp := colors.NewColorPalette(wf)
 
@@ -35,7 +48,16 @@ func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
 
 	for _, state := range wf.States {
 		_, bgColor := cp.StateColor(state.Name)
-		states = append(states, fmt.Sprintf(`%s [color="%s"]`, state.Name, bgColor))
+		attrs := make([]string, 0, 3)
+		if g.overlay.Aggregate() {
+			_, bgColor = g.overlay.StateColor(state.Name)
+			attrs = append(attrs, fmt.Sprintf(`label="%s (%d)"`, state.Name, g.overlay.StateCount(state.Name)))
+		}
+		attrs = append(attrs, fmt.Sprintf(`color="%s"`, bgColor))
+		if g.overlay.IsCurrent(state.Name) {
+			attrs = append(attrs, "active")
+		}
+		states = append(states, fmt.Sprintf(`%s [%s]`, state.Name, strings.Join(attrs, " ")))
 	}
 	states = append(states, "final")
 
@@ -48,8 +70,17 @@ func (g *Generator) Generate(out io.Writer, wf *api.Workflow) error {
 		_, _ = out.Write([]byte(transition.From))
 		_, _ = out.Write([]byte(" => "))
 		_, _ = out.Write([]byte(transition.To))
+		if g.overlay.Traversed(transition.From, transition.To) {
+			_, _ = fmt.Fprintf(out, ` [color="%s" width=3]`, overlay.HighlightColor)
+		}
 		_, _ = out.Write([]byte(": "))
 		_, _ = out.Write([]byte(transition.Eligible))
+		if label := g.stats.EdgeLabel(transition.From, transition.To); label != "" {
+			_, _ = fmt.Fprintf(out, " (%s)", label)
+		}
+		if label := g.overlay.EdgeLabel(transition.From, transition.To); label != "" {
+			_, _ = fmt.Fprintf(out, " [%s]", label)
+		}
 		_, _ = out.Write([]byte(";\n"))
 	}
 
@@ -10,20 +10,36 @@ package svg
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 
 	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/cmd/wfx-viewer/output/native"
 	"github.com/siemens/wfx/cmd/wfx-viewer/output/plantuml"
+	"github.com/siemens/wfx/cmd/wfx-viewer/overlay"
+	"github.com/siemens/wfx/cmd/wfx-viewer/stats"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/spf13/pflag"
 )
 
-const krokiURLFlag = "kroki-url"
+const (
+	krokiURLFlag = "kroki-url"
+	rendererFlag = "svg-renderer"
+)
+
+const (
+	// RendererNative lays out and renders the diagram locally.
+	RendererNative = "native"
+	// RendererKroki sends the PlantUML diagram to a Kroki server, see https://kroki.io
+	RendererKroki = "kroki"
+)
 
 type Generator struct {
-	f *pflag.FlagSet
+	f       *pflag.FlagSet
+	stats   *stats.Stats
+	overlay *overlay.Overlay
 }
 
 func NewGenerator() *Generator {
@@ -31,11 +47,38 @@ func NewGenerator() *Generator {
 }
 
 func (s *Generator) RegisterFlags(f *pflag.FlagSet) {
-	f.String(krokiURLFlag, "https://kroki.io/plantuml/svg", "url to kroki (used for svg)")
+	f.String(rendererFlag, RendererNative, fmt.Sprintf("svg renderer (used for svg), one of: %s, %s", RendererNative, RendererKroki))
+	f.String(krokiURLFlag, "https://kroki.io/plantuml/svg", "url to kroki (used for svg with --"+rendererFlag+"="+RendererKroki+")")
 	s.f = f
 }
 
+func (s *Generator) SetStats(stats *stats.Stats) {
+	s.stats = stats
+}
+
+func (s *Generator) SetOverlay(overlay *overlay.Overlay) {
+	s.overlay = overlay
+}
+
 func (s *Generator) Generate(out io.Writer, workflow *api.Workflow) error {
+	renderer := RendererNative
+	if s.f != nil {
+		var err error
+		if renderer, err = s.f.GetString(rendererFlag); err != nil {
+			return fault.Wrap(err)
+		}
+	}
+	switch renderer {
+	case RendererNative:
+		return native.New(workflow, s.stats, s.overlay).WriteSVG(out)
+	case RendererKroki:
+		return s.kroki(out, workflow)
+	default:
+		return fmt.Errorf("invalid %s: %s", rendererFlag, renderer)
+	}
+}
+
+func (s *Generator) kroki(out io.Writer, workflow *api.Workflow) error {
 	body := new(bytes.Buffer)
 	writer := multipart.NewWriter(body)
 	w, err := writer.CreateFormFile("file", "workflow")
@@ -43,7 +86,10 @@ func (s *Generator) Generate(out io.Writer, workflow *api.Workflow) error {
 		return fault.Wrap(err)
 	}
 
-	if err := plantuml.NewGenerator().Generate(w, workflow); err != nil {
+	gen := plantuml.NewGenerator()
+	gen.SetStats(s.stats)
+	gen.SetOverlay(s.overlay)
+	if err := gen.Generate(w, workflow); err != nil {
 		return fault.Wrap(err)
 	}
 
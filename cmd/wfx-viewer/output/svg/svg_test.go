@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/siemens/wfx/workflow/dau"
@@ -22,6 +23,26 @@ import (
 )
 
 func TestGenerate(t *testing.T) {
+	gen := NewGenerator()
+	gen.RegisterFlags(pflag.NewFlagSet("", pflag.ContinueOnError))
+
+	buf := new(bytes.Buffer)
+	err := gen.Generate(buf, dau.DirectWorkflow())
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(buf.String(), "<svg "))
+	assert.Contains(t, buf.String(), ">INSTALLING</text>")
+}
+
+func TestGenerate_InvalidRenderer(t *testing.T) {
+	gen := NewGenerator()
+	f := pflag.NewFlagSet("", pflag.ContinueOnError)
+	gen.RegisterFlags(f)
+	_ = f.Set(rendererFlag, "foo")
+	err := gen.Generate(new(bytes.Buffer), dau.DirectWorkflow())
+	assert.ErrorContains(t, err, "invalid svg-renderer: foo")
+}
+
+func TestGenerate_Kroki(t *testing.T) {
 	var body []byte
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		body, _ = io.ReadAll(r.Body)
@@ -33,6 +54,7 @@ func TestGenerate(t *testing.T) {
 	gen := NewGenerator()
 	f := pflag.NewFlagSet("", pflag.ContinueOnError)
 	gen.RegisterFlags(f)
+	_ = f.Set(rendererFlag, RendererKroki)
 	_ = f.Set(krokiURLFlag, server.URL)
 
 	buf := new(bytes.Buffer)
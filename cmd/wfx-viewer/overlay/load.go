@@ -0,0 +1,92 @@
+package overlay
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/generated/api"
+)
+
+// queryPageSize is the number of jobs fetched per request.
+const queryPageSize = 100
+
+// LoadJob parses a job in JSON format, e.g. the output of `wfxctl job get --history`.
+func LoadJob(r io.Reader) (*api.Job, error) {
+	var job api.Job
+	if err := json.NewDecoder(r).Decode(&job); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return &job, nil
+}
+
+// LoadJobs parses a list of jobs in JSON format, either a plain array or a paginated list
+// (e.g. the output of `wfxctl job query`).
+func LoadJobs(r io.Reader) ([]api.Job, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '[' {
+		var jobs []api.Job
+		if err := json.Unmarshal(b, &jobs); err != nil {
+			return nil, fault.Wrap(err)
+		}
+		return jobs, nil
+	}
+	var list api.PaginatedJobList
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return list.Content, nil
+}
+
+// FetchJob retrieves a job including its history from wfx.
+func FetchJob(ctx context.Context, client api.ClientWithResponsesInterface, id string) (*api.Job, error) {
+	history := true
+	resp, err := client.GetJobsIdWithResponse(ctx, id, &api.GetJobsIdParams{ParamHistory: &history})
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("failed to fetch job %s: %s", id, resp.Status())
+	}
+	return resp.JSON200, nil
+}
+
+// QueryJobs retrieves all jobs of the workflow from wfx, optionally restricted to jobs having one of the tags.
+func QueryJobs(ctx context.Context, client api.ClientWithResponsesInterface, workflow string, tags []string) ([]api.Job, error) {
+	params := api.GetJobsParams{ParamWorkflow: &workflow}
+	if len(tags) > 0 {
+		params.ParamTag = &tags
+	}
+	limit := int32(queryPageSize)
+	params.ParamLimit = &limit
+
+	var result []api.Job
+	for offset := int64(0); ; offset += queryPageSize {
+		params.ParamOffset = &offset
+		resp, err := client.GetJobsWithResponse(ctx, &params)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to query jobs: %s", resp.Status())
+		}
+		result = append(result, resp.JSON200.Content...)
+		if len(resp.JSON200.Content) < queryPageSize {
+			return result, nil
+		}
+	}
+}
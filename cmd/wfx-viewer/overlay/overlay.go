@@ -0,0 +1,216 @@
+package overlay
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/siemens/wfx/cmd/wfx-viewer/colors"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+)
+
+// HighlightColor is used for the current state and the traversed transitions of a job.
+const HighlightColor = "red"
+
+const timeFormat = time.DateTime
+
+// Overlay highlights jobs on a workflow diagram. It either shows the position and history of a
+// single job (see FromJob) or the number of jobs per state (see FromJobs).
+// A nil *Overlay is valid and yields no highlights.
+type Overlay struct {
+	// single job
+	current string
+	steps   map[transition][]step
+	// number of transitions taken so far
+	n int
+
+	// aggregate
+	counts   map[string]int
+	maxCount int
+}
+
+type transition struct {
+	from, to string
+}
+
+type step struct {
+	index int
+	time  time.Time
+}
+
+// FromJob replays the history of the job. Consecutive updates without a state change (e.g. progress
+// updates) are merged. Immediate transitions taken by wfx are reconstructed from the workflow, since
+// the history only contains the state the job came to rest in.
+func FromJob(wf *api.Workflow, job *api.Job) *Overlay {
+	type event struct {
+		state string
+		time  time.Time
+	}
+	var events []event
+	if initial := workflow.FindInitialState(wf); initial != nil {
+		var stime time.Time
+		if job.Stime != nil {
+			stime = *job.Stime
+		}
+		events = append(events, event{state: *initial, time: stime})
+	}
+	if job.History != nil {
+		// the history is ordered last in, first out
+		history := *job.History
+		for i := len(history) - 1; i >= 0; i-- {
+			h := history[i]
+			if h.Status == nil {
+				// definition update
+				continue
+			}
+			var mtime time.Time
+			if h.Mtime != nil {
+				mtime = *h.Mtime
+			}
+			events = append(events, event{state: h.Status.State, time: mtime})
+		}
+	}
+	if job.Status != nil {
+		var mtime time.Time
+		if job.Mtime != nil {
+			mtime = *job.Mtime
+		}
+		events = append(events, event{state: job.Status.State, time: mtime})
+	}
+
+	o := &Overlay{steps: make(map[transition][]step)}
+	if len(events) == 0 {
+		return o
+	}
+	// the job is created in the initial state, but wfx follows immediate transitions right away
+	current := events[0].state
+	for _, hop := range immediateHops(wf, current) {
+		o.add(hop, events[0].time)
+		current = hop.to
+	}
+	for _, ev := range events[1:] {
+		if ev.state == current {
+			continue
+		}
+		for _, hop := range resolve(wf, current, ev.state) {
+			o.add(hop, ev.time)
+		}
+		current = ev.state
+	}
+	o.current = current
+	return o
+}
+
+// FromJobs counts the jobs per state. Jobs belonging to other workflows are ignored.
+func FromJobs(wf *api.Workflow, jobs []api.Job) *Overlay {
+	o := &Overlay{counts: make(map[string]int, len(wf.States))}
+	for _, job := range jobs {
+		if job.Status == nil || (job.Workflow != nil && job.Workflow.Name != "" && job.Workflow.Name != wf.Name) {
+			continue
+		}
+		o.counts[job.Status.State]++
+		o.maxCount = max(o.maxCount, o.counts[job.Status.State])
+	}
+	return o
+}
+
+func (o *Overlay) add(t transition, at time.Time) {
+	o.n++
+	o.steps[t] = append(o.steps[t], step{index: o.n, time: at})
+}
+
+// resolve returns the transitions which lead from one state to another, taking immediate transitions into account.
+// The result is empty if there is no such transition, e.g. because the history was truncated.
+func resolve(wf *api.Workflow, from, to string) []transition {
+	for _, t := range wf.Transitions {
+		if t.From != from || t.To == from {
+			continue
+		}
+		hops := append([]transition{{from: from, to: t.To}}, immediateHops(wf, t.To)...)
+		if hops[len(hops)-1].to == to {
+			return hops
+		}
+	}
+	return nil
+}
+
+func immediateHops(wf *api.Workflow, from string) []transition {
+	path := workflow.ImmediatePath(wf, from)
+	result := make([]transition, 0, len(path)-1)
+	for i := 1; i < len(path); i++ {
+		result = append(result, transition{from: path[i-1], to: path[i]})
+	}
+	return result
+}
+
+// IsCurrent reports whether the job is currently in the given state.
+func (o *Overlay) IsCurrent(state string) bool {
+	return o != nil && o.current != "" && o.current == state
+}
+
+// Traversed reports whether the job took the transition.
+func (o *Overlay) Traversed(from, to string) bool {
+	if o == nil {
+		return false
+	}
+	_, ok := o.steps[transition{from: from, to: to}]
+	return ok
+}
+
+// EdgeLabel returns the numbers and times of the steps in which the job took the transition,
+// e.g. "#2 2026-10-19 08:15:00". If the transition was not taken, the result is empty.
+func (o *Overlay) EdgeLabel(from, to string) string {
+	if o == nil {
+		return ""
+	}
+	steps := o.steps[transition{from: from, to: to}]
+	labels := make([]string, 0, len(steps))
+	for _, s := range steps {
+		label := fmt.Sprintf("#%d", s.index)
+		if !s.time.IsZero() {
+			label += " " + s.time.UTC().Format(timeFormat)
+		}
+		labels = append(labels, label)
+	}
+	return strings.Join(labels, ", ")
+}
+
+// Aggregate reports whether the overlay shows the number of jobs per state.
+func (o *Overlay) Aggregate() bool {
+	return o != nil && o.counts != nil
+}
+
+// StateCount returns the number of jobs in the given state.
+func (o *Overlay) StateCount(state string) int {
+	if o == nil {
+		return 0
+	}
+	return o.counts[state]
+}
+
+// MaxCount returns the highest number of jobs in a single state.
+func (o *Overlay) MaxCount() int {
+	if o == nil {
+		return 0
+	}
+	return o.maxCount
+}
+
+// StateColor returns the foreground and background color of a state in aggregate mode,
+// where states with more jobs are colored more intensely.
+func (o *Overlay) StateColor(state string) (string, string) {
+	fraction := 0.0
+	if o.MaxCount() > 0 {
+		fraction = float64(o.StateCount(state)) / float64(o.MaxCount())
+	}
+	return colors.HeatColor(fraction)
+}
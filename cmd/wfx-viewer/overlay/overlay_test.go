@@ -0,0 +1,177 @@
+package overlay
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func at(minute int) *time.Time {
+	t := time.Date(2026, 10, 19, 8, minute, 0, 0, time.UTC)
+	return &t
+}
+
+func TestFromJob(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	job := api.Job{
+		Stime:  at(0),
+		Mtime:  at(4),
+		Status: &api.JobStatus{State: "ACTIVATING"},
+		// last in, first out
+		History: &[]api.History{
+			{Mtime: at(3), Status: &api.JobStatus{State: "ACTIVATE"}},
+			{Mtime: at(2), Status: &api.JobStatus{State: "INSTALLING", Message: "50%"}},
+			{Mtime: at(2), Definition: &map[string]any{"foo": "bar"}},
+			{Mtime: at(1), Status: &api.JobStatus{State: "INSTALLING"}},
+			{Mtime: at(0), Status: &api.JobStatus{State: "INSTALL"}},
+		},
+	}
+	o := FromJob(wf, &job)
+
+	assert.True(t, o.IsCurrent("ACTIVATING"))
+	assert.False(t, o.IsCurrent("INSTALL"))
+	assert.Equal(t, "#1 2026-10-19 08:01:00", o.EdgeLabel("INSTALL", "INSTALLING"))
+	// the immediate transition INSTALLED -> ACTIVATE is not part of the history
+	assert.Equal(t, "#2 2026-10-19 08:03:00", o.EdgeLabel("INSTALLING", "INSTALLED"))
+	assert.Equal(t, "#3 2026-10-19 08:03:00", o.EdgeLabel("INSTALLED", "ACTIVATE"))
+	assert.Equal(t, "#4 2026-10-19 08:04:00", o.EdgeLabel("ACTIVATE", "ACTIVATING"))
+	assert.True(t, o.Traversed("INSTALLED", "ACTIVATE"))
+	// progress updates are merged
+	assert.False(t, o.Traversed("INSTALLING", "INSTALLING"))
+	assert.False(t, o.Traversed("INSTALL", "TERMINATED"))
+	assert.Empty(t, o.EdgeLabel("INSTALL", "TERMINATED"))
+	assert.False(t, o.Aggregate())
+}
+
+func TestFromJob_TruncatedHistory(t *testing.T) {
+	job := api.Job{
+		Status:  &api.JobStatus{State: "ACTIVATED"},
+		History: &[]api.History{{Status: &api.JobStatus{State: "ACTIVATING"}}},
+	}
+	o := FromJob(dau.DirectWorkflow(), &job)
+	assert.True(t, o.IsCurrent("ACTIVATED"))
+	assert.Equal(t, "#1", o.EdgeLabel("ACTIVATING", "ACTIVATED"))
+	// INSTALL -> ACTIVATING is not a transition of the workflow
+	assert.False(t, o.Traversed("INSTALL", "ACTIVATING"))
+}
+
+func TestFromJobs(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	jobs := []api.Job{
+		{Status: &api.JobStatus{State: "INSTALLING"}},
+		{Status: &api.JobStatus{State: "INSTALLING"}, Workflow: &api.Workflow{Name: wf.Name}},
+		{Status: &api.JobStatus{State: "ACTIVATED"}},
+		{Status: &api.JobStatus{State: "INSTALLING"}, Workflow: &api.Workflow{Name: "other"}},
+	}
+	o := FromJobs(wf, jobs)
+	assert.True(t, o.Aggregate())
+	assert.Equal(t, 2, o.StateCount("INSTALLING"))
+	assert.Equal(t, 1, o.StateCount("ACTIVATED"))
+	assert.Equal(t, 0, o.StateCount("INSTALL"))
+	assert.Equal(t, 2, o.MaxCount())
+
+	_, bg := o.StateColor("INSTALLING")
+	assert.Equal(t, "#ff0000", bg)
+	_, bg = o.StateColor("INSTALL")
+	assert.Equal(t, "#ffffff", bg)
+}
+
+func TestNil(t *testing.T) {
+	var o *Overlay
+	assert.False(t, o.IsCurrent("INSTALL"))
+	assert.False(t, o.Traversed("INSTALL", "INSTALLING"))
+	assert.Empty(t, o.EdgeLabel("INSTALL", "INSTALLING"))
+	assert.False(t, o.Aggregate())
+	assert.Zero(t, o.StateCount("INSTALL"))
+	assert.Zero(t, o.MaxCount())
+}
+
+func TestLoadJobs(t *testing.T) {
+	jobs, err := LoadJobs(strings.NewReader(`[{"id": "1", "status": {"state": "INSTALL"}}]`))
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "1", jobs[0].ID)
+
+	jobs, err = LoadJobs(strings.NewReader(`{"content": [{"id": "1"}, {"id": "2"}]}`))
+	require.NoError(t, err)
+	assert.Len(t, jobs, 2)
+
+	_, err = LoadJobs(strings.NewReader("foo"))
+	assert.Error(t, err)
+}
+
+func TestLoadJob(t *testing.T) {
+	job, err := LoadJob(strings.NewReader(`{"id": "1", "history": [{"status": {"state": "INSTALL"}}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "1", job.ID)
+	assert.Len(t, *job.History, 1)
+}
+
+func TestFetchJob(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/wfx/v1/jobs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "true", r.URL.Query().Get("history"))
+		w.Header().Add("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.Job{ID: "1"})
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	client, err := api.NewClientWithResponses(ts.URL + "/api/wfx/v1")
+	require.NoError(t, err)
+
+	job, err := FetchJob(t.Context(), client, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "1", job.ID)
+
+	_, err = FetchJob(t.Context(), client, "2")
+	assert.ErrorContains(t, err, "failed to fetch job 2: 404 Not Found")
+}
+
+func TestQueryJobs(t *testing.T) {
+	const total = queryPageSize + 5
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/wfx/v1/jobs", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "wfx.workflow.dau.direct", q.Get("workflow"))
+		assert.Equal(t, "canary", q.Get("tag"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		var list api.PaginatedJobList
+		for i := offset; i < min(offset+limit, total); i++ {
+			list.Content = append(list.Content, api.Job{ID: strconv.Itoa(i)})
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(list)
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	client, err := api.NewClientWithResponses(ts.URL + "/api/wfx/v1")
+	require.NoError(t, err)
+
+	jobs, err := QueryJobs(t.Context(), client, "wfx.workflow.dau.direct", []string{"canary"})
+	require.NoError(t, err)
+	assert.Len(t, jobs, total)
+}
@@ -0,0 +1,88 @@
+package stats
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/generated/api"
+)
+
+// Stats annotates the edges of a workflow diagram with the output of `wfxctl workflow stats`.
+// A nil *Stats is valid and yields no annotations.
+type Stats struct {
+	workflow    string
+	states      map[string]api.StateStats
+	transitions map[transition]int64
+}
+
+type transition struct {
+	from, to string
+}
+
+// Load parses the JSON representation of api.WorkflowStats.
+func Load(r io.Reader) (*Stats, error) {
+	var stats api.WorkflowStats
+	if err := json.NewDecoder(r).Decode(&stats); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return New(&stats), nil
+}
+
+func New(stats *api.WorkflowStats) *Stats {
+	result := &Stats{
+		workflow:    stats.Workflow,
+		states:      make(map[string]api.StateStats, len(stats.States)),
+		transitions: make(map[transition]int64, len(stats.Transitions)),
+	}
+	for _, s := range stats.States {
+		result.states[s.State] = s
+	}
+	for _, t := range stats.Transitions {
+		result.transitions[transition{from: t.From, to: t.To}] = t.Count
+	}
+	return result
+}
+
+// Workflow returns the name of the workflow the statistics belong to.
+func (s *Stats) Workflow() string {
+	if s == nil {
+		return ""
+	}
+	return s.workflow
+}
+
+// EdgeLabel returns how often the transition was taken and the median time spent in the
+// source state, e.g. "12x, p50 3s". If there is no data for the transition, the result is empty.
+func (s *Stats) EdgeLabel(from, to string) string {
+	if s == nil {
+		return ""
+	}
+	count, ok := s.transitions[transition{from: from, to: to}]
+	if !ok {
+		return ""
+	}
+	label := fmt.Sprintf("%dx", count)
+	if state, ok := s.states[from]; ok {
+		label += ", p50 " + formatSeconds(state.P50)
+	}
+	return label
+}
+
+func formatSeconds(seconds float64) string {
+	d := time.Duration(seconds * float64(time.Second))
+	if d >= time.Second {
+		return d.Round(time.Second).String()
+	}
+	return d.Round(time.Millisecond).String()
+}
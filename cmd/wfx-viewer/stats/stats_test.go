@@ -0,0 +1,50 @@
+package stats
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	stats, err := Load(strings.NewReader(`{
+  "workflow": "wfx.workflow.dau.direct",
+  "jobs": 12,
+  "states": [{"state": "INSTALL", "count": 12, "p50": 3.25, "p95": 10, "p99": 20}],
+  "transitions": [
+    {"from": "INSTALL", "to": "INSTALLING", "count": 12},
+    {"from": "INSTALLING", "to": "INSTALLED", "count": 7}
+  ]
+}`))
+	require.NoError(t, err)
+	assert.Equal(t, "wfx.workflow.dau.direct", stats.Workflow())
+	assert.Equal(t, "12x, p50 3s", stats.EdgeLabel("INSTALL", "INSTALLING"))
+	assert.Equal(t, "7x", stats.EdgeLabel("INSTALLING", "INSTALLED"))
+	assert.Empty(t, stats.EdgeLabel("INSTALL", "TERMINATED"))
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	_, err := Load(strings.NewReader("foo"))
+	assert.Error(t, err)
+}
+
+func TestEdgeLabel_Nil(t *testing.T) {
+	var stats *Stats
+	assert.Empty(t, stats.EdgeLabel("A", "B"))
+	assert.Empty(t, stats.Workflow())
+}
+
+func TestFormatSeconds(t *testing.T) {
+	assert.Equal(t, "250ms", formatSeconds(0.25))
+	assert.Equal(t, "1m30s", formatSeconds(90.4))
+}
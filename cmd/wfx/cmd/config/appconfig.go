@@ -24,6 +24,7 @@ import (
 	"github.com/knadh/koanf/v2"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/siemens/wfx/middleware/plugin"
 	"github.com/siemens/wfx/persistence"
 	"github.com/spf13/pflag"
 )
@@ -34,6 +35,10 @@ type AppConfig struct {
 	flags         *pflag.FlagSet
 	fileProviders []*file.File
 
+	listenersMutex sync.Mutex
+	listeners      map[int]func()
+	nextListener   int
+
 	// flags
 	logLevel         zerolog.Level
 	logFormat        string
@@ -45,6 +50,10 @@ type AppConfig struct {
 
 	ssePingInterval  time.Duration
 	sseGraceInterval time.Duration
+	wsOrigins        []string
+
+	metricsInterval time.Duration
+	tracingEndpoint string
 
 	maxHeaderSize  int
 	readTimeout    time.Duration
@@ -62,6 +71,7 @@ type AppConfig struct {
 	clientTLSPort    int
 	clientUnixSocket string
 	clientPluginsDir string
+	clientManifest   string
 
 	mgmtHost       string
 	mgmtPort       int
@@ -69,6 +79,9 @@ type AppConfig struct {
 	mgmtTLSPort    int
 	mgmtUnixSocket string
 	mgmtPluginsDir string
+	mgmtManifest   string
+
+	pluginSettings plugin.Settings
 }
 
 type Scheme int
@@ -132,6 +145,7 @@ func NewAppConfig(flags *pflag.FlagSet) (*AppConfig, error) {
 	cfg := new(AppConfig)
 	cfg.flags = flags
 	cfg.k = k
+	cfg.listeners = make(map[int]func())
 	if ok := cfg.Reload(); !ok {
 		return nil, errors.New("configuration contains errors")
 	}
@@ -145,16 +159,64 @@ func NewAppConfig(flags *pflag.FlagSet) (*AppConfig, error) {
 			if err := k.Load(fp, yaml.Parser(), mergeFn); err == nil {
 				if ok := cfg.Reload(); !ok {
 					log.Error().Err(err).Msg("Failed to reload config")
+					return
 				}
+				cfg.notifyListeners()
 			}
 		}); err != nil {
 			log.Error().Err(err).Msg("Failed to set up config file watcher")
 		}
 	}
+
+	// the plugin manifests are part of the configuration, but parsed by the server
+	for _, manifest := range []string{cfg.MgmtPluginsManifest(), cfg.ClientPluginsManifest()} {
+		if manifest == "" {
+			continue
+		}
+		fp := file.Provider(manifest)
+		if err := fp.Watch(func(_ any, err error) {
+			if err != nil {
+				log.Error().Err(err).Str("manifest", manifest).Msg("Stopped watching plugin manifest")
+				return
+			}
+			cfg.notifyListeners()
+		}); err != nil {
+			log.Error().Err(err).Str("manifest", manifest).Msg("Failed to set up plugin manifest watcher")
+			continue
+		}
+		fileProviders = append(fileProviders, fp)
+	}
 	cfg.fileProviders = fileProviders
 	return cfg, nil
 }
 
+// OnReload registers a function which is called whenever a config file or a
+// plugin manifest has changed. The returned function unregisters it.
+func (cfg *AppConfig) OnReload(fn func()) func() {
+	cfg.listenersMutex.Lock()
+	defer cfg.listenersMutex.Unlock()
+	id := cfg.nextListener
+	cfg.nextListener++
+	cfg.listeners[id] = fn
+	return func() {
+		cfg.listenersMutex.Lock()
+		defer cfg.listenersMutex.Unlock()
+		delete(cfg.listeners, id)
+	}
+}
+
+func (cfg *AppConfig) notifyListeners() {
+	cfg.listenersMutex.Lock()
+	listeners := make([]func(), 0, len(cfg.listeners))
+	for _, fn := range cfg.listeners {
+		listeners = append(listeners, fn)
+	}
+	cfg.listenersMutex.Unlock()
+	for _, fn := range listeners {
+		fn()
+	}
+}
+
 func (cfg *AppConfig) Stop() {
 	for _, fp := range cfg.fileProviders {
 		_ = fp.Unwatch()
@@ -225,6 +287,9 @@ func (cfg *AppConfig) Reload() bool {
 	cfg.gracefulTimeout = cfg.k.Duration(GracefulTimeoutFlag)
 	cfg.ssePingInterval = cfg.k.Duration(SSEPingIntervalFlag)
 	cfg.sseGraceInterval = cfg.k.Duration(SSEGraceIntervalFlag)
+	cfg.wsOrigins = cfg.k.Strings(WSOriginsFlag)
+	cfg.metricsInterval = cfg.k.Duration(MetricsIntervalFlag)
+	cfg.tracingEndpoint = cfg.k.String(TracingEndpointFlag)
 
 	if schemes := cfg.k.Strings(SchemeFlag); len(schemes) > 0 {
 		cfg.schemes = make([]Scheme, 0, len(schemes))
@@ -259,6 +324,11 @@ func (cfg *AppConfig) Reload() bool {
 	cfg.mgmtTLSPort = cfg.k.Int(MgmtTLSPortFlag)
 	cfg.mgmtUnixSocket = cfg.k.String(MgmtUnixSocketFlag)
 	cfg.mgmtPluginsDir = cfg.k.String(MgmtPluginsDirFlag)
+	cfg.mgmtManifest = cfg.k.String(MgmtPluginsManifestFlag)
+	if cfg.mgmtPluginsDir != "" && cfg.mgmtManifest != "" {
+		log.Error().Msgf("--%s and --%s are mutually exclusive", MgmtPluginsDirFlag, MgmtPluginsManifestFlag)
+		ok = false
+	}
 
 	cfg.clientHost = cfg.k.String(ClientHostFlag)
 	cfg.clientPort = cfg.k.Int(ClientPortFlag)
@@ -266,6 +336,30 @@ func (cfg *AppConfig) Reload() bool {
 	cfg.clientTLSPort = cfg.k.Int(ClientTLSPortFlag)
 	cfg.clientUnixSocket = cfg.k.String(ClientUnixSocketFlag)
 	cfg.clientPluginsDir = cfg.k.String(ClientPluginsDirFlag)
+	cfg.clientManifest = cfg.k.String(ClientPluginsManifestFlag)
+	if cfg.clientPluginsDir != "" && cfg.clientManifest != "" {
+		log.Error().Msgf("--%s and --%s are mutually exclusive", ClientPluginsDirFlag, ClientPluginsManifestFlag)
+		ok = false
+	}
+
+	cfg.pluginSettings = plugin.Settings{
+		Timeout:          cfg.k.Duration(PluginTimeoutFlag),
+		Restart:          cfg.k.Bool(PluginRestartFlag),
+		RestartBackoff:   cfg.k.Duration(PluginRestartBackoffFlag),
+		RestartTimeouts:  cfg.k.Int(PluginRestartTimeoutsFlag),
+		BreakerThreshold: cfg.k.Int(PluginBreakerThresholdFlag),
+		BreakerCooldown:  cfg.k.Duration(PluginBreakerCooldownFlag),
+		Connections:      cfg.k.Int(PluginConnectionsFlag),
+		MemoryLimit:      cfg.k.Int(PluginMemoryLimitFlag),
+	}
+	if s := cfg.k.String(PluginFailurePolicyFlag); s != "" {
+		if policy, err := plugin.ParseFailurePolicy(s); err != nil {
+			log.Error().Err(err).Msg("Invalid plugin failure policy")
+			ok = false
+		} else {
+			cfg.pluginSettings.FailurePolicy = policy
+		}
+	}
 
 	lvlString := cfg.k.String(LogLevelFlag)
 	if lvl, err := zerolog.ParseLevel(lvlString); err != nil {
@@ -372,6 +466,13 @@ func (cfg *AppConfig) ClientPluginsDir() string {
 	return cfg.clientPluginsDir
 }
 
+// ClientPluginsManifest returns the path of the plugin manifest for the client API.
+func (cfg *AppConfig) ClientPluginsManifest() string {
+	cfg.mutex.RLock()
+	defer cfg.mutex.RUnlock()
+	return cfg.clientManifest
+}
+
 func (cfg *AppConfig) MgmtHost() string {
 	cfg.mutex.RLock()
 	defer cfg.mutex.RUnlock()
@@ -408,6 +509,20 @@ func (cfg *AppConfig) MgmtPluginsDir() string {
 	return cfg.mgmtPluginsDir
 }
 
+// MgmtPluginsManifest returns the path of the plugin manifest for the management API.
+func (cfg *AppConfig) MgmtPluginsManifest() string {
+	cfg.mutex.RLock()
+	defer cfg.mutex.RUnlock()
+	return cfg.mgmtManifest
+}
+
+// PluginSettings returns the supervision settings applied to plugins unless overridden per plugin.
+func (cfg *AppConfig) PluginSettings() plugin.Settings {
+	cfg.mutex.RLock()
+	defer cfg.mutex.RUnlock()
+	return cfg.pluginSettings
+}
+
 func (cfg *AppConfig) SSEPingInterval() time.Duration {
 	cfg.mutex.RLock()
 	defer cfg.mutex.RUnlock()
@@ -420,6 +535,25 @@ func (cfg *AppConfig) SSEGraceInterval() time.Duration {
 	return cfg.sseGraceInterval
 }
 
+// WSOrigins returns the origins from which browsers may open WebSocket connections in addition to the same origin.
+func (cfg *AppConfig) WSOrigins() []string {
+	cfg.mutex.RLock()
+	defer cfg.mutex.RUnlock()
+	return cfg.wsOrigins
+}
+
+func (cfg *AppConfig) MetricsInterval() time.Duration {
+	cfg.mutex.RLock()
+	defer cfg.mutex.RUnlock()
+	return cfg.metricsInterval
+}
+
+func (cfg *AppConfig) TracingEndpoint() string {
+	cfg.mutex.RLock()
+	defer cfg.mutex.RUnlock()
+	return cfg.tracingEndpoint
+}
+
 func (cfg *AppConfig) InitStorage() (persistence.Storage, error) {
 	name, options := cfg.Storage(), cfg.StorageOptions()
 	log.Debug().Str("name", name).Str("options", options).Msgf("Setting up persistent storage %q", name)
@@ -10,11 +10,14 @@ package config
 
 import (
 	"os"
+	"path"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/rs/zerolog"
+	"github.com/siemens/wfx/middleware/plugin"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,6 +55,50 @@ func TestNewAppConfig_Invalid(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestNewAppConfig_PluginSettings(t *testing.T) {
+	flags := NewFlagset()
+	_ = flags.Parse([]string{"--plugin-timeout=5s", "--plugin-failure-policy=open", "--plugin-restart=false"})
+
+	cfg, err := NewAppConfig(flags)
+	require.NoError(t, err)
+	t.Cleanup(cfg.Stop)
+
+	settings := cfg.PluginSettings()
+	assert.Equal(t, 5*time.Second, settings.Timeout)
+	assert.Equal(t, plugin.FailOpen, settings.FailurePolicy)
+	assert.False(t, settings.Restart)
+	assert.Equal(t, plugin.DefaultSettings().BreakerThreshold, settings.BreakerThreshold)
+}
+
+func TestNewAppConfig_InvalidFailurePolicy(t *testing.T) {
+	flags := NewFlagset()
+	_ = flags.Parse([]string{"--plugin-failure-policy=maybe"})
+
+	cfg, err := NewAppConfig(flags)
+	assert.Nil(t, cfg)
+	assert.Error(t, err)
+}
+
+func TestNewAppConfig_PluginsManifest(t *testing.T) {
+	flags := NewFlagset()
+	_ = flags.Parse([]string{"--client-plugins-manifest=client.yaml", "--mgmt-plugins-manifest=mgmt.yaml"})
+
+	cfg, err := NewAppConfig(flags)
+	require.NoError(t, err)
+	t.Cleanup(cfg.Stop)
+	assert.Equal(t, "client.yaml", cfg.ClientPluginsManifest())
+	assert.Equal(t, "mgmt.yaml", cfg.MgmtPluginsManifest())
+}
+
+func TestNewAppConfig_PluginsManifestAndDir(t *testing.T) {
+	flags := NewFlagset()
+	_ = flags.Parse([]string{"--client-plugins-manifest=plugins.yaml", "--client-plugins-dir=plugins"})
+
+	cfg, err := NewAppConfig(flags)
+	assert.Nil(t, cfg)
+	assert.Error(t, err)
+}
+
 func TestReload(t *testing.T) {
 	dir, _ := os.MkdirTemp("", "TestReload")
 	cfgFile, _ := os.CreateTemp("", "config.yaml")
@@ -82,3 +129,22 @@ func TestReload(t *testing.T) {
 	}
 	assert.Equal(t, zerolog.ErrorLevel.String(), zerolog.GlobalLevel().String())
 }
+
+func TestOnReload(t *testing.T) {
+	manifest := path.Join(t.TempDir(), "plugins.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte("plugins: []\n"), 0o600))
+
+	flags := NewFlagset()
+	_ = flags.Parse([]string{"--mgmt-plugins-manifest", manifest})
+	cfg, err := NewAppConfig(flags)
+	require.NoError(t, err)
+	t.Cleanup(cfg.Stop)
+
+	var count atomic.Int32
+	unsubscribe := cfg.OnReload(func() { count.Add(1) })
+	require.NoError(t, os.WriteFile(manifest, []byte("plugins: [{path: foo}]\n"), 0o600))
+	assert.Eventually(t, func() bool { return count.Load() > 0 }, 5*time.Second, 10*time.Millisecond)
+
+	unsubscribe()
+	assert.Empty(t, cfg.listeners)
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/OpenPeeDeeP/xdg"
 	"github.com/rs/zerolog"
+	"github.com/siemens/wfx/middleware/plugin"
 	"github.com/siemens/wfx/persistence"
 	"github.com/spf13/pflag"
 
@@ -34,19 +35,31 @@ const (
 	StorageOptFlag       = "storage-opt"
 	SimpleFileServerFlag = "simple-fileserver"
 
-	ClientHostFlag       = "client-host"
-	ClientPortFlag       = "client-port"
-	ClientTLSHostFlag    = "client-tls-host"
-	ClientTLSPortFlag    = "client-tls-port"
-	ClientUnixSocketFlag = "client-unix-socket"
-	ClientPluginsDirFlag = "client-plugins-dir"
-
-	MgmtHostFlag       = "mgmt-host"
-	MgmtPortFlag       = "mgmt-port"
-	MgmtTLSHostFlag    = "mgmt-tls-host"
-	MgmtTLSPortFlag    = "mgmt-tls-port"
-	MgmtUnixSocketFlag = "mgmt-unix-socket"
-	MgmtPluginsDirFlag = "mgmt-plugins-dir"
+	ClientHostFlag            = "client-host"
+	ClientPortFlag            = "client-port"
+	ClientTLSHostFlag         = "client-tls-host"
+	ClientTLSPortFlag         = "client-tls-port"
+	ClientUnixSocketFlag      = "client-unix-socket"
+	ClientPluginsDirFlag      = "client-plugins-dir"
+	ClientPluginsManifestFlag = "client-plugins-manifest"
+
+	MgmtHostFlag            = "mgmt-host"
+	MgmtPortFlag            = "mgmt-port"
+	MgmtTLSHostFlag         = "mgmt-tls-host"
+	MgmtTLSPortFlag         = "mgmt-tls-port"
+	MgmtUnixSocketFlag      = "mgmt-unix-socket"
+	MgmtPluginsDirFlag      = "mgmt-plugins-dir"
+	MgmtPluginsManifestFlag = "mgmt-plugins-manifest"
+
+	PluginTimeoutFlag          = "plugin-timeout"
+	PluginFailurePolicyFlag    = "plugin-failure-policy"
+	PluginRestartFlag          = "plugin-restart"
+	PluginRestartBackoffFlag   = "plugin-restart-backoff"
+	PluginRestartTimeoutsFlag  = "plugin-restart-timeouts"
+	PluginBreakerThresholdFlag = "plugin-breaker-threshold"
+	PluginBreakerCooldownFlag  = "plugin-breaker-cooldown"
+	PluginConnectionsFlag      = "plugin-connections"
+	PluginMemoryLimitFlag      = "plugin-memory-limit"
 
 	SchemeFlag          = "scheme"
 	KeepAliveFlag       = "keep-alive"
@@ -58,6 +71,10 @@ const (
 
 	SSEPingIntervalFlag  = "sse-ping-interval"
 	SSEGraceIntervalFlag = "sse-grace-interval"
+	WSOriginsFlag        = "ws-origins"
+
+	MetricsIntervalFlag = "metrics-interval"
+	TracingEndpointFlag = "tracing-endpoint"
 
 	TLSCaFlag          = "tls-ca"
 	TLSCertificateFlag = "tls-certificate"
@@ -73,6 +90,8 @@ const (
 	// some reverse proxy)
 	DefaultSSEPingInterval  = 30 * time.Second
 	DefaultSSEGraceInterval = time.Minute
+
+	DefaultMetricsInterval = time.Minute
 )
 
 func NewFlagset() *pflag.FlagSet {
@@ -83,8 +102,11 @@ func NewFlagset() *pflag.FlagSet {
 	f.StringSlice(SchemeFlag, []string{"http"}, "the listeners to enable, this can be repeated and defaults to the schemes in the swagger spec")
 	f.Duration(CleanupTimeoutFlag, 10*time.Second, "grace period for which to wait before killing idle connections")
 	f.Duration(GracefulTimeoutFlag, 15*time.Second, "grace period for which to wait before shutting down the server")
-	f.Duration(SSEPingIntervalFlag, DefaultSSEPingInterval, "interval to send periodic keep-alive messages to prevent server-sent events connections from being closed due to inactivity")
+	f.Duration(SSEPingIntervalFlag, DefaultSSEPingInterval, "interval to send periodic keep-alive messages (server-sent events) or pings (WebSocket) to prevent job event connections from being closed due to inactivity")
 	f.Duration(SSEGraceIntervalFlag, DefaultSSEGraceInterval, "interval after which non-responsive subscribers are dropped")
+	f.StringSlice(WSOriginsFlag, nil, "origins (host patterns, e.g. *.example.com) from which browsers may open WebSocket connections to receive job events; by default, only the same origin is allowed")
+	f.Duration(MetricsIntervalFlag, DefaultMetricsInterval, "interval to refresh the job gauges exposed under /metrics; set to 0 to disable")
+	f.String(TracingEndpointFlag, "", "OTLP/HTTP endpoint URL to export traces to, e.g. http://localhost:4318; tracing is disabled if empty")
 
 	f.Int(MaxHeaderSizeFlag, 1000000, "controls the maximum number of bytes the server will read parsing the request header's keys and values, including the request line. It does not limit the size of the request body")
 	f.Bool(KeepAliveFlag, true, "sets the TCP keep-alive timeouts on accepted connections. It prunes dead TCP connections ( e.g. closing laptop mid-download)")
@@ -102,6 +124,7 @@ func NewFlagset() *pflag.FlagSet {
 	f.Int(ClientTLSPortFlag, 8443, "the port to listen on for secure connections, defaults to a random value")
 	f.String(ClientUnixSocketFlag, "/tmp/wfx-client.sock", "the unix domain socket to use")
 	f.String(ClientPluginsDirFlag, "", "directory containing client plugins")
+	f.String(ClientPluginsManifestFlag, "", "YAML file declaring the client plugins (alternative to --"+ClientPluginsDirFlag+")")
 
 	f.String(MgmtHostFlag, "127.0.0.1", "management host")
 	f.Int(MgmtPortFlag, 8081, "management port")
@@ -109,6 +132,20 @@ func NewFlagset() *pflag.FlagSet {
 	f.Int(MgmtTLSPortFlag, 8444, "TLS management port")
 	f.String(MgmtUnixSocketFlag, "/tmp/wfx-mgmt.sock", "the unix domain socket to use")
 	f.String(MgmtPluginsDirFlag, "", "directory containing management plugins")
+	f.String(MgmtPluginsManifestFlag, "", "YAML file declaring the management plugins (alternative to --"+MgmtPluginsDirFlag+")")
+
+	{
+		defaults := plugin.DefaultSettings()
+		f.Duration(PluginTimeoutFlag, defaults.Timeout, "maximum time to wait for a plugin response; set to 0 to wait indefinitely")
+		f.String(PluginFailurePolicyFlag, defaults.FailurePolicy.String(), "how to handle requests if a plugin is unavailable: 'closed' rejects them with 503, 'open' processes them without the plugin")
+		f.Bool(PluginRestartFlag, defaults.Restart, "restart plugins which exit unexpectedly; if disabled, wfx shuts down instead")
+		f.Duration(PluginRestartBackoffFlag, defaults.RestartBackoff, "delay before restarting a plugin; doubled for each consecutive restart (up to one minute)")
+		f.Int(PluginRestartTimeoutsFlag, defaults.RestartTimeouts, "number of consecutive timeouts after which a plugin is considered stuck and restarted; set to 0 to disable")
+		f.Int(PluginBreakerThresholdFlag, defaults.BreakerThreshold, "number of consecutive plugin failures after which requests are no longer sent to the plugin; set to 0 to disable")
+		f.Duration(PluginBreakerCooldownFlag, defaults.BreakerCooldown, "time after which a failed plugin is given another chance")
+		f.Int(PluginConnectionsFlag, defaults.Connections, "number of connections to plugins reachable via unix domain socket")
+		f.Int(PluginMemoryLimitFlag, defaults.MemoryLimit, "maximum memory (in MiB) of a single invocation of a WebAssembly plugin; set to 0 for no limit")
+	}
 
 	{
 
@@ -9,6 +9,7 @@ package root
  */
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -24,7 +25,9 @@ import (
 	"github.com/siemens/wfx/cmd/wfx/cmd/config"
 	"github.com/siemens/wfx/cmd/wfx/metadata"
 	"github.com/siemens/wfx/internal/cmd/man"
-	"github.com/siemens/wfx/internal/server"
+	"github.com/siemens/wfx/middleware/metrics"
+	"github.com/siemens/wfx/middleware/tracing"
+	"github.com/siemens/wfx/server"
 	"github.com/spf13/cobra"
 	"go.uber.org/automaxprocs/maxprocs"
 )
@@ -72,11 +75,28 @@ Examples of tasks are installation of firmware or other types of commands issued
 				Str("user", username).
 				Msg("Starting wfx")
 
+			shutdownTracing, err := tracing.Setup(cmd.Context(), cfg.TracingEndpoint())
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), cfg.GracefulTimeout())
+				defer cancel()
+				if err := shutdownTracing(ctx); err != nil {
+					log.Warn().Err(err).Msg("Failed to flush traces")
+				}
+			}()
+
 			storage, err := cfg.InitStorage()
 			if err != nil {
 				return fault.Wrap(err)
 			}
 			defer storage.Shutdown()
+			storage = metrics.InstrumentStorage(tracing.InstrumentStorage(storage))
+
+			jobCollector := metrics.NewJobCollector(storage, cfg.MetricsInterval())
+			jobCollector.Start()
+			defer jobCollector.Stop()
 
 			// channel to catch signals
 			chSignal := make(chan os.Signal, 1)
@@ -88,15 +108,19 @@ Examples of tasks are installation of firmware or other types of commands issued
 				WithSSEOpts(api.SSEOpts{
 					PingInterval:  cfg.SSEPingInterval(),
 					GraceInterval: cfg.SSEGraceInterval(),
+					WSOrigins:     cfg.WSOrigins(),
 				})
-			wfx.Start()
-			defer wfx.Stop()
 
 			collection, err := server.NewServerCollection(cfg, wfx, storage)
 			if err != nil {
 				return fault.Wrap(err)
 			}
 
+			// plugin health is reported alongside the storage
+			wfx.WithHealthChecks(collection.HealthChecks()...)
+			wfx.Start()
+			defer wfx.Stop()
+
 			var g sync.WaitGroup
 			g.Go(func() {
 				err := collection.Start()
@@ -134,5 +158,7 @@ Examples of tasks are installation of firmware or other types of commands issued
 	_ = cmd.MarkPersistentFlagFilename(config.ConfigFlag, "yml", "yaml")
 	_ = cmd.MarkPersistentFlagDirname(config.ClientPluginsDirFlag)
 	_ = cmd.MarkPersistentFlagDirname(config.MgmtPluginsDirFlag)
+	_ = cmd.MarkPersistentFlagFilename(config.ClientPluginsManifestFlag, "yml", "yaml")
+	_ = cmd.MarkPersistentFlagFilename(config.MgmtPluginsManifestFlag, "yml", "yaml")
 	return cmd
 }
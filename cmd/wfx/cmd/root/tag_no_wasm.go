@@ -0,0 +1,15 @@
+//go:build no_wasm
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+package root
+
+func init() {
+	buildTags = append(buildTags, "no_wasm")
+}
@@ -0,0 +1,38 @@
+package config
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/config/currentcontext"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/config/deletecontext"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/config/getcontexts"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/config/setcontext"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/config/usecontext"
+	"github.com/spf13/cobra"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "manage contexts",
+		Long: `subcommand to manage contexts, i.e. named wfx instances
+
+Contexts are stored in ~/.config/wfxctl/config.yaml (or the file referenced by $WFXCTL_CONFIG).
+The current context is used by all commands unless a different one is selected using --context.
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+	}
+	cmd.AddCommand(getcontexts.NewCommand())
+	cmd.AddCommand(currentcontext.NewCommand())
+	cmd.AddCommand(usecontext.NewCommand())
+	cmd.AddCommand(setcontext.NewCommand())
+	cmd.AddCommand(deletecontext.NewCommand())
+	return cmd
+}
@@ -0,0 +1,39 @@
+package currentcontext
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+)
+
+func NewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "current-context",
+		Short:   "Display the current context",
+		Example: "wfxctl config current-context",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := contexts.Load(contexts.DefaultPath())
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			if cfg.CurrentContext == "" {
+				return errors.New("current context is not set")
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), cfg.CurrentContext)
+			return nil
+		},
+	}
+}
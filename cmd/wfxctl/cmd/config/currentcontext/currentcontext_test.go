@@ -0,0 +1,39 @@
+package currentcontext
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+)
+
+func TestCurrentContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(contexts.EnvConfig, path)
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{})
+	assert.EqualError(t, cmd.Execute(), "current context is not set")
+
+	cfg := contexts.Config{CurrentContext: "dev", Contexts: []contexts.Context{{Name: "dev"}}}
+	require.NoError(t, cfg.Save(path))
+
+	buf := new(bytes.Buffer)
+	cmd = NewCommand()
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "dev\n", buf.String())
+}
@@ -0,0 +1,43 @@
+package deletecontext
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+)
+
+func TestDeleteContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(contexts.EnvConfig, path)
+	cfg := contexts.Config{CurrentContext: "dev", Contexts: []contexts.Context{{Name: "dev"}, {Name: "prod"}}}
+	require.NoError(t, cfg.Save(path))
+
+	buf := new(bytes.Buffer)
+	cmd := NewCommand()
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"dev"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Deleted context \"dev\".\n", buf.String())
+
+	actual, err := contexts.Load(path)
+	require.NoError(t, err)
+	assert.Empty(t, actual.CurrentContext)
+	assert.Equal(t, []contexts.Context{{Name: "prod"}}, actual.Contexts)
+
+	cmd = NewCommand()
+	cmd.SetArgs([]string{"dev"})
+	assert.EqualError(t, cmd.Execute(), `context "dev" does not exist`)
+}
@@ -0,0 +1,72 @@
+package getcontexts
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+)
+
+func NewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "get-contexts",
+		Short:   "List all contexts",
+		Example: "wfxctl config get-contexts",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := contexts.Load(contexts.DefaultPath())
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CURRENT\tNAME\tCLIENT\tMGMT\tTLS")
+			for _, ctx := range cfg.Contexts {
+				current := ""
+				if ctx.Name == cfg.CurrentContext {
+					current = "*"
+				}
+				var client, mgmt string
+				if ctx.EnableTLS {
+					client = endpoint(ctx.ClientUnixSocket, ctx.ClientTLSHost, ctx.ClientTLSPort)
+					mgmt = endpoint(ctx.MgmtUnixSocket, ctx.MgmtTLSHost, ctx.MgmtTLSPort)
+				} else {
+					client = endpoint(ctx.ClientUnixSocket, ctx.ClientHost, ctx.ClientPort)
+					mgmt = endpoint(ctx.MgmtUnixSocket, ctx.MgmtHost, ctx.MgmtPort)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", current, ctx.Name, client, mgmt, ctx.EnableTLS)
+			}
+			return fault.Wrap(w.Flush())
+		},
+	}
+}
+
+// endpoint describes how the context reaches an interface; unset values fall back to the defaults of the global flags.
+func endpoint(socket string, host string, port int) string {
+	if socket != "" {
+		return "unix:" + socket
+	}
+	if host == "" && port == 0 {
+		return "-"
+	}
+	portStr := "-"
+	if port != 0 {
+		portStr = strconv.Itoa(port)
+	}
+	if host == "" {
+		host = "-"
+	}
+	return net.JoinHostPort(host, portStr)
+}
@@ -0,0 +1,50 @@
+package getcontexts
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+)
+
+func TestGetContexts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(contexts.EnvConfig, path)
+	cfg := contexts.Config{
+		CurrentContext: "prod",
+		Contexts: []contexts.Context{
+			{Name: "dev", ClientUnixSocket: "/run/wfx/client.sock", MgmtPort: 9081},
+			{Name: "prod", EnableTLS: true, ClientTLSHost: "wfx.example.com", ClientTLSPort: 443, MgmtTLSHost: "wfx-mgmt.example.com", MgmtTLSPort: 443},
+		},
+	}
+	require.NoError(t, cfg.Save(path))
+
+	buf := new(bytes.Buffer)
+	cmd := NewCommand()
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.Execute())
+	expected := `CURRENT  NAME  CLIENT                     MGMT                      TLS
+         dev   unix:/run/wfx/client.sock  -:9081                    false
+*        prod  wfx.example.com:443        wfx-mgmt.example.com:443  true
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestEndpoint(t *testing.T) {
+	assert.Equal(t, "-", endpoint("", "", 0))
+	assert.Equal(t, "localhost:-", endpoint("", "localhost", 0))
+	assert.Equal(t, "[::1]:8080", endpoint("", "::1", 8080))
+}
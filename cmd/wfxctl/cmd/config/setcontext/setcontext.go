@@ -0,0 +1,138 @@
+package setcontext
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+)
+
+const currentFlag = "current"
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-context NAME",
+		Short: "Create or modify a context",
+		Long: `Create or modify a context.
+
+Only the settings given on the command line are changed; all other settings of an existing context are preserved.
+Settings which are not part of the context fall back to the defaults of the global flags.
+`,
+		Example: `
+wfxctl config set-context dev --client-host=localhost --mgmt-host=localhost
+wfxctl config set-context prod --enable-tls --client-tls-host=wfx.example.com --mgmt-tls-host=wfx-mgmt.example.com --tls-ca=/etc/ssl/ca.pem --token=secret --current
+`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: contexts.CompleteNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := contexts.DefaultPath()
+			cfg, err := contexts.Load(path)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			name := args[0]
+			ctx := contexts.Context{Name: name}
+			existing, found := cfg.Find(name)
+			if found {
+				ctx = *existing
+			}
+			if err := apply(cmd.Flags(), &ctx); err != nil {
+				return fault.Wrap(err)
+			}
+			cfg.Set(ctx)
+			if current, _ := cmd.Flags().GetBool(currentFlag); current {
+				cfg.CurrentContext = name
+			}
+			if err := cfg.Save(path); err != nil {
+				return fault.Wrap(err)
+			}
+			if found {
+				fmt.Fprintf(cmd.OutOrStdout(), "Modified context %q.\n", name)
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Created context %q.\n", name)
+			}
+			return nil
+		},
+	}
+	f := cmd.Flags()
+	f.String(flags.ClientHostFlag, "", "host")
+	f.Int(flags.ClientPortFlag, 0, "port")
+	f.String(flags.ClientTLSHostFlag, "", "TLS host")
+	f.Int(flags.ClientTLSPortFlag, 0, "TLS port")
+	f.String(flags.ClientUnixSocketFlag, "", "connect via the given unix-domain socket")
+	f.String(flags.MgmtHostFlag, "", "management host")
+	f.Int(flags.MgmtPortFlag, 0, "management port")
+	f.String(flags.MgmtTLSHostFlag, "", "management TLS host")
+	f.Int(flags.MgmtTLSPortFlag, 0, "management TLS port")
+	f.String(flags.MgmtUnixSocketFlag, "", "connect via the given unix-domain socket")
+	f.Bool(flags.EnableTLSFlag, false, "whether to enable TLS (https)")
+	f.String(flags.TLSCaFlag, "", "ca bundle (PEM)")
+	_ = cmd.MarkFlagFilename(flags.TLSCaFlag, "pem", "crt")
+	f.String(flags.TokenFlag, "", "bearer token used to authenticate requests")
+	f.String(flags.UsernameFlag, "", "username used to authenticate requests (basic auth)")
+	f.String(flags.PasswordFlag, "", "password used to authenticate requests (basic auth)")
+	f.Bool(currentFlag, false, "make this the current context")
+	return cmd
+}
+
+// apply copies all flags which were explicitly set into the context.
+func apply(f *pflag.FlagSet, ctx *contexts.Context) error {
+	strs := map[string]*string{
+		flags.ClientHostFlag:       &ctx.ClientHost,
+		flags.ClientTLSHostFlag:    &ctx.ClientTLSHost,
+		flags.ClientUnixSocketFlag: &ctx.ClientUnixSocket,
+		flags.MgmtHostFlag:         &ctx.MgmtHost,
+		flags.MgmtTLSHostFlag:      &ctx.MgmtTLSHost,
+		flags.MgmtUnixSocketFlag:   &ctx.MgmtUnixSocket,
+		flags.TLSCaFlag:            &ctx.TLSCa,
+		flags.TokenFlag:            &ctx.Token,
+		flags.UsernameFlag:         &ctx.Username,
+		flags.PasswordFlag:         &ctx.Password,
+	}
+	for name, ptr := range strs {
+		if !f.Changed(name) {
+			continue
+		}
+		value, err := f.GetString(name)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+		*ptr = value
+	}
+	ints := map[string]*int{
+		flags.ClientPortFlag:    &ctx.ClientPort,
+		flags.ClientTLSPortFlag: &ctx.ClientTLSPort,
+		flags.MgmtPortFlag:      &ctx.MgmtPort,
+		flags.MgmtTLSPortFlag:   &ctx.MgmtTLSPort,
+	}
+	for name, ptr := range ints {
+		if !f.Changed(name) {
+			continue
+		}
+		value, err := f.GetInt(name)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+		*ptr = value
+	}
+	if f.Changed(flags.EnableTLSFlag) {
+		value, err := f.GetBool(flags.EnableTLSFlag)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+		ctx.EnableTLS = value
+	}
+	return nil
+}
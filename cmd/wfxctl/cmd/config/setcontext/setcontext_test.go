@@ -0,0 +1,50 @@
+package setcontext
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+)
+
+func TestSetContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(contexts.EnvConfig, path)
+
+	buf := new(bytes.Buffer)
+	cmd := NewCommand()
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"prod", "--enable-tls", "--mgmt-tls-host=wfx.example.com", "--mgmt-tls-port=443", "--token=secret", "--current"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Created context \"prod\".\n", buf.String())
+
+	buf.Reset()
+	cmd = NewCommand()
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"prod", "--mgmt-tls-port=8444"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Modified context \"prod\".\n", buf.String())
+
+	cfg, err := contexts.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", cfg.CurrentContext)
+	assert.Equal(t, []contexts.Context{{
+		Name:        "prod",
+		MgmtTLSHost: "wfx.example.com",
+		MgmtTLSPort: 8444,
+		EnableTLS:   true,
+		Token:       "secret",
+	}}, cfg.Contexts)
+}
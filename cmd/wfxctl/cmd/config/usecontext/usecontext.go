@@ -0,0 +1,45 @@
+package usecontext
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+)
+
+func NewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:               "use-context NAME",
+		Short:             "Set the current context",
+		Example:           "wfxctl config use-context staging",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: contexts.CompleteNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := contexts.DefaultPath()
+			cfg, err := contexts.Load(path)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			name := args[0]
+			if _, found := cfg.Find(name); !found {
+				return fmt.Errorf("context %q does not exist", name)
+			}
+			cfg.CurrentContext = name
+			if err := cfg.Save(path); err != nil {
+				return fault.Wrap(err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Switched to context %q.\n", name)
+			return nil
+		},
+	}
+}
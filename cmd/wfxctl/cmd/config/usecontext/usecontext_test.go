@@ -0,0 +1,45 @@
+package usecontext
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
+)
+
+func TestUseContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(contexts.EnvConfig, path)
+	cfg := contexts.Config{CurrentContext: "dev", Contexts: []contexts.Context{{Name: "dev"}, {Name: "prod"}}}
+	require.NoError(t, cfg.Save(path))
+
+	buf := new(bytes.Buffer)
+	cmd := NewCommand()
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"prod"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Switched to context \"prod\".\n", buf.String())
+
+	actual, err := contexts.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", actual.CurrentContext)
+}
+
+func TestUseContext_Unknown(t *testing.T) {
+	t.Setenv(contexts.EnvConfig, filepath.Join(t.TempDir(), "config.yaml"))
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"prod"})
+	assert.EqualError(t, cmd.Execute(), `context "prod" does not exist`)
+}
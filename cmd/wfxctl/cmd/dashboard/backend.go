@@ -0,0 +1,152 @@
+package dashboard
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/tmaxmax/go-sse"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+// backend abstracts the wfx API so that the dashboard can be tested without a server.
+type backend interface {
+	QueryJobs(ctx context.Context, f filter, limit int32) ([]api.Job, error)
+	GetJob(ctx context.Context, id string) (*api.Job, error)
+	GetWorkflow(ctx context.Context, name string) (*api.Workflow, error)
+	UpdateStatus(ctx context.Context, id string, state string) error
+	// Subscribe streams job events into ch until ctx is canceled.
+	Subscribe(ctx context.Context, f filter, ch chan<- api.JobEvent) error
+}
+
+type apiBackend struct {
+	server    string
+	client    *api.ClientWithResponses
+	sseClient *sse.Client
+}
+
+func newAPIBackend(client *api.Client, httpClient *http.Client) apiBackend {
+	// use sane defaults (e.g. auto reconnect) from the default client
+	sseClient := *sse.DefaultClient
+	sseClient.HTTPClient = httpClient
+	return apiBackend{
+		server:    client.Server,
+		client:    &api.ClientWithResponses{ClientInterface: client},
+		sseClient: &sseClient,
+	}
+}
+
+func (b apiBackend) QueryJobs(ctx context.Context, f filter, limit int32) ([]api.Job, error) {
+	sort := api.Desc
+	params := &api.GetJobsParams{ParamLimit: &limit, ParamSort: &sort}
+	if f.workflow != "" {
+		params.ParamWorkflow = &f.workflow
+	}
+	if f.state != "" {
+		params.ParamState = &f.state
+	}
+	if len(f.groups) > 0 {
+		params.ParamGroup = &f.groups
+	}
+	if len(f.tags) > 0 {
+		params.ParamTag = &f.tags
+	}
+	resp, err := b.client.GetJobsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Content, nil
+}
+
+func (b apiBackend) GetJob(ctx context.Context, id string) (*api.Job, error) {
+	history := true
+	resp, err := b.client.GetJobsIdWithResponse(ctx, id, &api.GetJobsIdParams{ParamHistory: &history})
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+func (b apiBackend) GetWorkflow(ctx context.Context, name string) (*api.Workflow, error) {
+	resp, err := b.client.GetWorkflowsNameWithResponse(ctx, name, nil)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+func (b apiBackend) UpdateStatus(ctx context.Context, id string, state string) error {
+	resp, err := b.client.PutJobsIdStatusWithResponse(ctx, id, nil, api.PutJobsIdStatusJSONRequestBody{State: state})
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	if resp.JSON200 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (b apiBackend) Subscribe(ctx context.Context, f filter, ch chan<- api.JobEvent) error {
+	params := new(api.GetJobsEventsParams)
+	if f.workflow != "" {
+		params.Workflows = &f.workflow
+	}
+	if len(f.tags) > 0 {
+		s := strings.Join(f.tags, ",")
+		params.Tags = &s
+	}
+	req, err := api.NewGetJobsEventsRequest(b.server, params)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	conn := b.sseClient.NewConnection(req.WithContext(ctx))
+	unsubscribe := conn.SubscribeMessages(func(event sse.Event) {
+		var jobEvent api.JobEvent
+		if err := json.Unmarshal([]byte(event.Data), &jobEvent); err != nil {
+			return
+		}
+		select {
+		case ch <- jobEvent:
+		case <-ctx.Done():
+		}
+	})
+	defer unsubscribe()
+	err = conn.Connect()
+	if ctx.Err() != nil {
+		return nil
+	}
+	return fault.Wrap(err)
+}
+
+func responseError(resp *http.Response, body []byte) error {
+	errResp := new(api.ErrorResponse)
+	if err := json.Unmarshal(body, errResp); err == nil && errResp.Errors != nil && len(*errResp.Errors) > 0 {
+		msgs := make([]string, 0, len(*errResp.Errors))
+		for _, e := range *errResp.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.Join(msgs, "; "))
+	}
+	return fmt.Errorf("HTTP %d", resp.StatusCode)
+}
@@ -0,0 +1,97 @@
+package dashboard
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+func newTestBackend(t *testing.T, handler http.HandlerFunc) apiBackend {
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	client, err := api.NewClient(ts.URL + "/api/wfx/v1")
+	require.NoError(t, err)
+	return newAPIBackend(client, ts.Client())
+}
+
+func TestAPIBackend_QueryJobs(t *testing.T) {
+	var query string
+	b := newTestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Encode()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"content":[{"id":"1","clientId":"foo"}],"pagination":{"total":1}}`))
+	})
+	jobs, err := b.QueryJobs(t.Context(), filter{workflow: "wf", state: "INSTALL", groups: []string{"OPEN"}, tags: []string{"beta"}}, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "1", jobs[0].ID)
+	assert.Equal(t, "group=OPEN&limit=10&sort=desc&state=INSTALL&tag=beta&workflow=wf", query)
+}
+
+func TestAPIBackend_Error(t *testing.T) {
+	b := newTestBackend(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"wfx.jobNotFound","message":"job not found"}]}`))
+	})
+	_, err := b.GetJob(t.Context(), "1")
+	assert.EqualError(t, err, "HTTP 404: job not found")
+	_, err = b.GetWorkflow(t.Context(), "wf")
+	assert.EqualError(t, err, "HTTP 404: job not found")
+	err = b.UpdateStatus(t.Context(), "1", "ACTIVATE")
+	assert.EqualError(t, err, "HTTP 404: job not found")
+}
+
+func TestAPIBackend_UpdateStatus(t *testing.T) {
+	var method, path string
+	b := newTestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"state":"ACTIVATE"}`))
+	})
+	err := b.UpdateStatus(t.Context(), "42", "ACTIVATE")
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/api/wfx/v1/jobs/42/status", path)
+}
+
+func TestAPIBackend_Subscribe(t *testing.T) {
+	var query string
+	b := newTestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Encode()
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("data: {\"action\":\"CREATE\",\"job\":{\"id\":\"1\"}}\n\n"))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	ch := make(chan api.JobEvent)
+	done := make(chan error)
+	go func() {
+		done <- b.Subscribe(ctx, filter{workflow: "wf", tags: []string{"a", "b"}}, ch)
+	}()
+
+	event := <-ch
+	assert.Equal(t, api.CREATE, event.Action)
+	assert.Equal(t, "1", event.Job.ID)
+	assert.Equal(t, "tags=a%2Cb&workflows=wf", query)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,80 @@
+package dashboard
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"github.com/Southclaws/fault"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+)
+
+const defaultLimit = 100
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dashboard",
+		Short: "Interactive terminal dashboard",
+		Long: `Interactive terminal dashboard to monitor and operate jobs.
+
+The dashboard lists the most recently modified jobs and keeps them up to date using job events.
+Jobs can be inspected (including their history) and moved along transitions which are eligible for WFX.
+
+The filter can be changed at runtime by pressing 'f', e.g. "workflow=wfx.workflow.dau.direct group=OPEN tag=beta".
+`,
+		Example: `
+wfxctl dashboard
+wfxctl dashboard --workflow=wfx.workflow.dau.direct --group=OPEN
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+
+			client := errutil.Must(baseCmd.CreateMgmtClient())
+			httpClient, err := baseCmd.CreateHTTPClient()
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			// the event stream is long-lived
+			httpClient.Timeout = 0
+
+			f := filter{
+				workflow: baseCmd.Workflow,
+				state:    baseCmd.State,
+				groups:   baseCmd.Groups,
+			}
+			if baseCmd.Tags != nil {
+				f.tags = *baseCmd.Tags
+			}
+			limit := baseCmd.Limit
+			if limit <= 0 {
+				limit = defaultLimit
+			}
+
+			m := newModel(cmd.Context(), newAPIBackend(client, httpClient), f, limit)
+			program := tea.NewProgram(m,
+				tea.WithAltScreen(),
+				tea.WithContext(cmd.Context()),
+				tea.WithInput(cmd.InOrStdin()),
+				tea.WithOutput(cmd.OutOrStdout()))
+			_, err = program.Run()
+			return fault.Wrap(err)
+		},
+	}
+	f := cmd.Flags()
+	f.String(flags.WorkflowFlag, "", "only show jobs of the given workflow")
+	f.String(flags.StateFlag, "", "only show jobs in the given state")
+	f.StringSlice(flags.GroupFlag, nil, "only show jobs in the given group(s)")
+	f.StringSlice(flags.TagFlag, nil, "only show jobs with any of the given tags")
+	f.Int32(flags.LimitFlag, defaultLimit, "maximum number of jobs to show")
+	return cmd
+}
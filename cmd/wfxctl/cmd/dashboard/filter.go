@@ -0,0 +1,95 @@
+package dashboard
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+)
+
+// filter restricts the jobs shown in the dashboard.
+type filter struct {
+	workflow string
+	state    string
+	groups   []string
+	tags     []string
+}
+
+// parseFilter parses the textual representation of a filter, e.g.
+// "workflow=wfx.workflow.dau.direct state=INSTALL group=OPEN,FAILED tag=beta".
+func parseFilter(s string) (filter, error) {
+	var result filter
+	for field := range strings.FieldsSeq(s) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || value == "" {
+			return filter{}, fmt.Errorf("invalid filter expression %q, expected key=value", field)
+		}
+		switch key {
+		case "workflow":
+			result.workflow = value
+		case "state":
+			result.state = value
+		case "group":
+			result.groups = strings.Split(value, ",")
+		case "tag":
+			result.tags = strings.Split(value, ",")
+		default:
+			return filter{}, fmt.Errorf("unknown filter key %q, expected one of: workflow, state, group, tag", key)
+		}
+	}
+	return result, nil
+}
+
+func (f filter) String() string {
+	parts := make([]string, 0, 4)
+	if f.workflow != "" {
+		parts = append(parts, "workflow="+f.workflow)
+	}
+	if f.state != "" {
+		parts = append(parts, "state="+f.state)
+	}
+	if len(f.groups) > 0 {
+		parts = append(parts, "group="+strings.Join(f.groups, ","))
+	}
+	if len(f.tags) > 0 {
+		parts = append(parts, "tag="+strings.Join(f.tags, ","))
+	}
+	return strings.Join(parts, " ")
+}
+
+// matches reports whether the job satisfies the filter. wf is the job's workflow definition,
+// which is required to determine the group of the job's state.
+func (f filter) matches(job *api.Job, wf *api.Workflow) bool {
+	if f.workflow != "" && (job.Workflow == nil || job.Workflow.Name != f.workflow) {
+		return false
+	}
+	state := ""
+	if job.Status != nil {
+		state = job.Status.State
+	}
+	if f.state != "" && state != f.state {
+		return false
+	}
+	if len(f.groups) > 0 && (wf == nil || !slices.Contains(f.groups, workflow.FindStateGroup(wf, state))) {
+		return false
+	}
+	if len(f.tags) > 0 {
+		if job.Tags == nil {
+			return false
+		}
+		if !slices.ContainsFunc(*job.Tags, func(tag string) bool { return slices.Contains(f.tags, tag) }) {
+			return false
+		}
+	}
+	return true
+}
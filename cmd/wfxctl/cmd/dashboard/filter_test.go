@@ -0,0 +1,57 @@
+package dashboard
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	f, err := parseFilter(" workflow=wfx.workflow.dau.direct state=INSTALL  group=OPEN,FAILED tag=beta ")
+	require.NoError(t, err)
+	assert.Equal(t, filter{
+		workflow: "wfx.workflow.dau.direct",
+		state:    "INSTALL",
+		groups:   []string{"OPEN", "FAILED"},
+		tags:     []string{"beta"},
+	}, f)
+	assert.Equal(t, "workflow=wfx.workflow.dau.direct state=INSTALL group=OPEN,FAILED tag=beta", f.String())
+
+	f, err = parseFilter("")
+	require.NoError(t, err)
+	assert.Equal(t, filter{}, f)
+	assert.Empty(t, f.String())
+}
+
+func TestParseFilter_Invalid(t *testing.T) {
+	_, err := parseFilter("workflow")
+	assert.ErrorContains(t, err, "expected key=value")
+	_, err = parseFilter("foo=bar")
+	assert.ErrorContains(t, err, "unknown filter key")
+}
+
+func TestFilterMatches(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	tags := []string{"alpha", "beta"}
+	job := api.Job{Workflow: wf, Status: &api.JobStatus{State: "ACTIVATED"}, Tags: &tags}
+
+	assert.True(t, filter{}.matches(&job, wf))
+	assert.True(t, filter{workflow: wf.Name, state: "ACTIVATED", groups: []string{"CLOSED"}, tags: []string{"beta"}}.matches(&job, wf))
+	assert.False(t, filter{workflow: "foo"}.matches(&job, wf))
+	assert.False(t, filter{state: "INSTALL"}.matches(&job, wf))
+	assert.False(t, filter{groups: []string{"OPEN"}}.matches(&job, wf))
+	assert.False(t, filter{groups: []string{"CLOSED"}}.matches(&job, nil))
+	assert.False(t, filter{tags: []string{"gamma"}}.matches(&job, wf))
+	assert.False(t, filter{tags: []string{"gamma"}}.matches(&api.Job{}, wf))
+}
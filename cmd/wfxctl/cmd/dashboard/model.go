@@ -0,0 +1,510 @@
+package dashboard
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+type mode int
+
+const (
+	modeList mode = iota
+	modeDetails
+	modeTransitions
+	modeFilter
+)
+
+type (
+	jobsMsg struct {
+		jobs []api.Job
+		err  error
+	}
+	workflowMsg struct {
+		name     string
+		workflow *api.Workflow
+		err      error
+	}
+	detailsMsg struct {
+		job *api.Job
+		err error
+	}
+	eventMsg struct {
+		event api.JobEvent
+	}
+	subscriptionMsg struct {
+		generation int
+		err        error
+	}
+	updateMsg struct {
+		state  string
+		total  int
+		errors []error
+	}
+)
+
+type model struct {
+	ctx     context.Context
+	backend backend
+	limit   int32
+
+	filter    filter
+	jobs      []api.Job
+	workflows map[string]*api.Workflow
+	selected  map[string]bool
+	cursor    int
+
+	mode       mode
+	details    *api.Job
+	targets    []string
+	menuCursor int
+	input      textinput.Model
+
+	status    string
+	connected bool
+	events    chan api.JobEvent
+	// cancels the current event subscription
+	unsubscribe context.CancelFunc
+	// incremented for each subscription, used to ignore stale subscriptionMsg
+	generation int
+
+	width, height int
+}
+
+func newModel(ctx context.Context, b backend, f filter, limit int32) model {
+	input := textinput.New()
+	input.Prompt = "filter> "
+	input.Placeholder = "workflow=NAME state=STATE group=G1,G2 tag=T1,T2"
+	return model{
+		ctx:       ctx,
+		backend:   b,
+		limit:     limit,
+		filter:    f,
+		workflows: make(map[string]*api.Workflow),
+		selected:  make(map[string]bool),
+		input:     input,
+		events:    make(chan api.JobEvent, 16),
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loadJobs(), m.waitForEvent())
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m.quit()
+		}
+		switch m.mode {
+		case modeFilter:
+			return m.updateFilter(msg)
+		case modeTransitions:
+			return m.updateTransitions(msg)
+		case modeDetails:
+			return m.updateDetails(msg)
+		default:
+			return m.updateList(msg)
+		}
+	case jobsMsg:
+		if msg.err != nil {
+			m.status = "Failed to query jobs: " + msg.err.Error()
+			return m, nil
+		}
+		m.jobs = msg.jobs
+		m.cursor = min(m.cursor, max(len(m.jobs)-1, 0))
+		var cmds []tea.Cmd
+		for _, job := range m.jobs {
+			cmds = append(cmds, m.cacheWorkflow(job.Workflow))
+		}
+		if m.unsubscribe == nil {
+			cmds = append(cmds, m.subscribe())
+		}
+		return m, tea.Batch(cmds...)
+	case workflowMsg:
+		if msg.err != nil {
+			m.status = fmt.Sprintf("Failed to fetch workflow %s: %s", msg.name, msg.err)
+			return m, nil
+		}
+		m.workflows[msg.name] = msg.workflow
+		return m, nil
+	case detailsMsg:
+		if msg.err != nil {
+			m.status = "Failed to fetch job: " + msg.err.Error()
+			return m, nil
+		}
+		m.details = msg.job
+		m.mode = modeDetails
+		return m, m.cacheWorkflow(msg.job.Workflow)
+	case eventMsg:
+		return m.applyEvent(msg.event)
+	case subscriptionMsg:
+		if msg.generation != m.generation {
+			return m, nil
+		}
+		m.connected = false
+		m.unsubscribe = nil
+		if msg.err != nil {
+			m.status = "Event subscription failed: " + msg.err.Error()
+		}
+		return m, nil
+	case updateMsg:
+		m.status = fmt.Sprintf("Moved %d of %d job(s) to %s", msg.total-len(msg.errors), msg.total, msg.state)
+		if len(msg.errors) > 0 {
+			m.status += ": " + msg.errors[0].Error()
+		}
+		clear(m.selected)
+		return m, m.loadJobs()
+	}
+	return m, nil
+}
+
+func (m model) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m.quit()
+	case "up", "k":
+		m.cursor = max(m.cursor-1, 0)
+	case "down", "j":
+		m.cursor = min(m.cursor+1, max(len(m.jobs)-1, 0))
+	case " ":
+		if job := m.current(); job != nil {
+			if m.selected[job.ID] {
+				delete(m.selected, job.ID)
+			} else {
+				m.selected[job.ID] = true
+			}
+		}
+	case "a":
+		if len(m.selected) > 0 {
+			clear(m.selected)
+		} else {
+			for _, job := range m.jobs {
+				m.selected[job.ID] = true
+			}
+		}
+	case "enter":
+		if job := m.current(); job != nil {
+			return m, m.loadDetails(job.ID)
+		}
+	case "t":
+		return m.openTransitions(m.targetJobs())
+	case "f", "/":
+		m.mode = modeFilter
+		m.input.SetValue(m.filter.String())
+		m.input.CursorEnd()
+		return m, m.input.Focus()
+	case "r":
+		m.status = ""
+		return m, m.loadJobs()
+	}
+	return m, nil
+}
+
+func (m model) updateDetails(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		m.mode = modeList
+		m.details = nil
+	case "t":
+		return m.openTransitions([]api.Job{*m.details})
+	case "r":
+		return m, m.loadDetails(m.details.ID)
+	}
+	return m, nil
+}
+
+func (m model) updateTransitions(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		m.mode = m.previousMode()
+	case "up", "k":
+		m.menuCursor = max(m.menuCursor-1, 0)
+	case "down", "j":
+		m.menuCursor = min(m.menuCursor+1, max(len(m.targets)-1, 0))
+	case "enter":
+		if len(m.targets) == 0 {
+			m.mode = m.previousMode()
+			return m, nil
+		}
+		jobs := m.targetJobs()
+		if m.details != nil {
+			jobs = []api.Job{*m.details}
+		}
+		state := m.targets[m.menuCursor]
+		m.mode = m.previousMode()
+		m.status = fmt.Sprintf("Moving %d job(s) to %s...", len(jobs), state)
+		return m, m.updateStatus(jobs, state)
+	}
+	return m, nil
+}
+
+func (m model) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.mode = modeList
+		m.input.Blur()
+		return m, nil
+	case tea.KeyEnter:
+		f, err := parseFilter(m.input.Value())
+		if err != nil {
+			m.status = err.Error()
+			return m, nil
+		}
+		m.mode = modeList
+		m.input.Blur()
+		m.filter = f
+		m.status = ""
+		m.cursor = 0
+		clear(m.selected)
+		// restart the subscription to apply the new filter
+		if m.unsubscribe != nil {
+			m.unsubscribe()
+		}
+		m.unsubscribe = nil
+		m.connected = false
+		return m, m.loadJobs()
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m model) quit() (tea.Model, tea.Cmd) {
+	if m.unsubscribe != nil {
+		m.unsubscribe()
+	}
+	return m, tea.Quit
+}
+
+func (m model) previousMode() mode {
+	if m.details != nil {
+		return modeDetails
+	}
+	return modeList
+}
+
+// current returns the job under the cursor.
+func (m model) current() *api.Job {
+	if m.cursor < 0 || m.cursor >= len(m.jobs) {
+		return nil
+	}
+	return &m.jobs[m.cursor]
+}
+
+// targetJobs returns the selected jobs or, if no job is selected, the job under the cursor.
+func (m model) targetJobs() []api.Job {
+	if len(m.selected) == 0 {
+		if job := m.current(); job != nil {
+			return []api.Job{*job}
+		}
+		return nil
+	}
+	result := make([]api.Job, 0, len(m.selected))
+	for _, job := range m.jobs {
+		if m.selected[job.ID] {
+			result = append(result, job)
+		}
+	}
+	return result
+}
+
+func (m model) openTransitions(jobs []api.Job) (tea.Model, tea.Cmd) {
+	if len(jobs) == 0 {
+		return m, nil
+	}
+	for _, job := range jobs {
+		if job.Workflow == nil || m.workflows[job.Workflow.Name] == nil {
+			m.status = "Workflow definition not loaded yet, please try again"
+			return m, nil
+		}
+	}
+	m.targets = transitionTargets(jobs, m.workflows)
+	m.menuCursor = 0
+	m.mode = modeTransitions
+	return m, nil
+}
+
+// transitionTargets returns the states which all jobs can be moved to by wfx (i.e. WFX-eligible transitions).
+func transitionTargets(jobs []api.Job, workflows map[string]*api.Workflow) []string {
+	var result []string
+	for i, job := range jobs {
+		wf := workflows[job.Workflow.Name]
+		targets := make([]string, 0)
+		for _, t := range wf.Transitions {
+			if t.From == job.Status.State && t.Eligible == api.WFX && !slices.Contains(targets, t.To) {
+				targets = append(targets, t.To)
+			}
+		}
+		if i == 0 {
+			result = targets
+			continue
+		}
+		result = slices.DeleteFunc(result, func(s string) bool { return !slices.Contains(targets, s) })
+	}
+	return result
+}
+
+// applyEvent merges a job event into the list of jobs.
+func (m model) applyEvent(event api.JobEvent) (tea.Model, tea.Cmd) {
+	next := m.waitForEvent()
+	job := event.Job
+
+	idx := slices.IndexFunc(m.jobs, func(j api.Job) bool { return j.ID == job.ID })
+	if event.Action == api.DELETE {
+		if idx >= 0 {
+			m.jobs = slices.Delete(m.jobs, idx, idx+1)
+			delete(m.selected, job.ID)
+			m.cursor = min(m.cursor, max(len(m.jobs)-1, 0))
+		}
+		return m, next
+	}
+
+	if idx < 0 {
+		if event.Action == api.CREATE && m.matches(&job) {
+			m.jobs = slices.Insert(m.jobs, 0, job)
+			if len(m.jobs) > 1 {
+				// keep the cursor on the same job
+				m.cursor++
+			}
+			return m, tea.Batch(next, m.cacheWorkflow(job.Workflow))
+		}
+		if event.Action == api.UPDATESTATUS && m.filter.state != "" && job.Status != nil && job.Status.State == m.filter.state {
+			// a job entered the filtered state, but the event lacks details such as tags
+			return m, tea.Batch(next, m.loadJobs())
+		}
+		return m, next
+	}
+
+	existing := &m.jobs[idx]
+	if job.Status != nil {
+		existing.Status = job.Status
+	}
+	if job.Mtime != nil {
+		existing.Mtime = job.Mtime
+	}
+	if job.Tags != nil {
+		existing.Tags = job.Tags
+	}
+	if job.Definition != nil {
+		existing.Definition = job.Definition
+	}
+	if m.details != nil && m.details.ID == job.ID {
+		m.details.Status = existing.Status
+		m.details.Mtime = existing.Mtime
+		m.details.Tags = existing.Tags
+	}
+	if !m.matches(existing) {
+		m.jobs = slices.Delete(m.jobs, idx, idx+1)
+		delete(m.selected, job.ID)
+		m.cursor = min(m.cursor, max(len(m.jobs)-1, 0))
+	}
+	return m, next
+}
+
+func (m model) matches(job *api.Job) bool {
+	var wf *api.Workflow
+	if job.Workflow != nil {
+		wf = m.workflows[job.Workflow.Name]
+	}
+	return m.filter.matches(job, wf)
+}
+
+func (m model) loadJobs() tea.Cmd {
+	ctx, b, f, limit := m.ctx, m.backend, m.filter, m.limit
+	return func() tea.Msg {
+		jobs, err := b.QueryJobs(ctx, f, limit)
+		return jobsMsg{jobs: jobs, err: err}
+	}
+}
+
+func (m model) loadDetails(id string) tea.Cmd {
+	ctx, b := m.ctx, m.backend
+	return func() tea.Msg {
+		job, err := b.GetJob(ctx, id)
+		return detailsMsg{job: job, err: err}
+	}
+}
+
+// cacheWorkflow remembers the workflow definition, fetching it if necessary.
+func (m model) cacheWorkflow(wf *api.Workflow) tea.Cmd {
+	if wf == nil || m.workflows[wf.Name] != nil {
+		return nil
+	}
+	if len(wf.Transitions) > 0 {
+		m.workflows[wf.Name] = wf
+		return nil
+	}
+	ctx, b, name := m.ctx, m.backend, wf.Name
+	return func() tea.Msg {
+		result, err := b.GetWorkflow(ctx, name)
+		return workflowMsg{name: name, workflow: result, err: err}
+	}
+}
+
+func (m model) updateStatus(jobs []api.Job, state string) tea.Cmd {
+	ctx, b := m.ctx, m.backend
+	return func() tea.Msg {
+		result := updateMsg{state: state, total: len(jobs)}
+		for _, job := range jobs {
+			if err := b.UpdateStatus(ctx, job.ID, state); err != nil {
+				result.errors = append(result.errors, fmt.Errorf("%s: %w", job.ID, err))
+			}
+		}
+		return result
+	}
+}
+
+func (m *model) subscribe() tea.Cmd {
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.unsubscribe = cancel
+	m.connected = true
+	m.generation++
+	b, f, ch, generation := m.backend, m.filter, m.events, m.generation
+	return func() tea.Msg {
+		return subscriptionMsg{generation: generation, err: b.Subscribe(ctx, f, ch)}
+	}
+}
+
+func (m model) waitForEvent() tea.Cmd {
+	ctx, ch := m.ctx, m.events
+	return func() tea.Msg {
+		select {
+		case event := <-ch:
+			return eventMsg{event: event}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (m model) selectionSummary() string {
+	if len(m.selected) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d selected", len(m.selected))
+}
+
+func (m model) filterSummary() string {
+	if s := m.filter.String(); s != "" {
+		return s
+	}
+	return "none"
+}
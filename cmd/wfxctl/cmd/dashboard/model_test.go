@@ -0,0 +1,311 @@
+package dashboard
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+)
+
+type fakeBackend struct {
+	jobs    []api.Job
+	updates map[string]string
+	err     error
+}
+
+func (b *fakeBackend) QueryJobs(context.Context, filter, int32) ([]api.Job, error) {
+	return b.jobs, b.err
+}
+
+func (b *fakeBackend) GetJob(_ context.Context, id string) (*api.Job, error) {
+	for _, job := range b.jobs {
+		if job.ID == id {
+			return &job, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (b *fakeBackend) GetWorkflow(context.Context, string) (*api.Workflow, error) {
+	return dau.DirectWorkflow(), nil
+}
+
+func (b *fakeBackend) UpdateStatus(_ context.Context, id string, state string) error {
+	if b.err != nil {
+		return b.err
+	}
+	b.updates[id] = state
+	return nil
+}
+
+func (b *fakeBackend) Subscribe(ctx context.Context, _ filter, _ chan<- api.JobEvent) error {
+	<-ctx.Done()
+	return nil
+}
+
+func newJob(id string, state string) api.Job {
+	return api.Job{
+		ID:       id,
+		ClientID: "client",
+		Workflow: &api.Workflow{Name: dau.DirectWorkflow().Name},
+		Status:   &api.JobStatus{State: state},
+	}
+}
+
+func newTestModel(t *testing.T, b backend, f filter) model {
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+	m := newModel(ctx, b, f, defaultLimit)
+	// pretend the jobs were loaded and the workflow was fetched
+	m.workflows[dau.DirectWorkflow().Name] = dau.DirectWorkflow()
+	m.unsubscribe = func() {}
+	m.connected = true
+	return m
+}
+
+func update(t *testing.T, m model, msg tea.Msg) (model, tea.Cmd) {
+	t.Helper()
+	result, cmd := m.Update(msg)
+	return result.(model), cmd
+}
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case " ":
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(s)}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestModel_List(t *testing.T) {
+	b := &fakeBackend{jobs: []api.Job{newJob("1", "INSTALL"), newJob("2", "DOWNLOAD")}}
+	m := newTestModel(t, b, filter{})
+
+	m, _ = update(t, m, m.loadJobs()())
+	require.Len(t, m.jobs, 2)
+	view := m.View()
+	assert.Contains(t, view, "2 job(s)")
+	assert.Contains(t, view, "INSTALL")
+	assert.Contains(t, view, "DOWNLOAD")
+	assert.Contains(t, view, "OPEN")
+
+	m, _ = update(t, m, key("j"))
+	assert.Equal(t, 1, m.cursor)
+	m, _ = update(t, m, key("j"))
+	assert.Equal(t, 1, m.cursor)
+	m, _ = update(t, m, key("k"))
+	assert.Equal(t, 0, m.cursor)
+
+	m, _ = update(t, m, key(" "))
+	assert.Equal(t, map[string]bool{"1": true}, m.selected)
+	assert.Contains(t, m.View(), "1 selected")
+	m, _ = update(t, m, key("a"))
+	assert.Empty(t, m.selected)
+	m, _ = update(t, m, key("a"))
+	assert.Len(t, m.selected, 2)
+}
+
+func TestModel_QueryError(t *testing.T) {
+	b := &fakeBackend{err: errors.New("connection refused")}
+	m := newTestModel(t, b, filter{})
+	m, _ = update(t, m, m.loadJobs()())
+	assert.Contains(t, m.View(), "Failed to query jobs: connection refused")
+	assert.Contains(t, m.View(), "No jobs found.")
+}
+
+func TestModel_Details(t *testing.T) {
+	job := newJob("1", "INSTALLING")
+	history := []api.History{{Status: &api.JobStatus{State: "INSTALL"}}}
+	job.History = &history
+	b := &fakeBackend{jobs: []api.Job{job}}
+	m := newTestModel(t, b, filter{})
+	m, _ = update(t, m, m.loadJobs()())
+
+	m, cmd := update(t, m, key("enter"))
+	require.NotNil(t, cmd)
+	m, _ = update(t, m, cmd())
+	assert.Equal(t, modeDetails, m.mode)
+	view := m.View()
+	assert.Contains(t, view, "History")
+	assert.Contains(t, view, "INSTALLING")
+	assert.Contains(t, view, "INSTALL ")
+
+	m, _ = update(t, m, key("esc"))
+	assert.Equal(t, modeList, m.mode)
+	assert.Nil(t, m.details)
+}
+
+func TestModel_Transitions(t *testing.T) {
+	b := &fakeBackend{
+		jobs:    []api.Job{newJob("1", "INSTALLED"), newJob("2", "INSTALLED")},
+		updates: make(map[string]string),
+	}
+	m := newTestModel(t, b, filter{})
+	m, _ = update(t, m, m.loadJobs()())
+	m, _ = update(t, m, key("a"))
+
+	m, _ = update(t, m, key("t"))
+	require.Equal(t, modeTransitions, m.mode)
+	assert.Equal(t, []string{"ACTIVATE"}, m.targets)
+	assert.Contains(t, m.View(), "> ACTIVATE")
+
+	m, cmd := update(t, m, key("enter"))
+	assert.Equal(t, modeList, m.mode)
+	require.NotNil(t, cmd)
+	m, _ = update(t, m, cmd())
+	assert.Equal(t, map[string]string{"1": "ACTIVATE", "2": "ACTIVATE"}, b.updates)
+	assert.Contains(t, m.View(), "Moved 2 of 2 job(s) to ACTIVATE")
+	assert.Empty(t, m.selected)
+}
+
+func TestModel_TransitionsNone(t *testing.T) {
+	b := &fakeBackend{jobs: []api.Job{newJob("1", "INSTALL")}}
+	m := newTestModel(t, b, filter{})
+	m, _ = update(t, m, m.loadJobs()())
+
+	m, _ = update(t, m, key("t"))
+	require.Equal(t, modeTransitions, m.mode)
+	assert.Contains(t, m.View(), "No transitions eligible for WFX available.")
+	m, cmd := update(t, m, key("enter"))
+	assert.Nil(t, cmd)
+	assert.Equal(t, modeList, m.mode)
+}
+
+func TestTransitionTargets(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	workflows := map[string]*api.Workflow{wf.Name: wf}
+	jobs := []api.Job{newJob("1", "INSTALLED"), newJob("2", "DOWNLOADED")}
+	assert.Equal(t, []string{"ACTIVATE"}, transitionTargets(jobs[:1], workflows))
+	assert.Empty(t, transitionTargets(jobs[1:], workflows))
+	assert.Empty(t, transitionTargets(jobs, workflows))
+}
+
+func TestModel_Filter(t *testing.T) {
+	b := &fakeBackend{jobs: []api.Job{newJob("1", "INSTALL")}}
+	m := newTestModel(t, b, filter{})
+	unsubscribed := false
+	m.unsubscribe = func() { unsubscribed = true }
+	m, _ = update(t, m, m.loadJobs()())
+
+	m, _ = update(t, m, key("f"))
+	require.Equal(t, modeFilter, m.mode)
+	m.input.SetValue("bogus")
+	m, _ = update(t, m, key("enter"))
+	assert.Equal(t, modeFilter, m.mode)
+	assert.Contains(t, m.View(), "invalid filter expression")
+
+	m.input.SetValue("state=INSTALL")
+	m, cmd := update(t, m, key("enter"))
+	assert.Equal(t, modeList, m.mode)
+	assert.Equal(t, filter{state: "INSTALL"}, m.filter)
+	assert.True(t, unsubscribed)
+	assert.False(t, m.connected)
+	require.NotNil(t, cmd)
+
+	// reloading the jobs starts a new subscription
+	m, _ = update(t, m, cmd())
+	assert.True(t, m.connected)
+	assert.NotNil(t, m.unsubscribe)
+	assert.Contains(t, m.View(), "filter: state=INSTALL")
+	m.unsubscribe()
+}
+
+func TestModel_StaleSubscription(t *testing.T) {
+	m := newTestModel(t, &fakeBackend{}, filter{})
+	m.generation = 2
+	m, _ = update(t, m, subscriptionMsg{generation: 1})
+	assert.True(t, m.connected)
+	m, _ = update(t, m, subscriptionMsg{generation: 2, err: errors.New("boom")})
+	assert.False(t, m.connected)
+	assert.Nil(t, m.unsubscribe)
+	assert.Contains(t, m.View(), "Event subscription failed: boom")
+	assert.Contains(t, m.View(), "offline")
+}
+
+func TestModel_ApplyEvent(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	m := newTestModel(t, &fakeBackend{}, filter{groups: []string{"OPEN"}})
+	m.jobs = []api.Job{newJob("1", "INSTALL"), newJob("2", "DOWNLOAD")}
+
+	t.Run("create", func(t *testing.T) {
+		job := newJob("3", "INSTALL")
+		job.Workflow = wf
+		m, _ = update(t, m, eventMsg{event: api.JobEvent{Action: api.CREATE, Job: job}})
+		require.Len(t, m.jobs, 3)
+		assert.Equal(t, "3", m.jobs[0].ID)
+		assert.Equal(t, 1, m.cursor)
+	})
+
+	t.Run("create non-matching", func(t *testing.T) {
+		job := newJob("4", "ACTIVATED")
+		m, _ = update(t, m, eventMsg{event: api.JobEvent{Action: api.CREATE, Job: job}})
+		assert.Len(t, m.jobs, 3)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		progress := int32(42)
+		job := api.Job{ID: "1", Status: &api.JobStatus{State: "INSTALLING", Progress: &progress}}
+		m, _ = update(t, m, eventMsg{event: api.JobEvent{Action: api.UPDATESTATUS, Job: job}})
+		require.Len(t, m.jobs, 3)
+		assert.Equal(t, "INSTALLING", m.jobs[1].Status.State)
+		assert.Contains(t, m.View(), "42%")
+	})
+
+	t.Run("update leaves filter", func(t *testing.T) {
+		m.selected["1"] = true
+		job := api.Job{ID: "1", Status: &api.JobStatus{State: "ACTIVATED"}}
+		m, _ = update(t, m, eventMsg{event: api.JobEvent{Action: api.UPDATESTATUS, Job: job}})
+		require.Len(t, m.jobs, 2)
+		assert.Empty(t, m.selected)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m, _ = update(t, m, eventMsg{event: api.JobEvent{Action: api.DELETE, Job: api.Job{ID: "3"}}})
+		require.Len(t, m.jobs, 1)
+		assert.Equal(t, "2", m.jobs[0].ID)
+		assert.Equal(t, 0, m.cursor)
+	})
+}
+
+func TestModel_ApplyEventReload(t *testing.T) {
+	m := newTestModel(t, &fakeBackend{jobs: []api.Job{newJob("1", "INSTALL")}}, filter{state: "INSTALL"})
+	job := api.Job{ID: "1", Status: &api.JobStatus{State: "INSTALL"}}
+	m, cmd := update(t, m, eventMsg{event: api.JobEvent{Action: api.UPDATESTATUS, Job: job}})
+	require.NotNil(t, cmd)
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	require.Len(t, batch, 2)
+	m, _ = update(t, m, batch[1]())
+	assert.Len(t, m.jobs, 1)
+}
+
+func TestVisibleRows(t *testing.T) {
+	m := model{}
+	first, last := m.visibleRows(100)
+	assert.Equal(t, 0, first)
+	assert.Equal(t, 100, last)
+
+	m.height = 16
+	m.cursor = 50
+	first, last = m.visibleRows(100)
+	assert.Equal(t, 41, first)
+	assert.Equal(t, 51, last)
+}
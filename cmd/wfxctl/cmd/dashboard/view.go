@@ -0,0 +1,229 @@
+package dashboard
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+)
+
+var (
+	titleStyle  = lipgloss.NewStyle().Bold(true)
+	headerStyle = lipgloss.NewStyle().Bold(true).Underline(true)
+	cursorStyle = lipgloss.NewStyle().Reverse(true)
+	helpStyle   = lipgloss.NewStyle().Faint(true)
+	statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("3"))
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+func (m model) View() string {
+	var b strings.Builder
+
+	live := "offline"
+	if m.connected {
+		live = "live"
+	}
+	header := fmt.Sprintf("wfx dashboard | filter: %s | %d job(s) | %s", m.filterSummary(), len(m.jobs), live)
+	if s := m.selectionSummary(); s != "" {
+		header += " | " + s
+	}
+	b.WriteString(titleStyle.Render(header))
+	b.WriteString("\n\n")
+
+	var help string
+	switch m.mode {
+	case modeDetails:
+		b.WriteString(m.viewDetails())
+		help = "t: transitions • r: refresh • esc: back • ctrl+c: quit"
+	case modeTransitions:
+		b.WriteString(m.viewTransitions())
+		help = "↑/↓: navigate • enter: apply • esc: cancel"
+	case modeFilter:
+		b.WriteString(m.viewList())
+		b.WriteString("\n")
+		b.WriteString(m.input.View())
+		help = "enter: apply • esc: cancel"
+	default:
+		b.WriteString(m.viewList())
+		help = "↑/↓: navigate • space: select • a: select all/none • enter: details • t: transitions • f: filter • r: refresh • q: quit"
+	}
+
+	b.WriteString("\n")
+	if m.status != "" {
+		b.WriteString(statusStyle.Render(m.status))
+		b.WriteString("\n")
+	}
+	b.WriteString(helpStyle.Render(help))
+	return b.String()
+}
+
+func (m model) viewList() string {
+	if len(m.jobs) == 0 {
+		return "No jobs found.\n"
+	}
+
+	var buf strings.Builder
+	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "\tID\tCLIENT\tWORKFLOW\tSTATE\tGROUP\tPROGRESS\tMODIFIED")
+	for _, job := range m.jobs {
+		mark := " "
+		if m.selected[job.ID] {
+			mark = "*"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			mark, job.ID, job.ClientID, workflowName(&job), state(&job), m.group(&job), progress(&job), formatTime(job.Mtime))
+	}
+	_ = tw.Flush()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	first, last := m.visibleRows(len(lines) - 1)
+
+	var b strings.Builder
+	b.WriteString(headerStyle.Render(lines[0]))
+	b.WriteString("\n")
+	for i := first; i < last; i++ {
+		line := lines[i+1]
+		if i == m.cursor {
+			line = cursorStyle.Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	if hidden := len(m.jobs) - (last - first); hidden > 0 {
+		fmt.Fprintf(&b, "(%d more)\n", hidden)
+	}
+	return b.String()
+}
+
+// visibleRows returns the range of rows which fit on the screen and contain the cursor.
+func (m model) visibleRows(n int) (int, int) {
+	// header, blank line, table header, status, help, "more" indicator
+	const reserved = 6
+	rows := m.height - reserved
+	if m.height == 0 || rows >= n {
+		return 0, n
+	}
+	rows = max(rows, 1)
+	first := max(m.cursor-rows+1, 0)
+	return first, first + rows
+}
+
+func (m model) viewDetails() string {
+	job := m.details
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID:\t%s\n", job.ID)
+	fmt.Fprintf(tw, "Client:\t%s\n", job.ClientID)
+	fmt.Fprintf(tw, "Workflow:\t%s\n", workflowName(job))
+	fmt.Fprintf(tw, "State:\t%s\n", state(job))
+	fmt.Fprintf(tw, "Group:\t%s\n", m.group(job))
+	fmt.Fprintf(tw, "Progress:\t%s\n", progress(job))
+	if job.Status != nil && job.Status.Message != "" {
+		fmt.Fprintf(tw, "Message:\t%s\n", job.Status.Message)
+	}
+	if job.Tags != nil && len(*job.Tags) > 0 {
+		fmt.Fprintf(tw, "Tags:\t%s\n", strings.Join(*job.Tags, ", "))
+	}
+	fmt.Fprintf(tw, "Created:\t%s\n", formatTime(job.Stime))
+	fmt.Fprintf(tw, "Modified:\t%s\n", formatTime(job.Mtime))
+	if len(job.Definition) > 0 {
+		definition, _ := json.Marshal(job.Definition)
+		fmt.Fprintf(tw, "Definition:\t%s\n", definition)
+	}
+	_ = tw.Flush()
+
+	b.WriteString("\n")
+	b.WriteString(titleStyle.Render("History"))
+	b.WriteString("\n")
+	if job.History == nil || len(*job.History) == 0 {
+		b.WriteString("No history.\n")
+		return b.String()
+	}
+	tw = tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODIFIED\tSTATE\tPROGRESS\tMESSAGE")
+	for _, h := range *job.History {
+		if h.Status == nil {
+			continue
+		}
+		p := ""
+		if h.Status.Progress != nil {
+			p = fmt.Sprintf("%d%%", *h.Status.Progress)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", formatTime(h.Mtime), h.Status.State, p, h.Status.Message)
+	}
+	_ = tw.Flush()
+	return b.String()
+}
+
+func (m model) viewTransitions() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Move job(s) to"))
+	b.WriteString("\n")
+	if len(m.targets) == 0 {
+		b.WriteString("No transitions eligible for WFX available.\n")
+		return b.String()
+	}
+	for i, target := range m.targets {
+		line := "  " + target
+		if i == m.menuCursor {
+			line = cursorStyle.Render("> " + target)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (m model) group(job *api.Job) string {
+	if job.Workflow == nil || job.Status == nil {
+		return ""
+	}
+	wf := m.workflows[job.Workflow.Name]
+	if wf == nil {
+		return ""
+	}
+	return workflow.FindStateGroup(wf, job.Status.State)
+}
+
+func workflowName(job *api.Job) string {
+	if job.Workflow == nil {
+		return ""
+	}
+	return job.Workflow.Name
+}
+
+func state(job *api.Job) string {
+	if job.Status == nil {
+		return ""
+	}
+	return job.Status.State
+}
+
+func progress(job *api.Job) string {
+	if job.Status == nil || job.Status.Progress == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d%%", *job.Status.Progress)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Local().Format(timeFormat)
+}
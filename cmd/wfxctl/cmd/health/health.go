@@ -12,12 +12,10 @@ import (
 	"bufio"
 	"fmt"
 	"io"
-	"os"
 	"sync"
 
 	"github.com/Southclaws/fault"
 	"github.com/gookit/color"
-	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 
 	"github.com/siemens/wfx/cmd/wfxctl/errutil"
@@ -32,9 +30,9 @@ type Endpoint struct {
 }
 
 const (
-	colorNever  = "never"
-	colorAlways = "always"
-	colorAuto   = "auto"
+	colorNever  = flags.ColorNever
+	colorAlways = flags.ColorAlways
+	colorAuto   = flags.ColorAuto
 )
 
 func NewCommand() *cobra.Command {
@@ -46,16 +44,9 @@ func NewCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			b := flags.NewBaseCmd(cmd.Flags())
 
-			var useColor bool
-			switch b.ColorMode {
-			case colorAlways:
-				useColor = true
-			case colorAuto:
-				useColor = isatty.IsTerminal(os.Stdout.Fd())
-			case colorNever:
-				useColor = false
-			default:
-				return fmt.Errorf("unsupported color mode: %s", b.ColorMode)
+			useColor, err := b.UseColor(cmd.OutOrStdout())
+			if err != nil {
+				return fault.Wrap(err)
 			}
 
 			swagger := errutil.Must(api.GetSpec())
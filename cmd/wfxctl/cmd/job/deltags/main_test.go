@@ -9,11 +9,20 @@ package deltags
  */
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
 	"go.uber.org/goleak"
 )
 
 func TestMain(m *testing.M) {
-	goleak.VerifyTestMain(m)
+	// do not pick up the contexts of the user running the tests
+	dir, err := os.MkdirTemp("", "wfxctl")
+	if err != nil {
+		panic(err)
+	}
+	_ = os.Setenv(contexts.EnvConfig, filepath.Join(dir, "config.yaml"))
+	goleak.VerifyTestMain(m, goleak.Cleanup(func(int) { _ = os.RemoveAll(dir) }))
 }
@@ -19,8 +19,10 @@ import (
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/job/getstatus"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/job/gettags"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/job/query"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/job/stats"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/job/updatedefinition"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/job/updatestatus"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/job/watch"
 	"github.com/spf13/cobra"
 )
 
@@ -36,6 +38,7 @@ func NewCommand() *cobra.Command {
 	cmd.AddCommand(delete.NewCommand())
 	cmd.AddCommand(get.NewCommand())
 	cmd.AddCommand(query.NewCommand())
+	cmd.AddCommand(stats.NewCommand())
 	cmd.AddCommand(updatestatus.NewCommand())
 	cmd.AddCommand(getstatus.NewCommand())
 	cmd.AddCommand(updatedefinition.NewCommand())
@@ -44,5 +47,6 @@ func NewCommand() *cobra.Command {
 	cmd.AddCommand(deltags.NewCommand())
 	cmd.AddCommand(gettags.NewCommand())
 	cmd.AddCommand(events.NewCommand())
+	cmd.AddCommand(watch.NewCommand())
 	return cmd
 }
@@ -0,0 +1,166 @@
+package stats
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Count jobs grouped by their properties",
+		Long: `Count jobs grouped by any combination of workflow, state, group, tag and client ID.
+Jobs can additionally be bucketed into time intervals based on their creation (stime) or modification time (mtime).
+
+The result is printed as a table, unless an output filter is given.`,
+		Example: `
+wfxctl job stats --group-by=workflow,state
+wfxctl job stats --group-by=group --workflow=wfx.workflow.dau.direct --bucket=stime --interval=1h
+`,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+
+			params := new(api.GetJobsStatsParams)
+			if clientID := baseCmd.ClientID; clientID != "" {
+				params.ParamClientID = &clientID
+			}
+			if state := baseCmd.State; state != "" {
+				params.ParamState = &state
+			}
+			if workflow := baseCmd.Workflow; workflow != "" {
+				params.ParamWorkflow = &workflow
+			}
+			if groups := baseCmd.Groups; len(groups) > 0 {
+				params.ParamGroup = &groups
+			}
+			params.ParamTag = baseCmd.Tags
+
+			groupBy := make([]api.JobStatsProperty, 0, len(baseCmd.GroupBy))
+			for _, s := range baseCmd.GroupBy {
+				property := api.JobStatsProperty(s)
+				if !property.Valid() {
+					return fmt.Errorf("invalid %s value: %s", flags.GroupByFlag, s)
+				}
+				if !slices.Contains(groupBy, property) {
+					groupBy = append(groupBy, property)
+				}
+			}
+			if len(groupBy) > 0 {
+				params.ParamGroupBy = &groupBy
+			}
+
+			if baseCmd.Bucket != "" {
+				bucket := api.JobStatsTimeField(baseCmd.Bucket)
+				if !bucket.Valid() {
+					return fmt.Errorf("invalid %s value: %s", flags.BucketFlag, baseCmd.Bucket)
+				}
+				params.ParamBucket = &bucket
+				interval := int64(baseCmd.Interval / time.Second)
+				params.ParamInterval = &interval
+			}
+
+			client := errutil.Must(baseCmd.CreateMgmtClient())
+			resp, err := client.GetJobsStats(cmd.Context(), params)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			tableOutput := baseCmd.Output == "" || baseCmd.Output == flags.OutputTable || baseCmd.Output == flags.OutputWide
+			if baseCmd.Filter != "" || !tableOutput || resp.StatusCode != http.StatusOK {
+				return fault.Wrap(baseCmd.ProcessResponse(resp, cmd.OutOrStdout()))
+			}
+
+			defer resp.Body.Close()
+			var stats api.JobStats
+			if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+				return fault.Wrap(err)
+			}
+			return fault.Wrap(writeTable(cmd.OutOrStdout(), stats, groupBy, params.ParamBucket != nil))
+		},
+	}
+	f := cmd.Flags()
+	f.String(flags.ClientIDFlag, "", "Filter jobs belonging to a specific client with clientId")
+	f.StringSlice(flags.GroupFlag, []string{}, "Filter jobs based on the group they belong to")
+	f.String(flags.StateFlag, "", "Filter jobs based on the current state value")
+	f.String(flags.WorkflowFlag, "", "Filter jobs based on workflow name")
+	f.StringSlice(flags.TagFlag, nil, "Filter jobs by tags")
+	f.StringSlice(flags.GroupByFlag, nil, "Group jobs by the given properties. possible values: workflow, state, group, tag, clientId")
+	f.String(flags.BucketFlag, "", "Group jobs into time intervals based on the given timestamp. possible values: stime, mtime")
+	f.Duration(flags.IntervalFlag, 24*time.Hour, "length of a time interval (truncated to seconds); only used in combination with --"+flags.BucketFlag)
+	return cmd
+}
+
+func writeTable(w io.Writer, stats api.JobStats, groupBy []api.JobStatsProperty, bucket bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	header := make([]string, 0, len(groupBy)+2)
+	if bucket {
+		header = append(header, "BUCKET")
+	}
+	for _, property := range groupBy {
+		header = append(header, columnName(property))
+	}
+	header = append(header, "COUNT")
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for _, entry := range stats.Content {
+		row := make([]string, 0, len(header))
+		if bucket && entry.Bucket != nil {
+			row = append(row, entry.Bucket.UTC().Format(time.RFC3339))
+		}
+		for _, property := range groupBy {
+			row = append(row, columnValue(entry, property))
+		}
+		row = append(row, fmt.Sprint(entry.Count))
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return fault.Wrap(tw.Flush())
+}
+
+func columnName(property api.JobStatsProperty) string {
+	if property == api.JobStatsPropertyClientId {
+		return "CLIENT ID"
+	}
+	return strings.ToUpper(string(property))
+}
+
+func columnValue(entry api.JobStatsEntry, property api.JobStatsProperty) string {
+	var value *string
+	switch property {
+	case api.JobStatsPropertyWorkflow:
+		value = entry.Workflow
+	case api.JobStatsPropertyState:
+		value = entry.State
+	case api.JobStatsPropertyGroup:
+		value = entry.Group
+	case api.JobStatsPropertyTag:
+		value = entry.Tag
+	case api.JobStatsPropertyClientId:
+		value = entry.ClientID
+	}
+	if value == nil || *value == "" {
+		return "-"
+	}
+	return *value
+}
@@ -0,0 +1,77 @@
+package stats
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobStats(t *testing.T) {
+	const expectedPath = "/api/wfx/v1/jobs/stats"
+	var actualPath string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actualPath = r.URL.Path
+
+		values := r.URL.Query()
+		assert.Equal(t, "workflow,state", values.Get("groupBy"))
+		assert.Equal(t, "stime", values.Get("bucket"))
+		assert.Equal(t, "3600", values.Get("interval"))
+		assert.Equal(t, "wfx.workflow.dau.direct", values.Get("workflow"))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"content":[
+{"bucket":"2026-10-18T10:00:00Z","workflow":"wfx.workflow.dau.direct","state":"INSTALL","count":2},
+{"bucket":"2026-10-18T11:00:00Z","workflow":"wfx.workflow.dau.direct","state":"ACTIVATED","count":10}
+]}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	t.Setenv("WFX_MGMT_HOST", u.Hostname())
+	t.Setenv("WFX_MGMT_PORT", u.Port())
+
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{
+		"--" + flags.GroupByFlag, "workflow,state",
+		"--" + flags.BucketFlag, "stime",
+		"--" + flags.IntervalFlag, "1h",
+		"--" + flags.WorkflowFlag, "wfx.workflow.dau.direct",
+	})
+	err := cmd.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, expectedPath, actualPath)
+	assert.Equal(t, `BUCKET                WORKFLOW                 STATE      COUNT
+2026-10-18T10:00:00Z  wfx.workflow.dau.direct  INSTALL    2
+2026-10-18T11:00:00Z  wfx.workflow.dau.direct  ACTIVATED  10
+`, out.String())
+}
+
+func TestJobStats_InvalidGroupBy(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--" + flags.GroupByFlag, "foo"})
+	assert.ErrorContains(t, cmd.Execute(), "invalid group-by value: foo")
+}
+
+func TestJobStats_InvalidBucket(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--" + flags.BucketFlag, "foo"})
+	assert.ErrorContains(t, cmd.Execute(), "invalid bucket value: foo")
+}
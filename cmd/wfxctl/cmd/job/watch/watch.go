@@ -0,0 +1,288 @@
+package watch
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+	"github.com/tmaxmax/go-sse"
+
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+)
+
+// Exit codes; any other error results in exit code 1.
+const (
+	// ExitFailed indicates that the job ended in a final state which does not belong to a success group.
+	ExitFailed = 2
+	// ExitTimeout indicates that the job did not finish in time.
+	ExitTimeout = 3
+)
+
+const defaultSuccessGroup = "CLOSED"
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Follow a job until it reaches a final state",
+		Long: fmt.Sprintf(`Follow a job until it reaches a final state.
+
+Each status update of the job is printed. The command terminates once the job
+reaches a final state of its workflow, or earlier if --%s or --%s is given.
+
+Exit codes:
+  0  the job reached a final state belonging to one of the success groups, or a state given by --%s or --%s
+  %d  the job reached a final state which does not belong to any success group
+  %d  the timeout expired
+  1  any other error, e.g. the job does not exist
+`, flags.UntilStateFlag, flags.UntilGroupFlag, flags.UntilStateFlag, flags.UntilGroupFlag, ExitFailed, ExitTimeout),
+		Example: `
+wfxctl job watch --id=1 --timeout=30m
+wfxctl job watch --id=1 --until-state=INSTALLED
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			f := cmd.Flags()
+			baseCmd := flags.NewBaseCmd(f)
+			if baseCmd.ID == "" {
+				return fmt.Errorf("--%s is required", flags.IDFlag)
+			}
+
+			ctx := cmd.Context()
+			if baseCmd.Timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, baseCmd.Timeout)
+				defer cancel()
+			}
+
+			client := errutil.Must(baseCmd.CreateClient())
+			httpClient := errutil.Must(baseCmd.CreateHTTPClient())
+			httpClient.Timeout = 0
+			// use sane defaults (e.g. auto reconnect) from the default client
+			sseClient := *sse.DefaultClient
+			sseClient.HTTPClient = httpClient
+			if autoReconnect, _ := f.GetBool(flags.AutoReconnectFlag); !autoReconnect {
+				sseClient.Backoff.MaxRetries = -1
+			}
+			sseClient.OnRetry = func(_ error, sleep time.Duration) {
+				fmt.Fprintf(cmd.ErrOrStderr(), "SSE connection lost. Attempting to reconnect in %v...\n", sleep)
+			}
+
+			w := watcher{
+				out:           cmd.OutOrStdout(),
+				id:            baseCmd.ID,
+				client:        &api.ClientWithResponses{ClientInterface: client},
+				server:        client.Server,
+				sseClient:     &sseClient,
+				untilStates:   baseCmd.UntilStates,
+				untilGroups:   baseCmd.UntilGroups,
+				successGroups: baseCmd.SuccessGroups,
+			}
+			err := w.run(ctx)
+			if errors.Is(err, context.DeadlineExceeded) && cmd.Context().Err() == nil {
+				return &errutil.ExitError{Code: ExitTimeout, Err: fmt.Errorf("timed out waiting for job %s", baseCmd.ID)}
+			}
+			return fault.Wrap(err)
+		},
+	}
+	f := cmd.Flags()
+	f.String(flags.IDFlag, "", "job id")
+	f.Duration(flags.TimeoutFlag, 0, "maximum time to wait (0 means no timeout)")
+	f.StringSlice(flags.UntilStateFlag, nil, "stop as soon as the job reaches one of the given states")
+	f.StringSlice(flags.UntilGroupFlag, nil, "stop as soon as the job reaches a state in one of the given groups")
+	f.StringSlice(flags.SuccessGroupFlag, []string{defaultSuccessGroup}, "groups of final states which are considered successful")
+	f.Bool(flags.AutoReconnectFlag, true, "auto reconnect on connection loss")
+	return cmd
+}
+
+type watcher struct {
+	out       io.Writer
+	id        string
+	client    *api.ClientWithResponses
+	server    string
+	sseClient *sse.Client
+
+	untilStates   []string
+	untilGroups   []string
+	successGroups []string
+
+	workflow *api.Workflow
+	// last printed status, used to suppress duplicates
+	last *api.JobStatus
+}
+
+func (w *watcher) run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan api.JobEvent, 16)
+	// signaled whenever the (re-)connection was established
+	connected := make(chan struct{}, 1)
+	subscriptionErr := make(chan error, 1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		subscriptionErr <- w.subscribe(ctx, events, connected)
+	}()
+	defer wg.Wait()
+	defer cancel()
+
+	for {
+		select {
+		case <-connected:
+			// fetch the job after (re-)connecting so that no status update is missed
+			job, err := w.fetchJob(ctx)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			if done, err := w.update(job.Status, job.Mtime); done || err != nil {
+				return err
+			}
+		case event := <-events:
+			if event.Action == api.DELETE {
+				return fmt.Errorf("job %s was deleted", w.id)
+			}
+			if event.Job.Status == nil || w.workflow == nil {
+				continue
+			}
+			if done, err := w.update(event.Job.Status, &event.Ctime); done || err != nil {
+				return err
+			}
+		case err := <-subscriptionErr:
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if err == nil {
+				err = errors.New("connection to server lost")
+			}
+			return fault.Wrap(err)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (w *watcher) subscribe(ctx context.Context, events chan<- api.JobEvent, connected chan<- struct{}) error {
+	req, err := api.NewGetJobsEventsRequest(w.server, &api.GetJobsEventsParams{JobIds: &w.id})
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	sseClient := *w.sseClient
+	sseClient.ResponseValidator = func(resp *http.Response) error {
+		if err := sse.DefaultValidator(resp); err != nil {
+			return fault.Wrap(err)
+		}
+		select {
+		case connected <- struct{}{}:
+		default:
+		}
+		return nil
+	}
+	conn := sseClient.NewConnection(req.WithContext(ctx))
+	unsubscribe := conn.SubscribeMessages(func(event sse.Event) {
+		var jobEvent api.JobEvent
+		if err := json.Unmarshal([]byte(event.Data), &jobEvent); err != nil {
+			return
+		}
+		select {
+		case events <- jobEvent:
+		case <-ctx.Done():
+		}
+	})
+	defer unsubscribe()
+	return fault.Wrap(conn.Connect())
+}
+
+func (w *watcher) fetchJob(ctx context.Context) (*api.Job, error) {
+	resp, err := w.client.GetJobsIdWithResponse(ctx, w.id, nil)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if resp.JSON200 == nil {
+		errResp := new(api.ErrorResponse)
+		if err := json.Unmarshal(resp.Body, errResp); err == nil && errResp.Errors != nil && len(*errResp.Errors) > 0 {
+			return nil, fmt.Errorf("failed to fetch job %s: %s", w.id, (*errResp.Errors)[0].Message)
+		}
+		return nil, fmt.Errorf("failed to fetch job %s: HTTP %d", w.id, resp.StatusCode())
+	}
+	job := resp.JSON200
+	if job.Workflow == nil {
+		return nil, fmt.Errorf("job %s has no workflow", w.id)
+	}
+	w.workflow = job.Workflow
+	return job, nil
+}
+
+// update prints the status and reports whether watching is done. The returned error determines the exit code.
+func (w *watcher) update(status *api.JobStatus, mtime *time.Time) (bool, error) {
+	if status == nil {
+		return false, nil
+	}
+	if w.last == nil || !sameStatus(w.last, status) {
+		w.print(status, mtime)
+		w.last = status
+	}
+
+	state := status.State
+	group := workflow.FindStateGroup(w.workflow, state)
+	if slices.Contains(w.untilStates, state) || (group != "" && slices.Contains(w.untilGroups, group)) {
+		fmt.Fprintf(w.out, "Job %s reached state %s\n", w.id, describe(state, group))
+		return true, nil
+	}
+	if !slices.Contains(workflow.FindFinalStates(w.workflow), state) {
+		return false, nil
+	}
+	if group != "" && slices.Contains(w.successGroups, group) {
+		fmt.Fprintf(w.out, "Job %s finished in state %s\n", w.id, describe(state, group))
+		return true, nil
+	}
+	return true, &errutil.ExitError{Code: ExitFailed, Err: fmt.Errorf("job %s finished in state %s", w.id, describe(state, group))}
+}
+
+func (w *watcher) print(status *api.JobStatus, mtime *time.Time) {
+	parts := make([]string, 0, 4)
+	if mtime != nil {
+		parts = append(parts, mtime.Format(time.RFC3339))
+	}
+	parts = append(parts, status.State)
+	if status.Progress != nil {
+		parts = append(parts, fmt.Sprintf("%d%%", *status.Progress))
+	}
+	if status.Message != "" {
+		parts = append(parts, status.Message)
+	}
+	fmt.Fprintln(w.out, strings.Join(parts, "  "))
+}
+
+func sameStatus(a, b *api.JobStatus) bool {
+	return a.State == b.State && a.Message == b.Message &&
+		((a.Progress == nil && b.Progress == nil) || (a.Progress != nil && b.Progress != nil && *a.Progress == *b.Progress))
+}
+
+func describe(state string, group string) string {
+	if group == "" {
+		return state
+	}
+	return fmt.Sprintf("%s (group %s)", state, group)
+}
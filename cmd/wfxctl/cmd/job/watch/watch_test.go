@@ -0,0 +1,166 @@
+package watch
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+)
+
+var mtime = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// newServer serves the job with the given state and streams the given events.
+func newServer(t *testing.T, state string, events ...api.JobEvent) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/wfx/v1/jobs/1":
+			progress := int32(10)
+			job := api.Job{
+				ID:       "1",
+				ClientID: "foo",
+				Workflow: dau.DirectWorkflow(),
+				Status:   &api.JobStatus{State: state, Progress: &progress},
+				Mtime:    &mtime,
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(job)
+		case "/api/wfx/v1/jobs/events":
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			// give the client some time to fetch the job
+			time.Sleep(50 * time.Millisecond)
+			stream := events
+			if r.URL.Query().Get("jobIds") != "1" {
+				stream = nil
+			}
+			for _, event := range stream {
+				b, _ := json.Marshal(event)
+				_, _ = fmt.Fprintf(w, "data: %s\n\n", b)
+				w.(http.Flusher).Flush()
+			}
+			<-r.Context().Done()
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"code":"wfx.jobNotFound","message":"job not found"}]}`))
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	u, _ := url.Parse(ts.URL)
+	t.Setenv("WFX_CLIENT_HOST", u.Hostname())
+	t.Setenv("WFX_CLIENT_PORT", u.Port())
+}
+
+func statusEvent(state string) api.JobEvent {
+	return api.JobEvent{
+		Action: api.UPDATESTATUS,
+		Ctime:  mtime,
+		Job:    api.Job{ID: "1", Status: &api.JobStatus{State: state}},
+	}
+}
+
+func execute(t *testing.T, args ...string) (string, error) {
+	buf := new(bytes.Buffer)
+	cmd := NewCommand()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs(append([]string{"--auto-reconnect=false"}, args...))
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func TestWatch_Success(t *testing.T) {
+	newServer(t, "INSTALLING", statusEvent("INSTALLING"), statusEvent("INSTALLED"), statusEvent("ACTIVATE"), statusEvent("ACTIVATED"))
+	out, err := execute(t, "--id=1", "--timeout=10s")
+	require.NoError(t, err)
+	expected := `2026-01-02T03:04:05Z  INSTALLING  10%
+2026-01-02T03:04:05Z  INSTALLING
+2026-01-02T03:04:05Z  INSTALLED
+2026-01-02T03:04:05Z  ACTIVATE
+2026-01-02T03:04:05Z  ACTIVATED
+Job 1 finished in state ACTIVATED (group CLOSED)
+`
+	assert.Equal(t, expected, out)
+}
+
+func TestWatch_Failed(t *testing.T) {
+	newServer(t, "INSTALLING", statusEvent("TERMINATED"))
+	out, err := execute(t, "--id=1", "--timeout=10s")
+	assert.EqualError(t, err, "job 1 finished in state TERMINATED (group FAILED)")
+	assert.Equal(t, ExitFailed, errutil.ExitCode(err))
+	assert.Contains(t, out, "TERMINATED")
+}
+
+func TestWatch_SuccessGroup(t *testing.T) {
+	newServer(t, "TERMINATED")
+	_, err := execute(t, "--id=1", "--success-group=CLOSED,FAILED")
+	require.NoError(t, err)
+}
+
+func TestWatch_AlreadyFinished(t *testing.T) {
+	newServer(t, "ACTIVATED")
+	out, err := execute(t, "--id=1")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-01-02T03:04:05Z  ACTIVATED  10%\nJob 1 finished in state ACTIVATED (group CLOSED)\n", out)
+}
+
+func TestWatch_UntilState(t *testing.T) {
+	newServer(t, "INSTALLING", statusEvent("INSTALLED"), statusEvent("ACTIVATE"))
+	out, err := execute(t, "--id=1", "--timeout=10s", "--until-state=INSTALLED")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Job 1 reached state INSTALLED (group OPEN)")
+	assert.NotContains(t, out, "ACTIVATE")
+}
+
+func TestWatch_UntilGroup(t *testing.T) {
+	newServer(t, "INSTALLING", statusEvent("TERMINATED"))
+	out, err := execute(t, "--id=1", "--timeout=10s", "--until-group=FAILED")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Job 1 reached state TERMINATED (group FAILED)")
+}
+
+func TestWatch_Timeout(t *testing.T) {
+	newServer(t, "INSTALLING")
+	_, err := execute(t, "--id=1", "--timeout=200ms")
+	assert.EqualError(t, err, "timed out waiting for job 1")
+	assert.Equal(t, ExitTimeout, errutil.ExitCode(err))
+}
+
+func TestWatch_Deleted(t *testing.T) {
+	newServer(t, "INSTALLING", api.JobEvent{Action: api.DELETE, Job: api.Job{ID: "1"}})
+	_, err := execute(t, "--id=1", "--timeout=10s")
+	assert.EqualError(t, err, "job 1 was deleted")
+	assert.Equal(t, 1, errutil.ExitCode(err))
+}
+
+func TestWatch_NotFound(t *testing.T) {
+	newServer(t, "INSTALLING")
+	_, err := execute(t, "--id=2", "--timeout=10s")
+	assert.EqualError(t, err, "failed to fetch job 2: job not found")
+}
+
+func TestWatch_MissingID(t *testing.T) {
+	_, err := execute(t)
+	assert.EqualError(t, err, "--id is required")
+}
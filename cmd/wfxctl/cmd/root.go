@@ -13,6 +13,8 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/siemens/wfx/cmd/wfx/cmd/config"
+	wfxctlConfig "github.com/siemens/wfx/cmd/wfxctl/cmd/config"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/dashboard"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/health"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/job"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/version"
@@ -34,8 +36,15 @@ Tip: Shell completion is available for Bash, Fish and Zsh. See wfxctl completion
 		Version:          fmt.Sprintf("%s (commit %s)", metadata.Version, metadata.Commit),
 		SilenceUsage:     true,
 		TraverseChildren: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			// validate early, i.e. before any request is sent to wfx
+			output, _ := cmd.Flags().GetString(flags.OutputFlag)
+			return flags.ValidateOutput(output)
+		},
 	}
 	cmd.AddCommand(man.NewCommand())
+	cmd.AddCommand(wfxctlConfig.NewCommand())
+	cmd.AddCommand(dashboard.NewCommand())
 	cmd.AddCommand(job.NewCommand())
 	cmd.AddCommand(workflow.NewCommand())
 	cmd.AddCommand(version.NewCommand())
@@ -44,6 +53,7 @@ Tip: Shell completion is available for Bash, Fish and Zsh. See wfxctl completion
 	f := cmd.PersistentFlags()
 	f.StringSlice(flags.ConfigFlag, config.DefaultConfigFiles(), "path to one or more .yaml config files; if this option is not set, then the default paths are tried")
 	_ = cmd.MarkPersistentFlagFilename(flags.ConfigFlag, "yml", "yaml")
+	f.String(flags.ContextFlag, "", "name of the context (wfx instance) to use; defaults to the current context, see 'wfxctl config get-contexts'")
 
 	f.String(flags.ClientHostFlag, "localhost", "host")
 	f.Int(flags.ClientPortFlag, 8080, "port")
@@ -62,6 +72,8 @@ Tip: Shell completion is available for Bash, Fish and Zsh. See wfxctl completion
 
 	f.String(flags.FilterFlag, "", "output filter (jq-expression). example: '.id'")
 	f.Bool(flags.RawFlag, false, "output raw strings, not JSON texts; use --filter to select a single entity")
+	f.StringP(flags.OutputFlag, "o", "", "output format (default: json, or a table for statistics). one of: "+flags.OutputFormats)
+	f.String(flags.ColorFlag, flags.ColorAuto, fmt.Sprintf("colorize output. one of: %s, %s, %s", flags.ColorNever, flags.ColorAlways, flags.ColorAuto))
 
 	f.String(flags.LogLevelFlag, "info", fmt.Sprintf("set log level. one of: %s,%s,%s,%s,%s,%s,%s",
 		zerolog.TraceLevel.String(),
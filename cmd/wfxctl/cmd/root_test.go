@@ -21,3 +21,10 @@ func TestRootCmd_ManPage(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, cmd)
 }
+
+func TestRootCmd_InvalidOutput(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-o", "xml", "version"})
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, `unsupported output format "xml"`)
+}
@@ -0,0 +1,68 @@
+package apply
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/manifest"
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Declaratively create and update workflows",
+		Long: `Bring the workflows of wfx into the state described by the given files or directories.
+
+Workflows which do not exist yet are created, workflows whose definition differs are replaced.
+With --prune, workflows which are not part of the given files are deleted.
+
+Replacing a workflow which is used by jobs in a non-final state is refused unless --force is given,
+since such jobs might end up in a state which no longer exists. Workflows which are still used by jobs
+are never pruned. If any change is refused, nothing is applied.
+
+Use 'wfxctl workflow diff' to preview the changes.`,
+		Example: `
+wfxctl workflow apply -f workflows/
+wfxctl workflow apply -f workflows/ --prune
+wfxctl workflow apply -f kanban.yml --force
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+			if len(baseCmd.Filenames) == 0 {
+				return errors.New("no workflows given, use --" + flags.FilenameFlag)
+			}
+			desired, err := manifest.Load(baseCmd.Filenames)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+
+			client := &api.ClientWithResponses{ClientInterface: errutil.Must(baseCmd.CreateMgmtClient())}
+			plan, err := manifest.NewPlan(cmd.Context(), client, desired, baseCmd.Prune)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			plan.Write(cmd.OutOrStdout(), baseCmd.Force)
+			return fault.Wrap(plan.Apply(cmd.Context(), client, baseCmd.Force, cmd.OutOrStdout()))
+		},
+	}
+	f := cmd.Flags()
+	f.StringSliceP(flags.FilenameFlag, "f", nil, "file or directory containing the workflows (YAML or JSON)")
+	f.Bool(flags.PruneFlag, false, "delete workflows which are not part of the given files")
+	f.Bool(flags.ForceFlag, false, "replace workflows even if they are used by jobs in a non-final state")
+	return cmd
+}
@@ -0,0 +1,72 @@
+package apply
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApply(t *testing.T) {
+	var created *api.Workflow
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/wfx/v1/workflows", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.PaginatedWorkflowList{Content: []api.Workflow{}})
+	})
+	mux.HandleFunc("POST /api/wfx/v1/workflows", func(w http.ResponseWriter, r *http.Request) {
+		created = new(api.Workflow)
+		_ = json.NewDecoder(r.Body).Decode(created)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(created)
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	u, _ := url.Parse(ts.URL)
+	t.Setenv("WFX_MGMT_HOST", u.Hostname())
+	t.Setenv("WFX_MGMT_PORT", u.Port())
+
+	fname := filepath.Join(t.TempDir(), "direct.yml")
+	b, err := yaml.Marshal(dau.DirectWorkflow())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(fname, b, 0o644))
+
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-f", fname})
+	require.NoError(t, cmd.Execute())
+
+	require.NotNil(t, created)
+	assert.Equal(t, dau.DirectWorkflow().Name, created.Name)
+	assert.Equal(t, `+ workflow wfx.workflow.dau.direct
+1 to create, 0 to update, 0 to prune, 0 unchanged
+workflow wfx.workflow.dau.direct: created
+`, out.String())
+}
+
+func TestApply_MissingFilename(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--" + flags.PruneFlag})
+	assert.ErrorContains(t, cmd.Execute(), "no workflows given")
+}
@@ -0,0 +1,186 @@
+package convert
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/convert"
+)
+
+const (
+	formatYAML = "yaml"
+	formatJSON = "json"
+)
+
+const stdin = "-"
+
+const schemaComment = "# yaml-language-server: $schema=https://raw.githubusercontent.com/siemens/wfx/main/spec/workflow.schema.json\n"
+
+// document is a workflow with its fields in the same order as in the example workflows.
+type document struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Groups      []api.Group  `json:"groups,omitempty"`
+	States      []api.State  `json:"states"`
+	Transitions []transition `json:"transitions"`
+}
+
+type transition struct {
+	From        string           `json:"from"`
+	To          string           `json:"to"`
+	Eligible    api.EligibleEnum `json:"eligible"`
+	Action      *api.ActionEnum  `json:"action,omitempty"`
+	Description string           `json:"description,omitempty"`
+}
+
+func NewCommand() *cobra.Command {
+	formats := make([]string, 0, len(convert.Formats))
+	for _, format := range convert.Formats {
+		formats = append(formats, string(format))
+	}
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: "Convert state machines from other formats into workflows",
+		Long: fmt.Sprintf(`Convert a state machine (SCXML, mermaid state diagram or State Machine Cat) into a wfx workflow.
+
+The input format is detected from the file extension unless --%s is given. Compound states become groups of
+their states. A transition leaving a compound state is added for each of its states, a transition entering it leads
+to its initial state. Parallel states, history and choice/fork/join pseudo states have no equivalent in wfx and are
+rejected.
+
+The eligible actor of a transition is derived from its label (event and guard, e.g. "done [checksum_ok]") using a
+convention. By default, labels mentioning WFX (or IMMEDIATE) are eligible for wfx and all other transitions are
+eligible for the client. A custom convention can be given with --%s:
+
+  rules:
+    - match: '^(timeout|auto)'  # regular expression
+      eligible: WFX
+      action: IMMEDIATE         # optional
+    - match: '^schedule'
+      eligible: WFX
+  default: CLIENT
+
+The result is validated and written to stdout.
+`, flags.FromFlag, flags.ConventionFlag),
+		Example: `
+wfxctl workflow convert updater.scxml > wfx.workflow.updater.yml
+wfxctl workflow convert --from=mermaid --name=wfx.workflow.legacy - < diagram.md
+wfxctl workflow convert --convention=convention.yml updater.smcat
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+		Args:             cobra.ExactArgs(1),
+		ValidArgsFunction: func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+			return []string{"scxml", "xml", "mmd", "mermaid", "smcat"}, cobra.ShellCompDirectiveFilterFileExt
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+			if !slices.Contains([]string{formatYAML, formatJSON}, baseCmd.Format) {
+				return fmt.Errorf("invalid format %q", baseCmd.Format)
+			}
+			fname := args[0]
+			format := convert.Format(baseCmd.From)
+			if format == "" {
+				var ok bool
+				if format, ok = convert.DetectFormat(fname); !ok {
+					return fmt.Errorf("cannot detect the format of %s, use --%s", fname, flags.FromFlag)
+				}
+			}
+			if !slices.Contains(convert.Formats, format) {
+				return fmt.Errorf("invalid %s %q, must be one of: %s", flags.FromFlag, format, strings.Join(formats, ", "))
+			}
+
+			opts := convert.Options{Name: baseCmd.Name}
+			if baseCmd.Convention != "" {
+				conv, err := loadConvention(baseCmd.Convention)
+				if err != nil {
+					return err
+				}
+				opts.Convention = conv
+			}
+
+			var r io.Reader = cmd.InOrStdin()
+			if fname != stdin {
+				f, err := os.Open(fname)
+				if err != nil {
+					return fault.Wrap(err)
+				}
+				defer f.Close()
+				r = f
+			}
+			wf, err := convert.Import(r, format, opts)
+			if err != nil {
+				return fmt.Errorf("%s: %w", fname, err)
+			}
+			return write(cmd.OutOrStdout(), wf, baseCmd.Format)
+		},
+	}
+	f := cmd.Flags()
+	f.String(flags.FromFlag, "", "input format, one of: "+strings.Join(formats, ", ")+" (default: detected from the file extension)")
+	f.String(flags.NameFlag, "", "workflow name (default: taken from the input, if available)")
+	f.String(flags.ConventionFlag, "", "file containing the convention which maps transition labels to actors")
+	f.String(flags.FormatFlag, formatYAML, "output format, one of: yaml, json")
+	return cmd
+}
+
+func loadConvention(fname string) (*convert.Convention, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	defer f.Close()
+	conv, err := convert.LoadConvention(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fname, err)
+	}
+	return conv, nil
+}
+
+func write(w io.Writer, wf *api.Workflow, format string) error {
+	doc := document{
+		Name:        wf.Name,
+		Description: wf.Description,
+		Groups:      wf.Groups,
+		States:      wf.States,
+		Transitions: make([]transition, 0, len(wf.Transitions)),
+	}
+	for _, t := range wf.Transitions {
+		doc.Transitions = append(doc.Transitions, transition{
+			From:        t.From,
+			To:          t.To,
+			Eligible:    t.Eligible,
+			Action:      t.Action,
+			Description: t.Description,
+		})
+	}
+	if format == formatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return fault.Wrap(enc.Encode(doc))
+	}
+	b, err := yaml.MarshalWithOptions(doc, yaml.IndentSequence(true))
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	_, _ = io.WriteString(w, schemaComment+"---\n")
+	_, err = w.Write(b)
+	return fault.Wrap(err)
+}
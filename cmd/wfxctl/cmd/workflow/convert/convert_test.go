@@ -0,0 +1,108 @@
+package convert
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scxml = `<scxml xmlns="http://www.w3.org/2005/07/scxml" name="legacy.updater">
+  <state id="IDLE">
+    <transition event="schedule" target="DOWNLOADING"/>
+  </state>
+  <state id="DOWNLOADING">
+    <transition event="done" target="DONE"/>
+  </state>
+  <final id="DONE"/>
+</scxml>
+`
+
+func writeFile(t *testing.T, name, content string) string {
+	fname := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(fname, []byte(content), 0o644))
+	return fname
+}
+
+func TestConvert_YAML(t *testing.T) {
+	fname := writeFile(t, "updater.scxml", scxml)
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{fname})
+	require.NoError(t, cmd.Execute())
+
+	assert.True(t, strings.HasPrefix(out.String(), "# yaml-language-server: $schema="))
+	var wf api.Workflow
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &wf))
+	require.NoError(t, workflow.ValidateWorkflow(&wf))
+	assert.Equal(t, "legacy.updater", wf.Name)
+	assert.Len(t, wf.States, 3)
+	require.Len(t, wf.Transitions, 2)
+	assert.Equal(t, api.CLIENT, wf.Transitions[0].Eligible)
+	assert.Equal(t, "schedule", wf.Transitions[0].Description)
+}
+
+func TestConvert_Convention(t *testing.T) {
+	convention := writeFile(t, "convention.yml", "rules:\n  - match: '^schedule$'\n    eligible: WFX\n")
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetIn(strings.NewReader(scxml))
+	cmd.SetArgs([]string{
+		"--" + flags.FromFlag, "scxml",
+		"--" + flags.NameFlag, "wfx.workflow.updater",
+		"--" + flags.ConventionFlag, convention,
+		"--" + flags.FormatFlag, "json",
+		"-",
+	})
+	require.NoError(t, cmd.Execute())
+
+	var wf api.Workflow
+	require.NoError(t, json.Unmarshal(out.Bytes(), &wf))
+	assert.Equal(t, "wfx.workflow.updater", wf.Name)
+	assert.Equal(t, api.WFX, wf.Transitions[0].Eligible)
+	assert.Equal(t, api.CLIENT, wf.Transitions[1].Eligible)
+}
+
+func TestConvert_Errors(t *testing.T) {
+	unknown := writeFile(t, "diagram.txt", "stateDiagram-v2\nA --> B\n")
+	invalid := writeFile(t, "diagram.mmd", "stateDiagram-v2\nstate c <<choice>>\n")
+	badConvention := writeFile(t, "convention.yml", "default: DEVICE\n")
+	for name, tc := range map[string]struct {
+		args []string
+		err  string
+	}{
+		"detect":     {args: []string{unknown}, err: "cannot detect the format"},
+		"from":       {args: []string{"--" + flags.FromFlag, "plantuml", unknown}, err: `invalid from "plantuml"`},
+		"format":     {args: []string{"--" + flags.FormatFlag, "xml", unknown}, err: `invalid format "xml"`},
+		"parse":      {args: []string{invalid}, err: "diagram.mmd: choice states are not supported"},
+		"convention": {args: []string{"--" + flags.ConventionFlag, badConvention, "--" + flags.FromFlag, "mermaid", unknown}, err: `invalid default actor "DEVICE"`},
+		"missing":    {args: []string{"does-not-exist.scxml"}, err: "no such file"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cmd := NewCommand()
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(new(bytes.Buffer))
+			cmd.SetArgs(tc.args)
+			assert.ErrorContains(t, cmd.Execute(), tc.err)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package diff
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/manifest"
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+)
+
+// ExitChanges is the exit code if the workflows of wfx differ from the given files.
+const ExitChanges = 2
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes 'workflow apply' would make",
+		Long: `Compare the workflows in the given files or directories with the workflows of wfx.
+
+Lines starting with '+' denote additions, '-' removals and '~' modifications.
+
+The exit code is 0 if there are no differences and 2 if there are differences, which makes
+the command suitable for drift detection in CI pipelines.`,
+		Example: `
+wfxctl workflow diff -f workflows/
+wfxctl workflow diff -f workflows/ --prune
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+			if len(baseCmd.Filenames) == 0 {
+				return errors.New("no workflows given, use --" + flags.FilenameFlag)
+			}
+			desired, err := manifest.Load(baseCmd.Filenames)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+
+			client := &api.ClientWithResponses{ClientInterface: errutil.Must(baseCmd.CreateMgmtClient())}
+			plan, err := manifest.NewPlan(cmd.Context(), client, desired, baseCmd.Prune)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			plan.Write(cmd.OutOrStdout(), baseCmd.Force)
+			if len(plan.Changes) > 0 {
+				return &errutil.ExitError{Code: ExitChanges, Err: errors.New("workflows differ")}
+			}
+			return nil
+		},
+	}
+	f := cmd.Flags()
+	f.StringSliceP(flags.FilenameFlag, "f", nil, "file or directory containing the workflows (YAML or JSON)")
+	f.Bool(flags.PruneFlag, false, "include workflows which are not part of the given files")
+	return cmd
+}
@@ -0,0 +1,79 @@
+package diff
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/wfx/v1/workflows", r.URL.Path)
+		w.Header().Add("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.PaginatedWorkflowList{Content: []api.Workflow{*dau.DirectWorkflow()}})
+	}))
+	t.Cleanup(ts.Close)
+	u, _ := url.Parse(ts.URL)
+	t.Setenv("WFX_MGMT_HOST", u.Hostname())
+	t.Setenv("WFX_MGMT_PORT", u.Port())
+
+	dir := t.TempDir()
+	b, err := yaml.Marshal(dau.DirectWorkflow())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "direct.yml"), b, 0o644))
+
+	t.Run("unchanged", func(t *testing.T) {
+		cmd := NewCommand()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"--" + flags.FilenameFlag, dir})
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "0 to create, 0 to update, 0 to prune, 1 unchanged\n", out.String())
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		b, err := yaml.Marshal(dau.PhasedWorkflow())
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "phased.yml"), b, 0o644))
+
+		cmd := NewCommand()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetArgs([]string{"--" + flags.FilenameFlag, dir})
+		err = cmd.Execute()
+
+		var exitErr *errutil.ExitError
+		require.True(t, errors.As(err, &exitErr))
+		assert.Equal(t, ExitChanges, exitErr.Code)
+		assert.Equal(t, "+ workflow wfx.workflow.dau.phased\n1 to create, 0 to update, 0 to prune, 1 unchanged\n", out.String())
+	})
+}
+
+func TestDiff_MissingFilename(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{})
+	assert.ErrorContains(t, cmd.Execute(), "no workflows given")
+}
@@ -0,0 +1,200 @@
+package lint
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow"
+)
+
+const (
+	formatText  = "text"
+	formatJSON  = "json"
+	formatSARIF = "sarif"
+
+	failOnNone = "none"
+)
+
+const stdin = "-"
+
+// result is a finding together with its location.
+type result struct {
+	File string `json:"file"`
+	Line int    `json:"line,omitempty"`
+	workflow.Finding
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check workflows for likely mistakes",
+		Long: fmt.Sprintf(`Check workflows for problems which are accepted by wfx, but are likely mistakes.
+
+Rules:
+%s
+Findings can be suppressed using --%s=RULE (all findings of a rule) or --%s=RULE:SUBJECT,
+where SUBJECT is a state or group name or a transition "FROM -> TO".
+
+The command fails if there is at least one (unsuppressed) finding with the severity given by --%s or higher.
+`, describeRules(), flags.SuppressFlag, flags.SuppressFlag, flags.FailOnFlag),
+		Example: `
+wfxctl workflow lint wfx.workflow.dau.direct.yml
+wfxctl workflow lint --suppress=client-dead-end --suppress="missing-description:INSTALL -> TERMINATED" workflows/*.yml
+wfxctl workflow lint --format=sarif workflows/*.yml > wfx-lint.sarif
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+		Args:             cobra.OnlyValidArgs,
+		ValidArgsFunction: func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+			return []string{"yaml", "yml", "json"}, cobra.ShellCompDirectiveFilterFileExt
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+			if len(args) == 0 {
+				return errors.New("workflow must be provided either via file or stdin")
+			}
+			if !slices.Contains([]string{formatText, formatJSON, formatSARIF}, baseCmd.Format) {
+				return fmt.Errorf("invalid format %q", baseCmd.Format)
+			}
+			failOn := workflow.Severity(baseCmd.FailOn)
+			if baseCmd.FailOn != failOnNone && failOn.Rank() == 0 {
+				return fmt.Errorf("invalid %s value %q", flags.FailOnFlag, baseCmd.FailOn)
+			}
+			var opts workflow.LintOptions
+			for _, s := range baseCmd.Suppressions {
+				suppression, err := workflow.ParseSuppression(s)
+				if err != nil {
+					return fault.Wrap(err)
+				}
+				opts.Suppressions = append(opts.Suppressions, suppression)
+			}
+
+			var results []result
+			for _, fname := range args {
+				r, err := lintFile(fname, cmd.InOrStdin(), opts)
+				if err != nil {
+					return fault.Wrap(err)
+				}
+				results = append(results, r...)
+			}
+
+			out := cmd.OutOrStdout()
+			switch baseCmd.Format {
+			case formatJSON:
+				enc := json.NewEncoder(out)
+				enc.SetIndent("", "  ")
+				if results == nil {
+					results = []result{}
+				}
+				if err := enc.Encode(results); err != nil {
+					return fault.Wrap(err)
+				}
+			case formatSARIF:
+				if err := writeSARIF(out, results); err != nil {
+					return fault.Wrap(err)
+				}
+			default:
+				writeText(out, results)
+			}
+
+			if baseCmd.FailOn == failOnNone {
+				return nil
+			}
+			failed := 0
+			for _, r := range results {
+				if r.Severity.Rank() >= failOn.Rank() {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d finding(s) with severity %s or higher", failed, failOn)
+			}
+			return nil
+		},
+	}
+	f := cmd.Flags()
+	f.String(flags.FormatFlag, formatText, "output format, one of: text, json, sarif")
+	f.String(flags.FailOnFlag, string(workflow.SeverityWarning), "minimum severity which causes the command to fail, one of: error, warning, note, none")
+	f.StringSlice(flags.SuppressFlag, nil, "suppress findings of a rule, optionally only for a subject (RULE[:SUBJECT])")
+	return cmd
+}
+
+func lintFile(fname string, r io.Reader, opts workflow.LintOptions) ([]result, error) {
+	var raw []byte
+	var err error
+	if fname == stdin {
+		raw, err = io.ReadAll(r)
+	} else {
+		raw, err = os.ReadFile(fname)
+	}
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	var wf api.Workflow
+	if err := yaml.Unmarshal(raw, &wf); err != nil {
+		return nil, fmt.Errorf("%s: %w", fname, err)
+	}
+
+	findings := workflow.Lint(&wf, opts)
+	results := make([]result, 0, len(findings))
+	for _, f := range findings {
+		results = append(results, result{File: fname, Line: findLine(raw, f.Path), Finding: f})
+	}
+	return results, nil
+}
+
+// findLine returns the line of the element identified by path or 0 if it cannot be determined.
+func findLine(raw []byte, path string) int {
+	file, err := parser.ParseBytes(raw, 0)
+	if err != nil {
+		return 0
+	}
+	p, err := yaml.PathString(path)
+	if err != nil {
+		return 0
+	}
+	node, err := p.FilterFile(file)
+	if err != nil || node == nil || node.GetToken() == nil {
+		return 0
+	}
+	return node.GetToken().Position.Line
+}
+
+func writeText(w io.Writer, results []result) {
+	for _, r := range results {
+		location := r.File
+		if r.Line > 0 {
+			location = fmt.Sprintf("%s:%d", r.File, r.Line)
+		}
+		fmt.Fprintf(w, "%s: %s: %s [%s]\n", location, r.Severity, r.Message, r.RuleID)
+	}
+}
+
+func describeRules() string {
+	var b strings.Builder
+	for _, rule := range workflow.Rules {
+		fmt.Fprintf(&b, "  %s (%s): %s\n", rule.ID, rule.Severity, rule.Description)
+	}
+	return b.String()
+}
@@ -0,0 +1,125 @@
+package lint
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const directWorkflow = "../../../../../workflow/dau/wfx.workflow.dau.direct.yml"
+
+func TestLint_Text(t *testing.T) {
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{directWorkflow})
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "4 finding(s) with severity warning or higher")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, directWorkflow+":30: warning: state INSTALL can only be left by the client (no WFX transition) [client-dead-end]", lines[0])
+}
+
+func TestLint_Suppress(t *testing.T) {
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{
+		"--" + flags.SuppressFlag, "client-dead-end:INSTALL",
+		"--" + flags.SuppressFlag, "client-dead-end:INSTALLING",
+		"--" + flags.FailOnFlag, "error",
+		directWorkflow,
+	})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, 2, strings.Count(out.String(), "\n"))
+}
+
+func TestLint_InvalidSuppression(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--" + flags.SuppressFlag, "foo", directWorkflow})
+	assert.ErrorContains(t, cmd.Execute(), `unknown rule "foo"`)
+}
+
+func TestLint_InvalidFlags(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--" + flags.FormatFlag, "xml", directWorkflow})
+	assert.ErrorContains(t, cmd.Execute(), "invalid format")
+
+	cmd = NewCommand()
+	cmd.SetArgs([]string{"--" + flags.FailOnFlag, "fatal", directWorkflow})
+	assert.ErrorContains(t, cmd.Execute(), "invalid fail-on value")
+}
+
+func TestLint_Stdin(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	wf.Description = ""
+	b, err := yaml.Marshal(wf)
+	require.NoError(t, err)
+
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetIn(bytes.NewReader(b))
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--" + flags.FormatFlag, "json", "--" + flags.FailOnFlag, "none", "-"})
+	require.NoError(t, cmd.Execute())
+
+	var results []map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &results))
+	require.Len(t, results, 5)
+	assert.Equal(t, "-", results[0]["file"])
+	assert.Equal(t, "missing-description", results[0]["ruleId"])
+	assert.Equal(t, "note", results[0]["severity"])
+}
+
+func TestLint_SARIF(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "wf.json")
+	b, err := json.Marshal(dau.DirectWorkflow())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(fname, b, 0o644))
+
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--" + flags.FormatFlag, "sarif", "--" + flags.FailOnFlag, "none", fname})
+	require.NoError(t, cmd.Execute())
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(out.Bytes(), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	assert.Equal(t, "wfxctl", run.Tool.Driver.Name)
+	require.Len(t, run.Results, 4)
+	result := run.Results[0]
+	assert.Equal(t, "client-dead-end", result.RuleID)
+	assert.Equal(t, "client-dead-end", run.Tool.Driver.Rules[result.RuleIndex].ID)
+	require.Len(t, result.Locations, 1)
+	assert.Equal(t, filepath.ToSlash(fname), result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	// single-line JSON document
+	assert.Equal(t, 1, result.Locations[0].PhysicalLocation.Region.StartLine)
+}
+
+func TestLint_MissingArgs(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{})
+	assert.ErrorContains(t, cmd.Execute(), "workflow must be provided")
+}
@@ -0,0 +1,115 @@
+package manifest
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+// Diff describes the differences between the current and the desired definition of a workflow, one line per
+// difference. Lines start with '+' (added), '-' (removed) or '~' (changed). The order of states, transitions and
+// groups is irrelevant.
+func Diff(current, desired *api.Workflow) []string {
+	var result []string
+	if current.Description != desired.Description {
+		result = append(result, fmt.Sprintf("~ description: %q -> %q", current.Description, desired.Description))
+	}
+	result = append(result, diffStates(current.States, desired.States)...)
+	result = append(result, diffTransitions(current.Transitions, desired.Transitions)...)
+	result = append(result, diffGroups(current.Groups, desired.Groups)...)
+	return result
+}
+
+func diffStates(current, desired []api.State) []string {
+	return diffByKey(current, desired,
+		func(s api.State) string { return "state " + s.Name },
+		func(a, b api.State) []string {
+			if a.Description != b.Description {
+				return []string{fmt.Sprintf("description %q -> %q", a.Description, b.Description)}
+			}
+			return nil
+		})
+}
+
+func diffTransitions(current, desired []api.Transition) []string {
+	return diffByKey(current, desired,
+		func(t api.Transition) string { return fmt.Sprintf("transition %s -> %s", t.From, t.To) },
+		func(a, b api.Transition) []string {
+			var changes []string
+			if a.Eligible != b.Eligible {
+				changes = append(changes, fmt.Sprintf("eligible %s -> %s", a.Eligible, b.Eligible))
+			}
+			if action(a) != action(b) {
+				changes = append(changes, fmt.Sprintf("action %s -> %s", action(a), action(b)))
+			}
+			if a.Description != b.Description {
+				changes = append(changes, fmt.Sprintf("description %q -> %q", a.Description, b.Description))
+			}
+			return changes
+		})
+}
+
+func diffGroups(current, desired []api.Group) []string {
+	return diffByKey(current, desired,
+		func(g api.Group) string { return "group " + g.Name },
+		func(a, b api.Group) []string {
+			var changes []string
+			statesA, statesB := slices.Sorted(slices.Values(a.States)), slices.Sorted(slices.Values(b.States))
+			if !slices.Equal(statesA, statesB) {
+				changes = append(changes, fmt.Sprintf("states [%s] -> [%s]", strings.Join(statesA, " "), strings.Join(statesB, " ")))
+			}
+			if a.Description != b.Description {
+				changes = append(changes, fmt.Sprintf("description %q -> %q", a.Description, b.Description))
+			}
+			return changes
+		})
+}
+
+// diffByKey matches the elements of current and desired by their key and reports removed, added and changed
+// elements. compare returns the changes between two elements having the same key.
+func diffByKey[T any](current, desired []T, key func(T) string, compare func(a, b T) []string) []string {
+	currentByKey := make(map[string]T, len(current))
+	for _, elem := range current {
+		currentByKey[key(elem)] = elem
+	}
+	desiredByKey := make(map[string]T, len(desired))
+	for _, elem := range desired {
+		desiredByKey[key(elem)] = elem
+	}
+
+	var result []string
+	for _, elem := range current {
+		if _, ok := desiredByKey[key(elem)]; !ok {
+			result = append(result, "- "+key(elem))
+		}
+	}
+	for _, elem := range desired {
+		other, ok := currentByKey[key(elem)]
+		if !ok {
+			result = append(result, "+ "+key(elem))
+			continue
+		}
+		if changes := compare(other, elem); len(changes) > 0 {
+			result = append(result, fmt.Sprintf("~ %s: %s", key(elem), strings.Join(changes, ", ")))
+		}
+	}
+	return result
+}
+
+// action returns the action of the transition; transitions without an action wait for an explicit update.
+func action(t api.Transition) api.ActionEnum {
+	if t.Action == nil {
+		return api.WAIT
+	}
+	return *t.Action
+}
@@ -0,0 +1,64 @@
+package manifest
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff_Equal(t *testing.T) {
+	current := dau.DirectWorkflow()
+	desired := dau.DirectWorkflow()
+	// order does not matter
+	slices.Reverse(desired.States)
+	slices.Reverse(desired.Transitions)
+	slices.Reverse(desired.Groups[0].States)
+	assert.Empty(t, Diff(current, desired))
+}
+
+func TestDiff(t *testing.T) {
+	current := &api.Workflow{
+		Name:        "test",
+		Description: "old",
+		States:      []api.State{{Name: "A"}, {Name: "B"}, {Name: "C"}},
+		Transitions: []api.Transition{
+			{From: "A", To: "B", Eligible: api.CLIENT},
+			{From: "B", To: "C", Eligible: api.CLIENT},
+		},
+		Groups: []api.Group{{Name: "OPEN", States: []string{"A"}}},
+	}
+	immediate := api.IMMEDIATE
+	desired := &api.Workflow{
+		Name:        "test",
+		Description: "new",
+		States:      []api.State{{Name: "A"}, {Name: "B", Description: "second"}, {Name: "D"}},
+		Transitions: []api.Transition{
+			{From: "A", To: "B", Eligible: api.WFX, Action: &immediate},
+			{From: "B", To: "D", Eligible: api.CLIENT},
+		},
+		Groups: []api.Group{{Name: "OPEN", States: []string{"A", "B"}}, {Name: "CLOSED", States: []string{"D"}}},
+	}
+
+	assert.Equal(t, []string{
+		`~ description: "old" -> "new"`,
+		"- state C",
+		`~ state B: description "" -> "second"`,
+		"+ state D",
+		"- transition B -> C",
+		"~ transition A -> B: eligible CLIENT -> WFX, action WAIT -> IMMEDIATE",
+		"+ transition B -> D",
+		"~ group OPEN: states [A] -> [A B]",
+		"+ group CLOSED",
+	}, Diff(current, desired))
+}
@@ -0,0 +1,93 @@
+package manifest
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow"
+)
+
+// Extensions lists the file extensions which are considered when loading workflows from a directory.
+var Extensions = []string{".yml", ".yaml", ".json"}
+
+// Load reads and validates the workflows in the given paths. A path is either a file or a directory, which is
+// searched recursively for files with one of the Extensions. Each file contains exactly one workflow.
+func Load(paths []string) ([]api.Workflow, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && slices.Contains(Extensions, strings.ToLower(filepath.Ext(path))) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+	}
+
+	result := make([]api.Workflow, 0, len(files))
+	origin := make(map[string]string, len(files))
+	for _, fname := range files {
+		b, err := os.ReadFile(fname)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		wf, err := unmarshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fname, err)
+		}
+		if err := workflow.ValidateWorkflow(wf); err != nil {
+			return nil, fmt.Errorf("%s: %w", fname, err)
+		}
+		if other, ok := origin[wf.Name]; ok {
+			return nil, fmt.Errorf("workflow %s is defined in both %s and %s", wf.Name, other, fname)
+		}
+		origin[wf.Name] = fname
+		result = append(result, *wf)
+	}
+	return result, nil
+}
+
+func unmarshal(raw []byte) (*api.Workflow, error) {
+	var wf api.Workflow
+	// try JSON first
+	if json.Valid(raw) {
+		if err := json.Unmarshal(raw, &wf); err == nil {
+			return &wf, nil
+		}
+	}
+	// fall back to YAML
+	if err := yaml.Unmarshal(raw, &wf); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return &wf, nil
+}
@@ -0,0 +1,73 @@
+package manifest
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "nested"), 0o755))
+
+	b, err := yaml.Marshal(dau.DirectWorkflow())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "direct.yml"), b, 0o644))
+	b, err = json.Marshal(dau.PhasedWorkflow())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "phased.json"), b, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Workflows"), 0o644))
+
+	workflows, err := Load([]string{dir})
+	require.NoError(t, err)
+	require.Len(t, workflows, 2)
+	assert.Equal(t, dau.DirectWorkflow().Name, workflows[0].Name)
+	assert.Equal(t, dau.PhasedWorkflow().Name, workflows[1].Name)
+
+	workflows, err = Load([]string{filepath.Join(dir, "direct.yml")})
+	require.NoError(t, err)
+	assert.Len(t, workflows, 1)
+}
+
+func TestLoad_Duplicate(t *testing.T) {
+	dir := t.TempDir()
+	b, err := yaml.Marshal(dau.DirectWorkflow())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yml"), b, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yaml"), b, 0o644))
+
+	_, err = Load([]string{dir})
+	assert.ErrorContains(t, err, "is defined in both")
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	wf := dau.DirectWorkflow()
+	wf.States = nil
+	b, err := yaml.Marshal(wf)
+	require.NoError(t, err)
+	fname := filepath.Join(dir, "invalid.yml")
+	require.NoError(t, os.WriteFile(fname, b, 0o644))
+
+	_, err = Load([]string{dir})
+	assert.ErrorContains(t, err, fname)
+}
+
+func TestLoad_NotFound(t *testing.T) {
+	_, err := Load([]string{filepath.Join(t.TempDir(), "missing")})
+	assert.Error(t, err)
+}
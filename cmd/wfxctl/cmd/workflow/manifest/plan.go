@@ -0,0 +1,274 @@
+package manifest
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Southclaws/fault"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionPrune  Action = "prune"
+)
+
+// pageSize is the number of workflows fetched per request.
+const pageSize int32 = 100
+
+// Change is a modification required to bring a workflow into the desired state.
+type Change struct {
+	Action Action
+	Name   string
+	// Workflow is the desired definition; nil if the workflow is pruned.
+	Workflow *api.Workflow
+	// Diff lists the differences for updates, see Diff.
+	Diff []string
+	// Jobs counts the jobs affected by the change: jobs in a non-final state for updates and all jobs for prunes.
+	Jobs int64
+}
+
+// Blocked reports whether the change cannot be applied. Updates of workflows with jobs in a non-final state
+// require force, whereas workflows which are still used by jobs cannot be pruned at all.
+func (c Change) Blocked(force bool) bool {
+	switch c.Action {
+	case ActionUpdate:
+		return c.Jobs > 0 && !force
+	case ActionPrune:
+		return c.Jobs > 0
+	default:
+		return false
+	}
+}
+
+// Plan lists the changes required to bring the workflows of a wfx instance into the desired state.
+type Plan struct {
+	Changes   []Change
+	Unchanged []string
+}
+
+// NewPlan compares the desired workflows with the workflows of the wfx instance. If prune is set, workflows which
+// are not part of desired are scheduled for deletion.
+func NewPlan(ctx context.Context, client *api.ClientWithResponses, desired []api.Workflow, prune bool) (*Plan, error) {
+	current, err := fetchWorkflows(ctx, client)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	plan := new(Plan)
+	for i := range desired {
+		wf := &desired[i]
+		existing, ok := current[wf.Name]
+		if !ok {
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Name: wf.Name, Workflow: wf})
+			continue
+		}
+		diff := Diff(existing, wf)
+		if len(diff) == 0 {
+			plan.Unchanged = append(plan.Unchanged, wf.Name)
+			continue
+		}
+		jobs, err := countJobs(ctx, client, existing.Name, workflow.FindFinalStates(existing))
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		plan.Changes = append(plan.Changes, Change{Action: ActionUpdate, Name: wf.Name, Workflow: wf, Diff: diff, Jobs: jobs})
+	}
+
+	if prune {
+		names := make([]string, 0, len(current))
+		for name := range current {
+			if !slices.ContainsFunc(desired, func(wf api.Workflow) bool { return wf.Name == name }) {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			jobs, err := countJobs(ctx, client, name, nil)
+			if err != nil {
+				return nil, fault.Wrap(err)
+			}
+			plan.Changes = append(plan.Changes, Change{Action: ActionPrune, Name: name, Jobs: jobs})
+		}
+	}
+	return plan, nil
+}
+
+// Write prints a human-readable representation of the plan.
+func (p *Plan) Write(w io.Writer, force bool) {
+	counts := make(map[Action]int, 3)
+	for _, change := range p.Changes {
+		counts[change.Action]++
+		switch change.Action {
+		case ActionCreate:
+			fmt.Fprintf(w, "+ workflow %s\n", change.Name)
+		case ActionUpdate:
+			fmt.Fprintf(w, "~ workflow %s\n", change.Name)
+			for _, line := range change.Diff {
+				fmt.Fprintf(w, "    %s\n", line)
+			}
+		case ActionPrune:
+			fmt.Fprintf(w, "- workflow %s\n", change.Name)
+		}
+		if change.Jobs > 0 {
+			switch {
+			case change.Action == ActionPrune:
+				fmt.Fprintf(w, "    ! used by %d job(s), cannot be pruned\n", change.Jobs)
+			case change.Blocked(force):
+				fmt.Fprintf(w, "    ! used by %d job(s) in a non-final state, requires --force\n", change.Jobs)
+			default:
+				fmt.Fprintf(w, "    ! used by %d job(s) in a non-final state\n", change.Jobs)
+			}
+		}
+	}
+	fmt.Fprintf(w, "%d to create, %d to update, %d to prune, %d unchanged\n",
+		counts[ActionCreate], counts[ActionUpdate], counts[ActionPrune], len(p.Unchanged))
+}
+
+// Apply performs the changes of the plan. Nothing is changed if any of the changes is blocked.
+func (p *Plan) Apply(ctx context.Context, client *api.ClientWithResponses, force bool, w io.Writer) error {
+	var blocked []string
+	for _, change := range p.Changes {
+		if change.Blocked(force) {
+			blocked = append(blocked, change.Name)
+		}
+	}
+	if len(blocked) > 0 {
+		return fmt.Errorf("refusing to apply changes, the following workflows are used by jobs: %s", strings.Join(blocked, ", "))
+	}
+
+	for _, change := range p.Changes {
+		var err error
+		switch change.Action {
+		case ActionCreate:
+			err = create(ctx, client, change.Workflow)
+		case ActionUpdate:
+			err = update(ctx, client, change.Workflow, force)
+		case ActionPrune:
+			err = prune(ctx, client, change.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s workflow %s: %w", change.Action, change.Name, err)
+		}
+		fmt.Fprintf(w, "workflow %s: %s\n", change.Name, pastTense(change.Action))
+	}
+	return nil
+}
+
+func pastTense(action Action) string {
+	switch action {
+	case ActionCreate:
+		return "created"
+	case ActionUpdate:
+		return "updated"
+	default:
+		return "pruned"
+	}
+}
+
+func fetchWorkflows(ctx context.Context, client *api.ClientWithResponses) (map[string]*api.Workflow, error) {
+	result := make(map[string]*api.Workflow)
+	limit := pageSize
+	var offset int64
+	for {
+		resp, err := client.GetWorkflowsWithResponse(ctx, &api.GetWorkflowsParams{ParamLimit: &limit, ParamOffset: &offset})
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		if resp.JSON200 == nil {
+			return nil, responseError(resp.HTTPResponse, resp.Body)
+		}
+		for i := range resp.JSON200.Content {
+			wf := &resp.JSON200.Content[i]
+			result[wf.Name] = wf
+		}
+		if len(resp.JSON200.Content) < int(limit) {
+			return result, nil
+		}
+		offset += int64(len(resp.JSON200.Content))
+	}
+}
+
+// countJobs counts the jobs of the given workflow which are not in one of the excluded states.
+func countJobs(ctx context.Context, client *api.ClientWithResponses, name string, excluded []string) (int64, error) {
+	groupBy := []api.JobStatsProperty{api.JobStatsPropertyState}
+	resp, err := client.GetJobsStatsWithResponse(ctx, &api.GetJobsStatsParams{ParamWorkflow: &name, ParamGroupBy: &groupBy})
+	if err != nil {
+		return 0, fault.Wrap(err)
+	}
+	if resp.JSON200 == nil {
+		return 0, responseError(resp.HTTPResponse, resp.Body)
+	}
+	var count int64
+	for _, entry := range resp.JSON200.Content {
+		if entry.State != nil && slices.Contains(excluded, *entry.State) {
+			continue
+		}
+		count += entry.Count
+	}
+	return count, nil
+}
+
+func create(ctx context.Context, client *api.ClientWithResponses, wf *api.Workflow) error {
+	resp, err := client.PostWorkflowsWithResponse(ctx, nil, *wf)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	if resp.JSON201 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func update(ctx context.Context, client *api.ClientWithResponses, wf *api.Workflow, force bool) error {
+	resp, err := client.PutWorkflowsNameWithResponse(ctx, wf.Name, &api.PutWorkflowsNameParams{ParamForce: &force}, *wf)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	if resp.JSON200 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func prune(ctx context.Context, client *api.ClientWithResponses, name string) error {
+	resp, err := client.DeleteWorkflowsNameWithResponse(ctx, name)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func responseError(resp *http.Response, body []byte) error {
+	errResp := new(api.ErrorResponse)
+	if err := json.Unmarshal(body, errResp); err == nil && errResp.Errors != nil && len(*errResp.Errors) > 0 {
+		msgs := make([]string, 0, len(*errResp.Errors))
+		for _, e := range *errResp.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return errors.New(strings.Join(msgs, "; "))
+	}
+	return fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+}
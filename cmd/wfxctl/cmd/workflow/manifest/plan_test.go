@@ -0,0 +1,163 @@
+package manifest
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer implements the parts of the wfx API used by the plan.
+type fakeServer struct {
+	sync.Mutex
+	workflows []api.Workflow
+	// jobs maps workflow names to the states of their jobs
+	jobs     map[string][]string
+	requests []string
+}
+
+func newFakeServer(t *testing.T, workflows []api.Workflow, jobs map[string][]string) (*fakeServer, *api.ClientWithResponses) {
+	srv := &fakeServer{workflows: workflows, jobs: jobs}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/wfx/v1/workflows", func(w http.ResponseWriter, _ *http.Request) {
+		srv.Lock()
+		defer srv.Unlock()
+		writeJSON(w, http.StatusOK, api.PaginatedWorkflowList{Content: srv.workflows})
+	})
+	mux.HandleFunc("POST /api/wfx/v1/workflows", func(w http.ResponseWriter, r *http.Request) {
+		var wf api.Workflow
+		_ = json.NewDecoder(r.Body).Decode(&wf)
+		srv.Lock()
+		defer srv.Unlock()
+		srv.requests = append(srv.requests, "POST "+wf.Name)
+		writeJSON(w, http.StatusCreated, wf)
+	})
+	mux.HandleFunc("PUT /api/wfx/v1/workflows/{name}", func(w http.ResponseWriter, r *http.Request) {
+		var wf api.Workflow
+		_ = json.NewDecoder(r.Body).Decode(&wf)
+		srv.Lock()
+		defer srv.Unlock()
+		srv.requests = append(srv.requests, "PUT "+r.PathValue("name")+"?"+r.URL.RawQuery)
+		writeJSON(w, http.StatusOK, wf)
+	})
+	mux.HandleFunc("DELETE /api/wfx/v1/workflows/{name}", func(w http.ResponseWriter, r *http.Request) {
+		srv.Lock()
+		defer srv.Unlock()
+		srv.requests = append(srv.requests, "DELETE "+r.PathValue("name"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /api/wfx/v1/jobs/stats", func(w http.ResponseWriter, r *http.Request) {
+		srv.Lock()
+		defer srv.Unlock()
+		var stats api.JobStats
+		for _, state := range srv.jobs[r.URL.Query().Get("workflow")] {
+			stats.Content = append(stats.Content, api.JobStatsEntry{State: &state, Count: 1})
+		}
+		writeJSON(w, http.StatusOK, stats)
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	client, err := api.NewClientWithResponses(ts.URL + "/api/wfx/v1")
+	require.NoError(t, err)
+	return srv, client
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestPlan(t *testing.T) {
+	direct, phased := dau.DirectWorkflow(), dau.PhasedWorkflow()
+	unmanaged := api.Workflow{Name: "unmanaged", States: []api.State{{Name: "A"}}}
+	srv, client := newFakeServer(t, []api.Workflow{*direct, unmanaged}, nil)
+
+	changed := dau.DirectWorkflow()
+	changed.Description = "changed"
+
+	plan, err := NewPlan(t.Context(), client, []api.Workflow{*phased, *direct}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{{Action: ActionCreate, Name: phased.Name, Workflow: phased}}, plan.Changes)
+	assert.Equal(t, []string{direct.Name}, plan.Unchanged)
+
+	plan, err = NewPlan(t.Context(), client, []api.Workflow{*changed}, true)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 2)
+	assert.Equal(t, ActionUpdate, plan.Changes[0].Action)
+	assert.Equal(t, ActionPrune, plan.Changes[1].Action)
+	assert.Equal(t, "unmanaged", plan.Changes[1].Name)
+
+	var buf bytes.Buffer
+	plan.Write(&buf, false)
+	assert.Equal(t, `~ workflow wfx.workflow.dau.direct
+    ~ description: "a workflow for device artifact updates without confirmation" -> "changed"
+- workflow unmanaged
+0 to create, 1 to update, 1 to prune, 0 unchanged
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, plan.Apply(t.Context(), client, false, &buf))
+	assert.Equal(t, []string{"PUT wfx.workflow.dau.direct?force=false", "DELETE unmanaged"}, srv.requests)
+	assert.Equal(t, "workflow wfx.workflow.dau.direct: updated\nworkflow unmanaged: pruned\n", buf.String())
+}
+
+func TestPlan_ActiveJobs(t *testing.T) {
+	direct := dau.DirectWorkflow()
+	srv, client := newFakeServer(t, []api.Workflow{*direct}, map[string][]string{
+		direct.Name: {"INSTALLING", "ACTIVATED", "TERMINATED"},
+	})
+
+	changed := dau.DirectWorkflow()
+	changed.Description = "changed"
+	plan, err := NewPlan(t.Context(), client, []api.Workflow{*changed}, false)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, int64(1), plan.Changes[0].Jobs)
+	assert.True(t, plan.Changes[0].Blocked(false))
+	assert.False(t, plan.Changes[0].Blocked(true))
+
+	var buf bytes.Buffer
+	plan.Write(&buf, false)
+	assert.Contains(t, buf.String(), "used by 1 job(s) in a non-final state, requires --force")
+
+	err = plan.Apply(t.Context(), client, false, &buf)
+	assert.ErrorContains(t, err, "refusing to apply changes")
+	assert.Empty(t, srv.requests)
+
+	require.NoError(t, plan.Apply(t.Context(), client, true, &buf))
+	assert.Equal(t, []string{"PUT wfx.workflow.dau.direct?force=true"}, srv.requests)
+}
+
+func TestPlan_PruneUsedWorkflow(t *testing.T) {
+	direct := dau.DirectWorkflow()
+	srv, client := newFakeServer(t, []api.Workflow{*direct}, map[string][]string{
+		direct.Name: {"ACTIVATED"},
+	})
+
+	plan, err := NewPlan(t.Context(), client, nil, true)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.True(t, plan.Changes[0].Blocked(true))
+
+	err = plan.Apply(t.Context(), client, true, new(bytes.Buffer))
+	assert.ErrorContains(t, err, direct.Name)
+	assert.False(t, slices.Contains(srv.requests, "DELETE "+direct.Name))
+}
@@ -0,0 +1,149 @@
+package paths
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/manifest"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/simulation"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+const defaultMaxPaths = 1000
+
+// entry is a path in JSON format.
+type entry struct {
+	Index    int                `json:"index"`
+	States   []string           `json:"states"`
+	Actors   []api.EligibleEnum `json:"actors"`
+	Hops     []simulation.Hop   `json:"hops"`
+	Scenario string             `json:"scenario,omitempty"`
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paths",
+		Short: "List all paths through a workflow and generate test scenarios",
+		Long: `List all paths from the initial state of a workflow to the states which cannot be left.
+
+Immediate transitions are always followed, since jobs never rest in a state which has one. Self-loops (e.g. progress
+updates) are included once for each state in which the job rests.
+
+With --` + flags.OutputDirFlag + `, a test scenario is written for each path. A scenario contains the status updates
+(actor and state) which move a job along the path, together with the state the job is expected to be in after each
+update. Scenarios can be replayed with 'wfxctl workflow simulate' or by a test harness against a wfx instance.
+`,
+		Example: `
+wfxctl workflow paths -f wfx.workflow.dau.direct.yml
+wfxctl workflow paths -f wfx.workflow.dau.direct.yml --output-dir scenarios
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+		Args:             cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+			if len(baseCmd.Filenames) != 1 {
+				return errors.New("exactly one workflow must be given, use --" + flags.FilenameFlag)
+			}
+			if !slices.Contains([]string{formatText, formatJSON}, baseCmd.Format) {
+				return fmt.Errorf("invalid format %q", baseCmd.Format)
+			}
+			workflows, err := manifest.Load(baseCmd.Filenames)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			if len(workflows) != 1 {
+				return fmt.Errorf("expected exactly one workflow, found %d", len(workflows))
+			}
+			paths, err := simulation.Paths(&workflows[0], baseCmd.MaxPaths)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+
+			entries := make([]entry, 0, len(paths))
+			for i, p := range paths {
+				entries = append(entries, entry{
+					Index:  i + 1,
+					States: p.States(),
+					Actors: p.Actors(),
+					Hops:   p.Hops,
+				})
+			}
+
+			if baseCmd.OutputDir != "" {
+				if err := os.MkdirAll(baseCmd.OutputDir, 0o755); err != nil {
+					return fault.Wrap(err)
+				}
+				width := len(fmt.Sprint(len(paths)))
+				for i, p := range paths {
+					fname := filepath.Join(baseCmd.OutputDir, fmt.Sprintf("path-%0*d.yml", width, i+1))
+					if err := writeScenario(fname, p.Script(p.String())); err != nil {
+						return fault.Wrap(err)
+					}
+					entries[i].Scenario = fname
+				}
+			}
+
+			out := cmd.OutOrStdout()
+			if baseCmd.Format == formatJSON {
+				enc := json.NewEncoder(out)
+				enc.SetIndent("", "  ")
+				return fault.Wrap(enc.Encode(entries))
+			}
+			writeText(out, paths, entries)
+			return nil
+		},
+	}
+	f := cmd.Flags()
+	f.StringSliceP(flags.FilenameFlag, "f", nil, "file containing the workflow (YAML or JSON)")
+	f.String(flags.FormatFlag, formatText, "output format, one of: text, json")
+	f.String(flags.OutputDirFlag, "", "directory to write a test scenario for each path to")
+	f.Int(flags.MaxPathsFlag, defaultMaxPaths, "fail if the workflow has more paths (0 means unlimited)")
+	return cmd
+}
+
+func writeScenario(fname string, script *simulation.Script) error {
+	b, err := yaml.Marshal(script)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	return fault.Wrap(os.WriteFile(fname, b, 0o644))
+}
+
+func writeText(w io.Writer, paths []simulation.Path, entries []entry) {
+	for i, p := range paths {
+		actors := make([]string, 0, len(entries[i].Actors))
+		for _, actor := range entries[i].Actors {
+			actors = append(actors, string(actor))
+		}
+		_, _ = fmt.Fprintf(w, "%d. %s (actors: %s)\n", entries[i].Index, p, strings.Join(actors, ", "))
+		if entries[i].Scenario != "" {
+			_, _ = fmt.Fprintf(w, "   scenario: %s\n", entries[i].Scenario)
+		}
+	}
+	_, _ = fmt.Fprintf(w, "%d path(s)\n", len(paths))
+}
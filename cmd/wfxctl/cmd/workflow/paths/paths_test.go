@@ -0,0 +1,80 @@
+package paths
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/simulation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const directWorkflow = "../../../../../workflow/dau/wfx.workflow.dau.direct.yml"
+
+func TestPaths_Text(t *testing.T) {
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-f", directWorkflow})
+	require.NoError(t, cmd.Execute())
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 6)
+	assert.Equal(t, "5. INSTALL -[CLIENT]-> TERMINATED (actors: CLIENT)", lines[4])
+	assert.Equal(t, "5 path(s)", lines[5])
+}
+
+func TestPaths_Scenarios(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "scenarios")
+
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-f", directWorkflow, "--" + flags.FormatFlag, "json", "--" + flags.OutputDirFlag, dir})
+	require.NoError(t, cmd.Execute())
+
+	var entries []entry
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entries))
+	require.Len(t, entries, 5)
+	assert.Equal(t, []string{"INSTALL", "TERMINATED"}, entries[4].States)
+	assert.Equal(t, []api.EligibleEnum{api.CLIENT}, entries[4].Actors)
+	assert.Equal(t, filepath.Join(dir, "path-5.yml"), entries[4].Scenario)
+
+	raw, err := os.ReadFile(entries[4].Scenario)
+	require.NoError(t, err)
+	script, err := simulation.ParseScript(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "INSTALL -[CLIENT]-> TERMINATED", script.Name)
+	assert.Equal(t, []simulation.Step{{Actor: api.CLIENT, State: "TERMINATED", Expect: "TERMINATED"}}, script.Steps)
+}
+
+func TestPaths_MaxPaths(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"-f", directWorkflow, "--" + flags.MaxPathsFlag, "2"})
+	assert.ErrorIs(t, cmd.Execute(), simulation.ErrTooManyPaths)
+}
+
+func TestPaths_InvalidArgs(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{})
+	assert.ErrorContains(t, cmd.Execute(), "exactly one workflow must be given")
+
+	cmd = NewCommand()
+	cmd.SetArgs([]string{"-f", directWorkflow, "--" + flags.FormatFlag, "xml"})
+	assert.ErrorContains(t, cmd.Execute(), "invalid format")
+}
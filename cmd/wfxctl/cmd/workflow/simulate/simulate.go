@@ -0,0 +1,203 @@
+package simulate
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/manifest"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/workflow/simulation"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+const stdin = "-"
+
+// output is the result of a simulation in JSON format.
+type output struct {
+	Workflow string               `json:"workflow"`
+	Scripts  []*simulation.Report `json:"scripts"`
+	Coverage simulation.Coverage  `json:"coverage"`
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate jobs without a running wfx instance",
+		Long: `Simulate jobs of a workflow without a running wfx instance.
+
+Each script creates a new job in the initial state of the workflow and sends the status updates described by its
+steps. Status updates are checked and immediate transitions are followed exactly like wfx does, i.e. rejected
+updates produce the same error messages.
+
+Example script:
+
+  name: happy path
+  steps:
+    - actor: CLIENT
+      state: INSTALLING
+    - actor: CLIENT
+      state: INSTALLED
+      expect: ACTIVATE   # state of the job after the step (optional)
+    - actor: WFX
+      state: ACTIVATING
+      reject: true       # the step is expected to be rejected
+
+Finally, the transitions which were never exercised by any script are listed.
+The command fails if a step does not behave as expected or if the coverage is below --` + flags.MinCoverageFlag + `.
+`,
+		Example: `
+wfxctl workflow simulate -f wfx.workflow.dau.direct.yml happy-path.yml failure.yml
+wfxctl workflow simulate -f wfx.workflow.dau.direct.yml --min-coverage=100 scripts/*.yml
+`,
+		TraverseChildren: true,
+		SilenceUsage:     true,
+		Args:             cobra.OnlyValidArgs,
+		ValidArgsFunction: func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+			return []string{"yaml", "yml", "json"}, cobra.ShellCompDirectiveFilterFileExt
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+			if len(baseCmd.Filenames) != 1 {
+				return errors.New("exactly one workflow must be given, use --" + flags.FilenameFlag)
+			}
+			if !slices.Contains([]string{formatText, formatJSON}, baseCmd.Format) {
+				return fmt.Errorf("invalid format %q", baseCmd.Format)
+			}
+			workflows, err := manifest.Load(baseCmd.Filenames)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			if len(workflows) != 1 {
+				return fmt.Errorf("expected exactly one workflow, found %d", len(workflows))
+			}
+			wf := workflows[0]
+			sim, err := simulation.New(&wf)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+
+			result := output{Workflow: wf.Name, Scripts: make([]*simulation.Report, 0, len(args))}
+			failedSteps := 0
+			for _, fname := range args {
+				script, err := readScript(fname, cmd.InOrStdin())
+				if err != nil {
+					return fault.Wrap(err)
+				}
+				if script.Name == "" {
+					script.Name = fname
+				}
+				report, err := sim.Run(script)
+				if err != nil {
+					return fault.Wrap(err)
+				}
+				for _, step := range report.Steps {
+					if step.Failed {
+						failedSteps++
+					}
+				}
+				result.Scripts = append(result.Scripts, report)
+			}
+			result.Coverage = sim.Coverage()
+
+			out := cmd.OutOrStdout()
+			switch baseCmd.Format {
+			case formatJSON:
+				enc := json.NewEncoder(out)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(result); err != nil {
+					return fault.Wrap(err)
+				}
+			default:
+				writeText(out, &result)
+			}
+
+			if failedSteps > 0 {
+				return fmt.Errorf("%d step(s) failed", failedSteps)
+			}
+			if percent := result.Coverage.Percent(); percent < baseCmd.MinCoverage {
+				return fmt.Errorf("transition coverage %.1f%% is below %.1f%%", percent, baseCmd.MinCoverage)
+			}
+			return nil
+		},
+	}
+	f := cmd.Flags()
+	f.StringSliceP(flags.FilenameFlag, "f", nil, "file containing the workflow (YAML or JSON)")
+	f.String(flags.FormatFlag, formatText, "output format, one of: text, json")
+	f.Float64(flags.MinCoverageFlag, 0, "minimum percentage of transitions which must be exercised")
+	return cmd
+}
+
+func readScript(fname string, r io.Reader) (*simulation.Script, error) {
+	var (
+		raw []byte
+		err error
+	)
+	if fname == stdin {
+		raw, err = io.ReadAll(r)
+	} else {
+		raw, err = os.ReadFile(fname)
+	}
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	script, err := simulation.ParseScript(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fname, err)
+	}
+	return script, nil
+}
+
+func writeText(w io.Writer, result *output) {
+	for _, report := range result.Scripts {
+		_, _ = fmt.Fprintf(w, "Script %s (initial state: %s)\n", report.Name, report.InitialState)
+		for i, step := range report.Steps {
+			outcome := step.To
+			if step.Error != "" {
+				outcome = "rejected: " + step.Error
+			}
+			_, _ = fmt.Fprintf(w, "  %d. %s %s -> %s: %s", i+1, step.Actor, step.From, step.State, outcome)
+			if step.Failed {
+				_, _ = fmt.Fprintf(w, " [FAILED: %s]", expectation(step))
+			}
+			_, _ = fmt.Fprintln(w)
+		}
+	}
+	coverage := result.Coverage
+	_, _ = fmt.Fprintf(w, "Coverage: %d/%d transitions (%.1f%%)\n", coverage.Covered, coverage.Total, coverage.Percent())
+	if missed := coverage.Missed(); len(missed) > 0 {
+		_, _ = fmt.Fprintln(w, "Never exercised:")
+		for _, t := range missed {
+			_, _ = fmt.Fprintf(w, "  %s -> %s (%s)\n", t.From, t.To, t.Eligible)
+		}
+	}
+}
+
+func expectation(step simulation.StepResult) string {
+	switch {
+	case step.Reject && step.Error == "":
+		return "expected rejection"
+	case !step.Reject && step.Error != "":
+		return "expected transition to be accepted"
+	default:
+		return "expected state " + step.Expect
+	}
+}
@@ -0,0 +1,106 @@
+package simulate
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const directWorkflow = "../../../../../workflow/dau/wfx.workflow.dau.direct.yml"
+
+const happyPath = `
+name: happy path
+steps:
+  - actor: CLIENT
+    state: INSTALLING
+  - actor: CLIENT
+    state: INSTALLED
+    expect: ACTIVATE
+  - actor: WFX
+    state: ACTIVATING
+    reject: true
+  - actor: CLIENT
+    state: ACTIVATING
+  - actor: CLIENT
+    state: ACTIVATED
+`
+
+func TestSimulate_Text(t *testing.T) {
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetIn(strings.NewReader(happyPath))
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-f", directWorkflow, "-"})
+	require.NoError(t, cmd.Execute())
+
+	expected := `Script happy path (initial state: INSTALL)
+  1. CLIENT INSTALL -> INSTALLING: INSTALLING
+  2. CLIENT INSTALLING -> INSTALLED: ACTIVATE
+  3. WFX ACTIVATE -> ACTIVATING: rejected: transition from 'ACTIVATE' to 'ACTIVATING' is not allowed for actor 'WFX'
+  4. CLIENT ACTIVATE -> ACTIVATING: ACTIVATING
+  5. CLIENT ACTIVATING -> ACTIVATED: ACTIVATED
+Coverage: 5/11 transitions (45.5%)
+Never exercised:
+`
+	assert.True(t, strings.HasPrefix(out.String(), expected), out.String())
+	assert.Contains(t, out.String(), "  INSTALLING -> INSTALLING (CLIENT)\n")
+}
+
+func TestSimulate_JSON(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "terminate.yml")
+	require.NoError(t, os.WriteFile(script, []byte("steps:\n  - actor: CLIENT\n    state: TERMINATED\n    expect: INSTALL\n"), 0o644))
+
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-f", directWorkflow, "--" + flags.FormatFlag, "json", script})
+	assert.ErrorContains(t, cmd.Execute(), "1 step(s) failed")
+
+	var result output
+	require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	assert.Equal(t, "wfx.workflow.dau.direct", result.Workflow)
+	require.Len(t, result.Scripts, 1)
+	assert.Equal(t, script, result.Scripts[0].Name)
+	assert.True(t, result.Scripts[0].Steps[0].Failed)
+	assert.Equal(t, "TERMINATED", result.Scripts[0].Steps[0].To)
+	assert.Equal(t, 1, result.Coverage.Covered)
+}
+
+func TestSimulate_MinCoverage(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetIn(strings.NewReader(happyPath))
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"-f", directWorkflow, "--" + flags.MinCoverageFlag, "50", "-"})
+	assert.ErrorContains(t, cmd.Execute(), "transition coverage 45.5% is below 50.0%")
+}
+
+func TestSimulate_InvalidArgs(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-"})
+	assert.ErrorContains(t, cmd.Execute(), "exactly one workflow must be given")
+
+	cmd = NewCommand()
+	cmd.SetArgs([]string{"-f", directWorkflow, "--" + flags.FormatFlag, "xml", "-"})
+	assert.ErrorContains(t, cmd.Execute(), "invalid format")
+
+	cmd = NewCommand()
+	cmd.SetIn(strings.NewReader("steps:\n  - actor: OPERATOR\n    state: FOO\n"))
+	cmd.SetArgs([]string{"-f", directWorkflow, "-"})
+	assert.ErrorContains(t, cmd.Execute(), `-: step 1: invalid actor "OPERATOR"`)
+}
@@ -0,0 +1,88 @@
+package stats
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/spf13/cobra"
+
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Analyze the time jobs spend in the states of a workflow",
+		Long: `Compute dwell time percentiles (p50, p95, p99) per state and the number of transitions between states,
+based on the history of the jobs using the workflow.
+
+The output can be passed to wfx-viewer (--stats) to annotate the workflow diagram.`,
+		Example: `
+wfxctl workflow stats --name=wfx.workflow.dau.direct --since=24h
+wfxctl workflow stats --name=wfx.workflow.dau.direct --since=2026-01-01T00:00:00Z --tag=beta > stats.json
+`,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			baseCmd := flags.NewBaseCmd(cmd.Flags())
+			name := baseCmd.Name
+			if name == "" {
+				return errors.New("workflow name missing")
+			}
+
+			now := time.Now()
+			params := new(api.GetWorkflowsNameStatsParams)
+			params.ParamTag = baseCmd.Tags
+			since, err := parseTime(baseCmd.Since, now)
+			if err != nil {
+				return fmt.Errorf("invalid %s value: %w", flags.SinceFlag, err)
+			}
+			params.ParamSince = since
+			until, err := parseTime(baseCmd.Until, now)
+			if err != nil {
+				return fmt.Errorf("invalid %s value: %w", flags.UntilFlag, err)
+			}
+			params.ParamUntil = until
+
+			client := errutil.Must(baseCmd.CreateMgmtClient())
+			resp, err := client.GetWorkflowsNameStats(cmd.Context(), name, params)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			return fault.Wrap(baseCmd.ProcessResponse(resp, cmd.OutOrStdout()))
+		},
+	}
+	f := cmd.Flags()
+	f.String(flags.NameFlag, "", "workflow name")
+	f.StringSlice(flags.TagFlag, nil, "only consider jobs with any of the given tags")
+	f.String(flags.SinceFlag, "", "only consider transitions at or after this time (RFC3339 or a duration relative to now, e.g. 24h)")
+	f.String(flags.UntilFlag, "", "only consider transitions before this time (RFC3339 or a duration relative to now, e.g. 1h)")
+	return cmd
+}
+
+// parseTime parses either an RFC3339 timestamp or a duration, which is subtracted from now.
+func parseTime(s string, now time.Time) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		t := now.Add(-d)
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,92 @@
+package stats
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/cmd/wfxctl/flags"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStats(t *testing.T) {
+	const expectedPath = "/api/wfx/v1/workflows/test/stats"
+	var actualPath string
+	var query url.Values
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actualPath = r.URL.Path
+		query = r.URL.Query()
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(api.WorkflowStats{
+			Workflow:    "test",
+			Jobs:        1,
+			Transitions: []api.TransitionStats{{From: "A", To: "B", Count: 1}},
+		})
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	t.Setenv("WFX_MGMT_HOST", u.Hostname())
+	t.Setenv("WFX_MGMT_PORT", u.Port())
+
+	cmd := NewCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{
+		"--" + flags.NameFlag, "test",
+		"--" + flags.TagFlag, "beta",
+		"--" + flags.SinceFlag, "2026-01-01T00:00:00Z",
+	})
+	err := cmd.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, expectedPath, actualPath)
+	assert.Equal(t, "beta", query.Get("tag"))
+	assert.Equal(t, "2026-01-01T00:00:00Z", query.Get("since"))
+	assert.False(t, query.Has("until"))
+
+	var stats api.WorkflowStats
+	require.NoError(t, json.Unmarshal(out.Bytes(), &stats))
+	assert.Equal(t, int64(1), stats.Jobs)
+}
+
+func TestWorkflowStats_MissingName(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{})
+	assert.ErrorContains(t, cmd.Execute(), "workflow name missing")
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	result, err := parseTime("", now)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	result, err = parseTime("24h", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *result)
+
+	result, err = parseTime("2025-12-31T12:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC), *result)
+
+	_, err = parseTime("yesterday", now)
+	assert.Error(t, err)
+}
@@ -9,10 +9,17 @@ package workflow
  */
 
 import (
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/apply"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/convert"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/create"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/delete"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/diff"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/get"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/lint"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/paths"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/query"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/simulate"
+	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/stats"
 	"github.com/siemens/wfx/cmd/wfxctl/cmd/workflow/validate"
 	"github.com/spf13/cobra"
 )
@@ -25,10 +32,17 @@ func NewCommand() *cobra.Command {
 		TraverseChildren: true,
 		SilenceUsage:     true,
 	}
+	cmd.AddCommand(apply.NewCommand())
+	cmd.AddCommand(convert.NewCommand())
 	cmd.AddCommand(create.NewCommand())
 	cmd.AddCommand(delete.NewCommand())
+	cmd.AddCommand(diff.NewCommand())
 	cmd.AddCommand(get.NewCommand())
+	cmd.AddCommand(lint.NewCommand())
+	cmd.AddCommand(paths.NewCommand())
 	cmd.AddCommand(query.NewCommand())
+	cmd.AddCommand(simulate.NewCommand())
+	cmd.AddCommand(stats.NewCommand())
 	cmd.AddCommand(validate.NewCommand())
 	return cmd
 }
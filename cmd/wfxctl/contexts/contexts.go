@@ -0,0 +1,162 @@
+package contexts
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+)
+
+// EnvConfig can be used to override the location of the contexts file.
+const EnvConfig = "WFXCTL_CONFIG"
+
+// Config is the content of the wfxctl contexts file.
+type Config struct {
+	CurrentContext string    `yaml:"current-context,omitempty"`
+	Contexts       []Context `yaml:"contexts"`
+}
+
+// Context describes how to reach a wfx instance. The keys correspond to the global wfxctl flags.
+type Context struct {
+	Name string `yaml:"name"`
+
+	ClientHost       string `yaml:"client-host,omitempty"`
+	ClientPort       int    `yaml:"client-port,omitempty"`
+	ClientTLSHost    string `yaml:"client-tls-host,omitempty"`
+	ClientTLSPort    int    `yaml:"client-tls-port,omitempty"`
+	ClientUnixSocket string `yaml:"client-unix-socket,omitempty"`
+
+	MgmtHost       string `yaml:"mgmt-host,omitempty"`
+	MgmtPort       int    `yaml:"mgmt-port,omitempty"`
+	MgmtTLSHost    string `yaml:"mgmt-tls-host,omitempty"`
+	MgmtTLSPort    int    `yaml:"mgmt-tls-port,omitempty"`
+	MgmtUnixSocket string `yaml:"mgmt-unix-socket,omitempty"`
+
+	EnableTLS bool   `yaml:"enable-tls,omitempty"`
+	TLSCa     string `yaml:"tls-ca,omitempty"`
+
+	// Credentials which are sent along with each request.
+	Token    string `yaml:"token,omitempty"`
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
+}
+
+// DefaultPath returns the location of the contexts file, i.e. $WFXCTL_CONFIG or ~/.config/wfxctl/config.yaml.
+func DefaultPath() string {
+	if path := os.Getenv(EnvConfig); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "wfxctl", "config.yaml")
+}
+
+// Load reads the contexts file. A missing file results in an empty config.
+func Load(path string) (*Config, error) {
+	cfg := new(Config)
+	if path == "" {
+		return cfg, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return cfg, nil
+		}
+		return nil, fault.Wrap(err)
+	}
+	if err := yaml.Unmarshal(b, cfg); err != nil {
+		return nil, fault.Wrap(fmt.Errorf("failed to parse %s: %w", path, err))
+	}
+	return cfg, nil
+}
+
+// Save writes the contexts file. Since contexts may contain credentials, the file is only readable by the owner.
+func (c *Config) Save(path string) error {
+	if path == "" {
+		return errors.New("unable to determine location of the wfxctl config file, please set " + EnvConfig)
+	}
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fault.Wrap(err)
+	}
+	return fault.Wrap(os.WriteFile(path, b, 0o600))
+}
+
+// Find returns the context with the given name.
+func (c *Config) Find(name string) (*Context, bool) {
+	idx := slices.IndexFunc(c.Contexts, func(ctx Context) bool { return ctx.Name == name })
+	if idx < 0 {
+		return nil, false
+	}
+	return &c.Contexts[idx], true
+}
+
+// Current returns the context selected by name or, if name is empty, the current context.
+// It returns nil if no context is selected.
+func (c *Config) Current(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, nil
+	}
+	ctx, found := c.Find(name)
+	if !found {
+		return nil, fmt.Errorf("context %q does not exist", name)
+	}
+	return ctx, nil
+}
+
+// Set adds the context or replaces an existing context with the same name.
+func (c *Config) Set(ctx Context) {
+	if existing, found := c.Find(ctx.Name); found {
+		*existing = ctx
+		return
+	}
+	c.Contexts = append(c.Contexts, ctx)
+}
+
+// Delete removes the context with the given name. If it was the current context, the current context is unset.
+func (c *Config) Delete(name string) bool {
+	n := len(c.Contexts)
+	c.Contexts = slices.DeleteFunc(c.Contexts, func(ctx Context) bool { return ctx.Name == name })
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+	return len(c.Contexts) != n
+}
+
+// CompleteNames provides shell completion for context names.
+func CompleteNames(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	cfg, err := Load(DefaultPath())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0, len(cfg.Contexts))
+	for _, ctx := range cfg.Contexts {
+		names = append(names, ctx.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,90 @@
+package contexts
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultPath(t *testing.T) {
+	t.Setenv(EnvConfig, "/tmp/wfxctl.yaml")
+	assert.Equal(t, "/tmp/wfxctl.yaml", DefaultPath())
+
+	t.Setenv(EnvConfig, "")
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/xdg")
+	assert.Equal(t, "/tmp/xdg/wfxctl/config.yaml", DefaultPath())
+}
+
+func TestLoad_Missing(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "config.yaml"))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Contexts)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("contexts: foo"), 0o600))
+	_, err := Load(path)
+	assert.ErrorContains(t, err, "failed to parse")
+}
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wfxctl", "config.yaml")
+	cfg := Config{CurrentContext: "prod"}
+	cfg.Set(Context{Name: "dev", ClientHost: "localhost"})
+	cfg.Set(Context{Name: "prod", MgmtHost: "wfx.example.com", MgmtTLSPort: 443, EnableTLS: true, Token: "secret"})
+	require.NoError(t, cfg.Save(path))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	actual, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, cfg, *actual)
+}
+
+func TestSetDelete(t *testing.T) {
+	var cfg Config
+	cfg.Set(Context{Name: "dev", ClientHost: "localhost"})
+	cfg.Set(Context{Name: "dev", ClientHost: "wfx.local"})
+	require.Len(t, cfg.Contexts, 1)
+	assert.Equal(t, "wfx.local", cfg.Contexts[0].ClientHost)
+
+	cfg.CurrentContext = "dev"
+	assert.False(t, cfg.Delete("foo"))
+	assert.True(t, cfg.Delete("dev"))
+	assert.Empty(t, cfg.Contexts)
+	assert.Empty(t, cfg.CurrentContext)
+}
+
+func TestCurrent(t *testing.T) {
+	cfg := Config{Contexts: []Context{{Name: "dev"}, {Name: "prod"}}}
+
+	ctx, err := cfg.Current("")
+	require.NoError(t, err)
+	assert.Nil(t, ctx)
+
+	cfg.CurrentContext = "dev"
+	ctx, err = cfg.Current("")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", ctx.Name)
+
+	ctx, err = cfg.Current("prod")
+	require.NoError(t, err)
+	assert.Equal(t, "prod", ctx.Name)
+
+	_, err = cfg.Current("staging")
+	assert.EqualError(t, err, `context "staging" does not exist`)
+}
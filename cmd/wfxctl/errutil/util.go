@@ -9,6 +9,7 @@ package errutil
  */
 
 import (
+	"errors"
 	"fmt"
 	"io"
 
@@ -34,3 +35,29 @@ func Must[T any](value T, err error) T {
 	}
 	return value
 }
+
+// ExitError causes wfxctl to terminate with the given exit code instead of the generic exit code 1.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode determines the exit code for the given error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return 1
+}
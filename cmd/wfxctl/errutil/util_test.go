@@ -10,8 +10,10 @@ package errutil
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
+	"github.com/Southclaws/fault"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/stretchr/testify/assert"
 )
@@ -41,3 +43,11 @@ func TestMust(t *testing.T) {
 	s := "hello world"
 	assert.Equal(t, s, Must(s, nil))
 }
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 0, ExitCode(nil))
+	assert.Equal(t, 1, ExitCode(errors.New("foo")))
+	err := fault.Wrap(&ExitError{Code: 3, Err: errors.New("timeout")})
+	assert.Equal(t, 3, ExitCode(err))
+	assert.Equal(t, "timeout", err.Error())
+}
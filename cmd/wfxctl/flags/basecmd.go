@@ -18,6 +18,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"reflect"
 	"strings"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/knadh/koanf/v2"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
 	"github.com/siemens/wfx/cmd/wfxctl/errutil"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/spf13/pflag"
@@ -45,6 +47,7 @@ const (
 	ClientUnixSocketFlag = "client-unix-socket"
 	ColorFlag            = "color"
 	ConfigFlag           = "config"
+	ContextFlag          = "context"
 	EnableTLSFlag        = "enable-tls"
 	FilterFlag           = "filter"
 	GroupFlag            = "group"
@@ -68,7 +71,31 @@ const (
 	TagFlag              = "tag"
 	WorkflowFlag         = "workflow"
 	NameFlag             = "name"
+	OutputFlag           = "output"
 	AutoReconnectFlag    = "auto-reconnect"
+	GroupByFlag          = "group-by"
+	BucketFlag           = "bucket"
+	IntervalFlag         = "interval"
+	SinceFlag            = "since"
+	UntilFlag            = "until"
+	TokenFlag            = "token"
+	UsernameFlag         = "username"
+	PasswordFlag         = "password"
+	TimeoutFlag          = "timeout"
+	UntilStateFlag       = "until-state"
+	UntilGroupFlag       = "until-group"
+	SuccessGroupFlag     = "success-group"
+	FilenameFlag         = "filename"
+	PruneFlag            = "prune"
+	ForceFlag            = "force"
+	FormatFlag           = "format"
+	FailOnFlag           = "fail-on"
+	SuppressFlag         = "suppress"
+	MinCoverageFlag      = "min-coverage"
+	OutputDirFlag        = "output-dir"
+	MaxPathsFlag         = "max-paths"
+	FromFlag             = "from"
+	ConventionFlag       = "convention"
 )
 
 type BaseCmd struct {
@@ -87,10 +114,17 @@ type BaseCmd struct {
 	MgmtTLSPort int    `validate:"required"`
 	MgmtSocket  string
 
+	// Credentials (bearer token or basic auth) sent along with each request
+	Token    string
+	Username string
+	Password string
+
 	Filter string
 	// Strip quotes to make output usable in shell scripts
 	RawOutput bool
 	ColorMode string
+	// Output format, see OutputFormats
+	Output string
 
 	ID        string
 	ClientID  string
@@ -109,6 +143,29 @@ type BaseCmd struct {
 	Actor     string
 	Name      string
 	Limit     int32
+	GroupBy   []string
+	Bucket    string
+	Interval  time.Duration
+	Since     string
+	Until     string
+
+	Timeout       time.Duration
+	UntilStates   []string
+	UntilGroups   []string
+	SuccessGroups []string
+
+	Filenames []string
+	Prune     bool
+	Force     bool
+
+	Format       string
+	FailOn       string
+	Suppressions []string
+	MinCoverage  float64
+	OutputDir    string
+	MaxPaths     int
+	From         string
+	Convention   string
 }
 
 func NewBaseCmd(f *pflag.FlagSet) BaseCmd {
@@ -133,6 +190,19 @@ func NewBaseCmd(f *pflag.FlagSet) BaseCmd {
 		}
 	}
 
+	// The selected context takes precedence over config files but not over env variables or flags.
+	contextName, _ := f.GetString(ContextFlag)
+	if contextName == "" {
+		contextName = os.Getenv("WFX_CONTEXT")
+	}
+	if err := loadContext(k, contexts.DefaultPath(), contextName); err != nil {
+		if contextName != "" {
+			log.Fatal().Err(err).Str("context", contextName).Msg("Failed to load context")
+		}
+		// commands must keep working with a broken contexts file unless a context was requested
+		log.Warn().Err(err).Msg("Failed to load context, continuing without")
+	}
+
 	envProvider := env.Provider(".", env.Opt{
 		Prefix: "WFX",
 		TransformFunc: func(k string, v string) (string, any) {
@@ -179,6 +249,7 @@ func NewBaseCmd(f *pflag.FlagSet) BaseCmd {
 		MgmtTLSHost: k.String(MgmtTLSHostFlag),
 		MgmtTLSPort: k.Int(MgmtTLSPortFlag),
 		Offset:      k.Int64(OffsetFlag),
+		Output:      k.String(OutputFlag),
 		Port:        k.Int(ClientPortFlag),
 		RawOutput:   k.Bool(RawFlag),
 		Socket:      k.String(ClientUnixSocketFlag),
@@ -195,7 +266,72 @@ func NewBaseCmd(f *pflag.FlagSet) BaseCmd {
 		Actor:       k.String(ActorFlag),
 		Name:        k.String(NameFlag),
 		Limit:       int32(k.Int(LimitFlag)),
+		GroupBy:     k.Strings(GroupByFlag),
+		Bucket:      k.String(BucketFlag),
+		Interval:    k.Duration(IntervalFlag),
+		Since:       k.String(SinceFlag),
+		Until:       k.String(UntilFlag),
+		Token:       k.String(TokenFlag),
+		Username:    k.String(UsernameFlag),
+		Password:    k.String(PasswordFlag),
+
+		Timeout:       k.Duration(TimeoutFlag),
+		UntilStates:   k.Strings(UntilStateFlag),
+		UntilGroups:   k.Strings(UntilGroupFlag),
+		SuccessGroups: k.Strings(SuccessGroupFlag),
+
+		Filenames: k.Strings(FilenameFlag),
+		Prune:     k.Bool(PruneFlag),
+		Force:     k.Bool(ForceFlag),
+
+		Format:       k.String(FormatFlag),
+		FailOn:       k.String(FailOnFlag),
+		Suppressions: k.Strings(SuppressFlag),
+		MinCoverage:  k.Float64(MinCoverageFlag),
+		OutputDir:    k.String(OutputDirFlag),
+		MaxPaths:     k.Int(MaxPathsFlag),
+		From:         k.String(FromFlag),
+		Convention:   k.String(ConventionFlag),
+	}
+}
+
+// loadContext applies the settings of the given context (or the current context, if name is empty).
+func loadContext(k *koanf.Koanf, path string, name string) error {
+	cfg, err := contexts.Load(path)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	ctx, err := cfg.Current(name)
+	if err != nil || ctx == nil {
+		return fault.Wrap(err)
+	}
+	log.Debug().Str("context", ctx.Name).Msg("Using context")
+	values := map[string]any{
+		ClientHostFlag:       ctx.ClientHost,
+		ClientPortFlag:       ctx.ClientPort,
+		ClientTLSHostFlag:    ctx.ClientTLSHost,
+		ClientTLSPortFlag:    ctx.ClientTLSPort,
+		ClientUnixSocketFlag: ctx.ClientUnixSocket,
+		MgmtHostFlag:         ctx.MgmtHost,
+		MgmtPortFlag:         ctx.MgmtPort,
+		MgmtTLSHostFlag:      ctx.MgmtTLSHost,
+		MgmtTLSPortFlag:      ctx.MgmtTLSPort,
+		MgmtUnixSocketFlag:   ctx.MgmtUnixSocket,
+		EnableTLSFlag:        ctx.EnableTLS,
+		TLSCaFlag:            ctx.TLSCa,
+		TokenFlag:            ctx.Token,
+		UsernameFlag:         ctx.Username,
+		PasswordFlag:         ctx.Password,
 	}
+	for key, value := range values {
+		if reflect.ValueOf(value).IsZero() {
+			continue
+		}
+		if err := k.Set(key, value); err != nil {
+			return fault.Wrap(err)
+		}
+	}
+	return nil
 }
 
 func (b *BaseCmd) SortParam() (*api.SortEnum, error) {
@@ -233,12 +369,12 @@ func (b *BaseCmd) CreateHTTPClient() (*http.Client, error) {
 		}
 		log.Info().Msg("Using unix-domain socket transport")
 		return &http.Client{
-			Transport: &http.Transport{
+			Transport: b.withCredentials(&http.Transport{
 				Dial: func(_, _ string) (net.Conn, error) {
 					conn, err := net.DialUnix("unix", nil, addr)
 					return conn, fault.Wrap(err)
 				},
-			},
+			}),
 			Timeout: time.Second * 10,
 		}, nil
 	}
@@ -261,14 +397,40 @@ func (b *BaseCmd) CreateHTTPClient() (*http.Client, error) {
 	}
 
 	return &http.Client{
-		Transport: &http.Transport{
+		Transport: b.withCredentials(&http.Transport{
 			TLSClientConfig:     tlsConfig,
 			TLSHandshakeTimeout: time.Second * 10,
-		},
+		}),
 		Timeout: time.Second * 10,
 	}, nil
 }
 
+// withCredentials wraps the transport to authenticate each request, if credentials are configured.
+func (b *BaseCmd) withCredentials(transport *http.Transport) http.RoundTripper {
+	if b.Token == "" && b.Username == "" {
+		return transport
+	}
+	return credentialsTransport{next: transport, token: b.Token, username: b.Username, password: b.Password}
+}
+
+type credentialsTransport struct {
+	next     http.RoundTripper
+	token    string
+	username string
+	password string
+}
+
+func (t credentialsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the original request
+	req = req.Clone(req.Context())
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	} else {
+		req.SetBasicAuth(t.username, t.password)
+	}
+	return t.next.RoundTrip(req)
+}
+
 func (b *BaseCmd) CreateClient() (*api.Client, error) {
 	var server string
 	swagger := errutil.Must(api.GetSpec())
@@ -333,28 +495,38 @@ func (b *BaseCmd) dumpResponse(w io.Writer, payload []byte) error {
 	if len(payload) == 0 {
 		return nil
 	}
-	if b.Filter != "" {
+	useColor, err := b.UseColor(w)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	p, err := newPrinter(b.Output, useColor, terminalWidth(w))
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	if b.Filter != "" && p.format == OutputJSON {
 		return fault.Wrap(dumpFiltered(payload, b.Filter, b.RawOutput, w))
 	}
+
 	var body any
 	if err := json.Unmarshal(payload, &body); err != nil {
 		return fault.Wrap(err)
 	}
-	encoder := json.NewEncoder(w)
-	encoder.SetIndent("", "  ")
-	return fault.Wrap(encoder.Encode(body))
+	values := []any{body}
+	if b.Filter != "" {
+		// the output format is applied to the results of the filter
+		if values, err = runFilter(body, b.Filter); err != nil {
+			return fault.Wrap(err)
+		}
+	}
+	return fault.Wrap(p.print(w, values))
 }
 
-func dumpFiltered(payload []byte, filter string, rawOutput bool, w io.Writer) error {
+func runFilter(input any, filter string) ([]any, error) {
 	query, err := gojq.Parse(filter)
 	if err != nil {
-		return fault.Wrap(err)
-	}
-
-	var input any
-	if err := json.Unmarshal(payload, &input); err != nil {
-		return fault.Wrap(err)
+		return nil, fault.Wrap(err)
 	}
+	var result []any
 	iter := query.Run(input)
 	for {
 		v, ok := iter.Next()
@@ -362,9 +534,23 @@ func dumpFiltered(payload []byte, filter string, rawOutput bool, w io.Writer) er
 			break
 		}
 		if err, ok := v.(error); ok {
-			return fault.Wrap(err)
+			return nil, fault.Wrap(err)
 		}
+		result = append(result, v)
+	}
+	return result, nil
+}
 
+func dumpFiltered(payload []byte, filter string, rawOutput bool, w io.Writer) error {
+	var input any
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fault.Wrap(err)
+	}
+	values, err := runFilter(input, filter)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	for _, v := range values {
 		if rawOutput {
 			if s, ok := v.(string); ok {
 				fmt.Fprintf(w, "%s\n", s)
@@ -378,7 +564,6 @@ func dumpFiltered(payload []byte, filter string, rawOutput bool, w io.Writer) er
 				return fault.Wrap(err)
 			}
 		}
-
 	}
 	return nil
 }
@@ -14,9 +14,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/knadh/koanf/v2"
 	"github.com/rs/zerolog"
+	"github.com/siemens/wfx/cmd/wfxctl/contexts"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
@@ -252,3 +255,111 @@ func TestNewBaseCmd_EnvVariables(t *testing.T) {
 	NewBaseCmd(f)
 	assert.Equal(t, zerolog.TraceLevel, zerolog.GlobalLevel())
 }
+
+func TestNewBaseCmd_Context(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(contexts.EnvConfig, path)
+	cfg := contexts.Config{
+		CurrentContext: "dev",
+		Contexts: []contexts.Context{
+			{Name: "dev", ClientHost: "wfx.local", ClientPort: 9080},
+			{Name: "prod", MgmtHost: "wfx.example.com", EnableTLS: true, Token: "secret"},
+		},
+	}
+	require.NoError(t, cfg.Save(path))
+
+	newFlagSet := func() *pflag.FlagSet {
+		f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		f.String(ContextFlag, "", "context")
+		f.String(ClientHostFlag, "localhost", "host")
+		f.Int(ClientPortFlag, 8080, "port")
+		f.String(MgmtHostFlag, "localhost", "management host")
+		f.Bool(EnableTLSFlag, false, "tls")
+		return f
+	}
+
+	t.Run("current context", func(t *testing.T) {
+		b := NewBaseCmd(newFlagSet())
+		assert.Equal(t, "wfx.local", b.Host)
+		assert.Equal(t, 9080, b.Port)
+		assert.Equal(t, "localhost", b.MgmtHost)
+	})
+
+	t.Run("flags take precedence", func(t *testing.T) {
+		f := newFlagSet()
+		require.NoError(t, f.Parse([]string{"--" + ClientPortFlag, "8888"}))
+		b := NewBaseCmd(f)
+		assert.Equal(t, "wfx.local", b.Host)
+		assert.Equal(t, 8888, b.Port)
+	})
+
+	t.Run("env takes precedence", func(t *testing.T) {
+		t.Setenv("WFX_CLIENT_HOST", "wfx.env")
+		b := NewBaseCmd(newFlagSet())
+		assert.Equal(t, "wfx.env", b.Host)
+	})
+
+	t.Run("select context", func(t *testing.T) {
+		f := newFlagSet()
+		require.NoError(t, f.Parse([]string{"--" + ContextFlag, "prod"}))
+		b := NewBaseCmd(f)
+		assert.Equal(t, "localhost", b.Host)
+		assert.Equal(t, "wfx.example.com", b.MgmtHost)
+		assert.True(t, b.EnableTLS)
+		assert.Equal(t, "secret", b.Token)
+	})
+
+	t.Run("select context using env", func(t *testing.T) {
+		t.Setenv("WFX_CONTEXT", "prod")
+		b := NewBaseCmd(newFlagSet())
+		assert.Equal(t, "wfx.example.com", b.MgmtHost)
+	})
+}
+
+func TestLoadContext_Unknown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := loadContext(koanf.New("."), path, "foo")
+	assert.EqualError(t, err, `context "foo" does not exist`)
+}
+
+func TestCreateHTTPClient_Credentials(t *testing.T) {
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(ts.Close)
+
+	t.Run("token", func(t *testing.T) {
+		b := BaseCmd{Token: "secret"}
+		client, err := b.CreateHTTPClient()
+		require.NoError(t, err)
+		resp, err := client.Get(ts.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, "Bearer secret", authorization)
+	})
+
+	t.Run("basic auth", func(t *testing.T) {
+		b := BaseCmd{Username: "alice", Password: "secret"}
+		client, err := b.CreateHTTPClient()
+		require.NoError(t, err)
+		resp, err := client.Get(ts.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, "Basic YWxpY2U6c2VjcmV0", authorization)
+	})
+}
+
+func TestNewBaseCmd_InvalidContexts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(contexts.EnvConfig, path)
+	require.NoError(t, os.WriteFile(path, []byte("contexts: {invalid"), 0o600))
+
+	// without an explicitly requested context, the contexts file is optional
+	f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	f.String(ContextFlag, "", "context")
+	f.String(ClientHostFlag, "localhost", "host")
+	b := NewBaseCmd(f)
+	assert.Equal(t, "localhost", b.Host)
+}
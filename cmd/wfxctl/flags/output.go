@@ -0,0 +1,497 @@
+package flags
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+	"github.com/gookit/color"
+	"github.com/itchyny/gojq"
+	"golang.org/x/term"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/workflow"
+)
+
+const (
+	OutputJSON  = "json"
+	OutputJSONL = "jsonl"
+	OutputYAML  = "yaml"
+	OutputTable = "table"
+	OutputWide  = "wide"
+
+	customColumnsPrefix = "custom-columns="
+	templatePrefix      = "template="
+)
+
+const (
+	ColorAlways = "always"
+	ColorAuto   = "auto"
+	ColorNever  = "never"
+)
+
+// OutputFormats lists the supported values of the --output flag, used for help texts.
+const OutputFormats = "json, jsonl, yaml, table, wide, custom-columns=HEADER:.jq.expr,..., template=GO-TEMPLATE"
+
+const (
+	// placeholder for empty cells in tables
+	emptyCell = "-"
+	// gap between table columns
+	columnGap = 2
+	// columns are not truncated below this width
+	minColumnWidth = 8
+)
+
+// ValidateOutput checks whether the given output format is supported.
+func ValidateOutput(output string) error {
+	_, err := newPrinter(output, false, 0)
+	return fault.Wrap(err)
+}
+
+// UseColor reports whether output written to w shall be colorized according to the color mode.
+func (b *BaseCmd) UseColor(w io.Writer) (bool, error) {
+	switch b.ColorMode {
+	case ColorAlways:
+		return true, nil
+	case ColorAuto, "":
+		f, ok := w.(*os.File)
+		return ok && term.IsTerminal(int(f.Fd())), nil
+	case ColorNever:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unsupported color mode: %s", b.ColorMode)
+	}
+}
+
+// printer renders decoded JSON values in one of the supported output formats.
+type printer struct {
+	format   string
+	useColor bool
+	// maximum width of a table; zero means unlimited
+	width int
+
+	columns  []column
+	template *template.Template
+}
+
+type column struct {
+	header string
+	value  func(item any) string
+}
+
+func newPrinter(output string, useColor bool, width int) (*printer, error) {
+	p := &printer{format: output, useColor: useColor, width: width}
+	switch {
+	case output == "" || output == OutputJSON:
+		p.format = OutputJSON
+	case output == OutputJSONL, output == OutputYAML, output == OutputTable:
+	case output == OutputWide:
+		// wide output is never truncated
+		p.width = 0
+	case strings.HasPrefix(output, customColumnsPrefix):
+		columns, err := parseCustomColumns(strings.TrimPrefix(output, customColumnsPrefix))
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		p.format = customColumnsPrefix
+		p.columns = columns
+	case strings.HasPrefix(output, templatePrefix):
+		tmpl, err := template.New("output").Parse(strings.TrimPrefix(output, templatePrefix))
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		p.format = templatePrefix
+		p.template = tmpl
+	default:
+		return nil, fmt.Errorf("unsupported output format %q, possible values: %s", output, OutputFormats)
+	}
+	return p, nil
+}
+
+// parseCustomColumns parses a spec such as "ID:.id,STATE:.status.state".
+func parseCustomColumns(spec string) ([]column, error) {
+	var result []column
+	for part := range strings.SplitSeq(spec, ",") {
+		header, expr, ok := strings.Cut(part, ":")
+		if !ok || header == "" || expr == "" {
+			return nil, fmt.Errorf("invalid custom column %q, expected HEADER:.jq.expr", part)
+		}
+		query, err := gojq.Parse(expr)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		code, err := gojq.Compile(query)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		result = append(result, column{header: header, value: func(item any) string {
+			v, ok := code.Run(item).Next()
+			if !ok {
+				return ""
+			}
+			if _, isErr := v.(error); isErr {
+				return ""
+			}
+			return formatCell(v)
+		}})
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no custom columns specified")
+	}
+	return result, nil
+}
+
+func (p *printer) print(w io.Writer, values []any) error {
+	switch p.format {
+	case OutputYAML:
+		for i, v := range values {
+			if i > 0 {
+				fmt.Fprintln(w, "---")
+			}
+			b, err := yaml.Marshal(v)
+			if err != nil {
+				return fault.Wrap(err)
+			}
+			if _, err := w.Write(b); err != nil {
+				return fault.Wrap(err)
+			}
+		}
+	case OutputJSONL:
+		encoder := json.NewEncoder(w)
+		for _, item := range items(values) {
+			if err := encoder.Encode(item); err != nil {
+				return fault.Wrap(err)
+			}
+		}
+	case templatePrefix:
+		for _, item := range items(values) {
+			if err := p.template.Execute(w, item); err != nil {
+				return fault.Wrap(err)
+			}
+		}
+	case OutputTable, OutputWide, customColumnsPrefix:
+		all := items(values)
+		columns := p.columns
+		if columns == nil {
+			if len(all) > 0 && allItems(all, isJob) {
+				var err error
+				if all, err = toJobs(all); err != nil {
+					return fault.Wrap(err)
+				}
+				columns = jobColumns(p.format == OutputWide)
+			} else {
+				columns = defaultColumns(all, p.format == OutputWide)
+			}
+		}
+		return fault.Wrap(p.printTable(w, columns, all))
+	default:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		for _, v := range values {
+			if err := encoder.Encode(v); err != nil {
+				return fault.Wrap(err)
+			}
+		}
+	}
+	return nil
+}
+
+// items flattens lists so that each job or workflow becomes a separate item.
+func items(values []any) []any {
+	result := make([]any, 0, len(values))
+	for _, v := range values {
+		switch v := v.(type) {
+		case []any:
+			result = append(result, v...)
+		case map[string]any:
+			if content, ok := v["content"].([]any); ok {
+				// paginated list
+				result = append(result, content...)
+				continue
+			}
+			result = append(result, v)
+		default:
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// defaultColumns determines the columns for items which are not jobs.
+func defaultColumns(items []any, wide bool) []column {
+	if len(items) == 0 {
+		return nil
+	}
+	if allItems(items, isWorkflow) {
+		return workflowColumns(wide)
+	}
+
+	// generic objects: one column per top-level key
+	var keys []string
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return []column{{header: "VALUE", value: formatCell}}
+		}
+		for k := range m {
+			if !slices.Contains(keys, k) {
+				keys = append(keys, k)
+			}
+		}
+	}
+	slices.Sort(keys)
+	columns := make([]column, 0, len(keys))
+	for _, k := range keys {
+		columns = append(columns, column{header: strings.ToUpper(k), value: func(item any) string {
+			return formatCell(item.(map[string]any)[k])
+		}})
+	}
+	return columns
+}
+
+func allItems(items []any, pred func(map[string]any) bool) bool {
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok || !pred(m) {
+			return false
+		}
+	}
+	return true
+}
+
+func isJob(m map[string]any) bool {
+	_, hasClientID := m["clientId"]
+	_, hasWorkflow := m["workflow"]
+	return hasClientID && hasWorkflow
+}
+
+func isWorkflow(m map[string]any) bool {
+	_, hasStates := m["states"]
+	_, hasTransitions := m["transitions"]
+	return hasStates && hasTransitions
+}
+
+func jobColumns(wide bool) []column {
+	columns := []column{
+		{header: "ID", value: func(item any) string { return item.(*api.Job).ID }},
+		{header: "CLIENT ID", value: func(item any) string { return item.(*api.Job).ClientID }},
+		{header: "WORKFLOW", value: func(item any) string {
+			if wf := item.(*api.Job).Workflow; wf != nil {
+				return wf.Name
+			}
+			return ""
+		}},
+		{header: "STATE", value: func(item any) string {
+			if status := item.(*api.Job).Status; status != nil {
+				return status.State
+			}
+			return ""
+		}},
+		{header: "GROUP", value: func(item any) string {
+			job := item.(*api.Job)
+			if job.Workflow == nil || job.Status == nil {
+				return ""
+			}
+			return workflow.FindStateGroup(job.Workflow, job.Status.State)
+		}},
+		{header: "MODIFIED", value: func(item any) string { return formatTime(item.(*api.Job).Mtime) }},
+	}
+	if wide {
+		columns = append(
+			columns,
+			column{header: "CREATED", value: func(item any) string { return formatTime(item.(*api.Job).Stime) }},
+			column{header: "PROGRESS", value: func(item any) string {
+				if status := item.(*api.Job).Status; status != nil && status.Progress != nil {
+					return fmt.Sprintf("%d%%", *status.Progress)
+				}
+				return ""
+			}},
+			column{header: "TAGS", value: func(item any) string {
+				if tags := item.(*api.Job).Tags; tags != nil {
+					return strings.Join(*tags, ",")
+				}
+				return ""
+			}},
+			column{header: "MESSAGE", value: func(item any) string {
+				if status := item.(*api.Job).Status; status != nil {
+					return status.Message
+				}
+				return ""
+			}},
+		)
+	}
+	return columns
+}
+
+// toJobs converts decoded JSON objects into jobs, which are required by the job columns.
+func toJobs(items []any) ([]any, error) {
+	result := make([]any, 0, len(items))
+	for _, item := range items {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		job := new(api.Job)
+		if err := json.Unmarshal(b, job); err != nil {
+			return nil, fault.Wrap(err)
+		}
+		result = append(result, job)
+	}
+	return result, nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func workflowColumns(wide bool) []column {
+	count := func(key string) func(item any) string {
+		return func(item any) string {
+			list, _ := item.(map[string]any)[key].([]any)
+			return fmt.Sprint(len(list))
+		}
+	}
+	columns := []column{
+		{header: "NAME", value: func(item any) string { return formatCell(item.(map[string]any)["name"]) }},
+		{header: "STATES", value: count("states")},
+		{header: "TRANSITIONS", value: count("transitions")},
+	}
+	if wide {
+		columns = append(
+			columns,
+			column{header: "GROUPS", value: count("groups")},
+			column{header: "DESCRIPTION", value: func(item any) string { return formatCell(item.(map[string]any)["description"]) }},
+		)
+	}
+	return columns
+}
+
+// formatCell renders a JSON value as a single-line string.
+func formatCell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64, bool, json.Number:
+		return fmt.Sprint(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+func (p *printer) printTable(w io.Writer, columns []column, items []any) error {
+	if len(columns) == 0 {
+		return nil
+	}
+	rows := make([][]string, 0, len(items)+1)
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.header
+	}
+	rows = append(rows, header)
+	for _, item := range items {
+		row := make([]string, len(columns))
+		for i, c := range columns {
+			cell := strings.Join(strings.Fields(c.value(item)), " ")
+			if cell == "" {
+				cell = emptyCell
+			}
+			row[i] = cell
+		}
+		rows = append(rows, row)
+	}
+
+	widths := make([]int, len(columns))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	if p.width > 0 {
+		widths = fitWidths(widths, p.width)
+	}
+
+	var b strings.Builder
+	for r, row := range rows {
+		var line strings.Builder
+		for i, cell := range row {
+			cell = truncate(cell, widths[i])
+			if i < len(row)-1 {
+				cell += strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)+columnGap)
+			}
+			line.WriteString(cell)
+		}
+		s := line.String()
+		if r == 0 && p.useColor {
+			s = color.Bold.Render(s)
+		}
+		b.WriteString(s)
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return fault.Wrap(err)
+}
+
+// fitWidths shrinks the widest columns until the table fits into the given width.
+func fitWidths(widths []int, maxWidth int) []int {
+	total := columnGap * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+	for total > maxWidth {
+		widest := 0
+		for i, w := range widths {
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= minColumnWidth {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+	return widths
+}
+
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:width-1]) + "…"
+}
+
+// terminalWidth returns the width of the terminal w refers to, or zero if w is not a terminal.
+func terminalWidth(w io.Writer) int {
+	f, ok := w.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return 0
+	}
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
@@ -0,0 +1,156 @@
+package flags
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+)
+
+func jobListPayload(t *testing.T) []byte {
+	mtime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	progress := int32(50)
+	tags := []string{"alpha", "beta"}
+	list := api.PaginatedJobList{Content: []api.Job{
+		{ID: "1", ClientID: "foo", Workflow: dau.DirectWorkflow(), Status: &api.JobStatus{State: "INSTALLING", Progress: &progress}, Mtime: &mtime, Stime: &mtime, Tags: &tags},
+		{ID: "2", ClientID: "bar", Workflow: dau.DirectWorkflow(), Status: &api.JobStatus{State: "ACTIVATED", Message: "done"}, Mtime: &mtime, Stime: &mtime},
+	}}
+	payload, err := json.Marshal(list)
+	require.NoError(t, err)
+	return payload
+}
+
+func dump(t *testing.T, b BaseCmd, payload []byte) string {
+	t.Helper()
+	b.ColorMode = ColorNever
+	buf := new(bytes.Buffer)
+	require.NoError(t, b.dumpResponse(buf, payload))
+	return buf.String()
+}
+
+func TestValidateOutput(t *testing.T) {
+	for _, output := range []string{"", OutputJSON, OutputJSONL, OutputYAML, OutputTable, OutputWide, "custom-columns=ID:.id", "template={{.id}}"} {
+		assert.NoError(t, ValidateOutput(output), output)
+	}
+	assert.ErrorContains(t, ValidateOutput("xml"), `unsupported output format "xml"`)
+	assert.ErrorContains(t, ValidateOutput("custom-columns=ID"), "invalid custom column")
+	assert.ErrorContains(t, ValidateOutput("custom-columns=ID:.id["), "unexpected EOF")
+	assert.Error(t, ValidateOutput("template={{.id"))
+}
+
+func TestOutput_JobTable(t *testing.T) {
+	actual := dump(t, BaseCmd{Output: OutputTable}, jobListPayload(t))
+	expected := `ID  CLIENT ID  WORKFLOW                 STATE       GROUP   MODIFIED
+1   foo        wfx.workflow.dau.direct  INSTALLING  OPEN    2026-01-02T03:04:05Z
+2   bar        wfx.workflow.dau.direct  ACTIVATED   CLOSED  2026-01-02T03:04:05Z
+`
+	assert.Equal(t, expected, actual)
+}
+
+func TestOutput_JobWide(t *testing.T) {
+	actual := dump(t, BaseCmd{Output: OutputWide}, jobListPayload(t))
+	expected := `ID  CLIENT ID  WORKFLOW                 STATE       GROUP   MODIFIED              CREATED               PROGRESS  TAGS        MESSAGE
+1   foo        wfx.workflow.dau.direct  INSTALLING  OPEN    2026-01-02T03:04:05Z  2026-01-02T03:04:05Z  50%       alpha,beta  -
+2   bar        wfx.workflow.dau.direct  ACTIVATED   CLOSED  2026-01-02T03:04:05Z  2026-01-02T03:04:05Z  -         -           done
+`
+	assert.Equal(t, expected, actual)
+}
+
+func TestOutput_WorkflowTable(t *testing.T) {
+	payload, err := json.Marshal(dau.PhasedWorkflow())
+	require.NoError(t, err)
+	actual := dump(t, BaseCmd{Output: OutputTable}, payload)
+	expected := `NAME                     STATES  TRANSITIONS
+wfx.workflow.dau.phased  11      19
+`
+	assert.Equal(t, expected, actual)
+}
+
+func TestOutput_GenericTable(t *testing.T) {
+	actual := dump(t, BaseCmd{Output: OutputTable}, []byte(`{"state":"INSTALL","progress":10}`))
+	assert.Equal(t, "PROGRESS  STATE\n10        INSTALL\n", actual)
+
+	actual = dump(t, BaseCmd{Output: OutputTable}, []byte(`["alpha","beta"]`))
+	assert.Equal(t, "VALUE\nalpha\nbeta\n", actual)
+}
+
+func TestOutput_CustomColumns(t *testing.T) {
+	actual := dump(t, BaseCmd{Output: "custom-columns=ID:.id,STATE:.status.state,FOO:.foo"}, jobListPayload(t))
+	assert.Equal(t, "ID  STATE       FOO\n1   INSTALLING  -\n2   ACTIVATED   -\n", actual)
+}
+
+func TestOutput_Template(t *testing.T) {
+	actual := dump(t, BaseCmd{Output: `template={{.id}}={{.status.state}}{{"\n"}}`}, jobListPayload(t))
+	assert.Equal(t, "1=INSTALLING\n2=ACTIVATED\n", actual)
+}
+
+func TestOutput_JSONL(t *testing.T) {
+	actual := dump(t, BaseCmd{Output: OutputJSONL, Filter: "[.content[] | {id}]"}, jobListPayload(t))
+	assert.Equal(t, "{\"id\":\"1\"}\n{\"id\":\"2\"}\n", actual)
+}
+
+func TestOutput_YAML(t *testing.T) {
+	actual := dump(t, BaseCmd{Output: OutputYAML, Filter: ".content[] | {id, clientId}"}, jobListPayload(t))
+	assert.Equal(t, "clientId: foo\nid: \"1\"\n---\nclientId: bar\nid: \"2\"\n", actual)
+}
+
+func TestOutput_FilterTable(t *testing.T) {
+	actual := dump(t, BaseCmd{Output: OutputTable, Filter: ".content[] | select(.id == \"2\")"}, jobListPayload(t))
+	assert.Contains(t, actual, "ACTIVATED")
+	assert.NotContains(t, actual, "INSTALLING")
+}
+
+func TestOutput_Invalid(t *testing.T) {
+	b := BaseCmd{Output: "xml", ColorMode: ColorNever}
+	err := b.dumpResponse(new(bytes.Buffer), []byte(`{}`))
+	assert.ErrorContains(t, err, "unsupported output format")
+}
+
+func TestPrintTable_Width(t *testing.T) {
+	p, err := newPrinter(OutputTable, false, 30)
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	require.NoError(t, p.print(buf, []any{map[string]any{"a": "short", "b": "a very long value which does not fit"}}))
+	assert.Equal(t, "A      B\nshort  a very long value whic…\n", buf.String())
+}
+
+func TestPrintTable_Color(t *testing.T) {
+	p, err := newPrinter(OutputTable, true, 0)
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	require.NoError(t, p.print(buf, []any{map[string]any{"a": "b"}}))
+	assert.Equal(t, "\x1b[1mA\x1b[0m\nb\n", buf.String())
+}
+
+func TestFitWidths(t *testing.T) {
+	assert.Equal(t, []int{10, 10}, fitWidths([]int{10, 10}, 22))
+	assert.Equal(t, []int{10, 12}, fitWidths([]int{10, 30}, 24))
+	// columns are not truncated below the minimum width
+	assert.Equal(t, []int{8, 8}, fitWidths([]int{10, 30}, 5))
+}
+
+func TestUseColor(t *testing.T) {
+	for mode, expected := range map[string]bool{ColorAlways: true, ColorNever: false, ColorAuto: false} {
+		b := BaseCmd{ColorMode: mode}
+		actual, err := b.UseColor(new(bytes.Buffer))
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual, mode)
+	}
+	b := BaseCmd{ColorMode: "foo"}
+	_, err := b.UseColor(new(bytes.Buffer))
+	assert.EqualError(t, err, "unsupported color mode: foo")
+}
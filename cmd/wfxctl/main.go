@@ -12,10 +12,11 @@ import (
 	"os"
 
 	"github.com/siemens/wfx/cmd/wfxctl/cmd"
+	"github.com/siemens/wfx/cmd/wfxctl/errutil"
 )
 
 func main() {
 	if err := cmd.NewCommand().Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(errutil.ExitCode(err))
 	}
 }
@@ -0,0 +1,88 @@
+package main
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+// This example embeds wfx in a custom binary: it composes the storage, the wfx
+// API and the server collection itself and extends the northbound API with an
+// in-process plugin and the southbound API with a custom middleware.
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/siemens/wfx/api"
+	"github.com/siemens/wfx/cmd/wfx/cmd/config"
+	"github.com/siemens/wfx/middleware/plugin"
+	"github.com/siemens/wfx/server"
+)
+
+func main() {
+	flags := config.NewFlagset()
+	if err := flags.Parse(os.Args[1:]); err != nil {
+		log.Fatalln("[ERROR] Failed to parse flags:", err)
+	}
+	cfg, err := config.NewAppConfig(flags)
+	if err != nil {
+		log.Fatalln("[ERROR] Failed to load config:", err)
+	}
+	defer cfg.Stop()
+
+	storage, err := cfg.InitStorage()
+	if err != nil {
+		log.Fatalln("[ERROR] Failed to initialize storage:", err)
+	}
+	defer storage.Shutdown()
+
+	wfx := api.NewWfxServer(storage)
+
+	// this is just an example; prevent access to /workflows
+	denyWorkflows := plugin.NewGoPlugin("deny-workflows", plugin.HandlerFunc(
+		func(_ context.Context, req *plugin.Request) (*plugin.Verdict, error) {
+			if strings.HasPrefix(req.HTTP.URL.Path, "/api/wfx/v1/workflows") {
+				return plugin.Reply(plugin.ReplyDeny, nil, []byte("You are not allowed to access the workflows resource.\n")), nil
+			}
+			return nil, nil
+		},
+	))
+
+	// identify the server to clients
+	serverHeader := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Server", "embedded-wfx")
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	collection, err := server.NewServerCollection(cfg, wfx, storage,
+		server.WithNorthPlugins(denyWorkflows),
+		server.WithSouthMiddlewares(serverHeader))
+	if err != nil {
+		log.Fatalln("[ERROR] Failed to create servers:", err)
+	}
+	wfx.WithHealthChecks(collection.HealthChecks()...)
+	wfx.Start()
+	defer wfx.Stop()
+
+	go func() {
+		chSignal := make(chan os.Signal, 1)
+		signal.Notify(chSignal, os.Interrupt, syscall.SIGTERM)
+		<-chSignal
+		collection.Stop()
+	}()
+
+	log.Println("[INFO] Starting embedded wfx")
+	if err := collection.Start(); err != nil {
+		log.Println("[ERROR] Server collection failed:", err)
+	}
+	collection.Stop()
+}
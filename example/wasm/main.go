@@ -0,0 +1,77 @@
+//go:build wasip1
+
+package main
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+// This is the WebAssembly counterpart of example/plugin. Build it with
+//
+//	GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o plugin.wasm
+//
+// and place plugin.wasm in the plugin directory of wfx.
+
+import (
+	"log"
+	"os"
+	"strings"
+	"unsafe"
+
+	"github.com/siemens/wfx/generated/plugin"
+	"github.com/siemens/wfx/generated/plugin/client"
+	"github.com/siemens/wfx/middleware/plugin/ioutil"
+)
+
+var (
+	// request is the buffer wfx writes the request to
+	request []byte
+	// response must stay alive until wfx has read it
+	response []byte
+)
+
+func main() {}
+
+//go:wasmexport wfx_alloc
+func alloc(size uint32) uint32 {
+	request = make([]byte, size)
+	return uint32(uintptr(unsafe.Pointer(unsafe.SliceData(request))))
+}
+
+//go:wasmexport wfx_handle
+func handle(_ uint32, size uint32) uint64 {
+	log.SetOutput(os.Stderr)
+	req, err := ioutil.UnmarshalRequest(request[:size])
+	if err != nil {
+		log.Println("[ERROR] Failed to parse request", err)
+		return 0
+	}
+
+	destination := req.Request.Destination
+	log.Printf("[DEBUG] Processing request: cookie=%d, destination=%s", req.Cookie, destination)
+
+	// this just an example; prevent access to /workflows
+	if !strings.Contains(destination, "/api/wfx/v1/workflows") {
+		log.Println("[DEBUG] Allowing request")
+		// an empty response leaves the request unchanged
+		return 0
+	}
+
+	log.Println("[DEBUG] Denying request")
+	response = ioutil.MarshalResponse(&plugin.PluginResponseT{
+		Cookie: req.Cookie,
+		Payload: &plugin.PayloadT{
+			Type: plugin.Payloadgenerated_plugin_client_Response,
+			Value: &client.ResponseT{
+				Status:  client.ResponseStatusDeny,
+				Content: []byte("You are not allowed to access the workflows resource.\n"),
+			},
+		},
+	})
+	ptr := uint64(uintptr(unsafe.Pointer(unsafe.SliceData(response))))
+	return ptr<<32 | uint64(len(response))
+}
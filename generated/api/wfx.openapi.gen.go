@@ -111,6 +111,90 @@ func (e JobEventAction) Valid() bool {
 	}
 }
 
+// Defines values for JobEventsCommandType.
+const (
+	Subscribe   JobEventsCommandType = "subscribe"
+	Unsubscribe JobEventsCommandType = "unsubscribe"
+)
+
+// Valid indicates whether the value is a known member of the JobEventsCommandType enum.
+func (e JobEventsCommandType) Valid() bool {
+	switch e {
+	case Subscribe:
+		return true
+	case Unsubscribe:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for JobEventsMessageType.
+const (
+	JobEventsMessageTypeError        JobEventsMessageType = "error"
+	JobEventsMessageTypeEvent        JobEventsMessageType = "event"
+	JobEventsMessageTypeSubscription JobEventsMessageType = "subscription"
+)
+
+// Valid indicates whether the value is a known member of the JobEventsMessageType enum.
+func (e JobEventsMessageType) Valid() bool {
+	switch e {
+	case JobEventsMessageTypeError:
+		return true
+	case JobEventsMessageTypeEvent:
+		return true
+	case JobEventsMessageTypeSubscription:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for JobStatsProperty.
+const (
+	JobStatsPropertyClientId JobStatsProperty = "clientId"
+	JobStatsPropertyGroup    JobStatsProperty = "group"
+	JobStatsPropertyState    JobStatsProperty = "state"
+	JobStatsPropertyTag      JobStatsProperty = "tag"
+	JobStatsPropertyWorkflow JobStatsProperty = "workflow"
+)
+
+// Valid indicates whether the value is a known member of the JobStatsProperty enum.
+func (e JobStatsProperty) Valid() bool {
+	switch e {
+	case JobStatsPropertyClientId:
+		return true
+	case JobStatsPropertyGroup:
+		return true
+	case JobStatsPropertyState:
+		return true
+	case JobStatsPropertyTag:
+		return true
+	case JobStatsPropertyWorkflow:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for JobStatsTimeField.
+const (
+	Mtime JobStatsTimeField = "mtime"
+	Stime JobStatsTimeField = "stime"
+)
+
+// Valid indicates whether the value is a known member of the JobStatsTimeField enum.
+func (e JobStatsTimeField) Valid() bool {
+	switch e {
+	case Mtime:
+		return true
+	case Stime:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SortEnum.
 const (
 	Asc  SortEnum = "asc"
@@ -236,6 +320,49 @@ type JobEvent struct {
 // JobEventAction defines model for JobEventAction.
 type JobEventAction string
 
+// JobEventsCommand Changes the subscription of a WebSocket job events connection
+type JobEventsCommand struct {
+	// All Whether to subscribe to (or unsubscribe from) all events
+	All       *bool     `json:"all,omitempty"`
+	ClientIDs *[]string `json:"clientIds,omitempty"`
+	JobIds    *[]string `json:"jobIds,omitempty"`
+
+	// Type Whether to add the filters to or remove them from the subscription
+	Type      JobEventsCommandType `json:"type"`
+	Workflows *[]string            `json:"workflows,omitempty"`
+}
+
+// JobEventsCommandType Whether to add the filters to or remove them from the subscription
+type JobEventsCommandType string
+
+// JobEventsMessage A message sent by the server over a WebSocket job events connection
+type JobEventsMessage struct {
+	Error *string   `json:"error,omitempty"`
+	Event *JobEvent `json:"event,omitempty"`
+
+	// Subscription Filters of a job events subscription
+	Subscription *JobEventsSubscription `json:"subscription,omitempty"`
+
+	// Type event: a job event matching the subscription;
+	// subscription: acknowledges a command, contains the resulting subscription;
+	// error: the command was rejected, the subscription is unchanged
+	Type JobEventsMessageType `json:"type"`
+}
+
+// JobEventsMessageType event: a job event matching the subscription;
+// subscription: acknowledges a command, contains the resulting subscription;
+// error: the command was rejected, the subscription is unchanged
+type JobEventsMessageType string
+
+// JobEventsSubscription Filters of a job events subscription
+type JobEventsSubscription struct {
+	// All Whether all events are received, regardless of the other filters
+	All       *bool     `json:"all,omitempty"`
+	ClientIDs *[]string `json:"clientIds,omitempty"`
+	JobIds    *[]string `json:"jobIds,omitempty"`
+	Workflows *[]string `json:"workflows,omitempty"`
+}
+
 // JobRequest defines model for JobRequest.
 type JobRequest struct {
 	// ClientID Create job for the given client ID
@@ -249,6 +376,42 @@ type JobRequest struct {
 	Workflow string `json:"workflow"`
 }
 
+// JobStats Job counts grouped by the requested properties
+type JobStats struct {
+	Content []JobStatsEntry `json:"content"`
+}
+
+// JobStatsEntry defines model for JobStatsEntry.
+type JobStatsEntry struct {
+	// Bucket Start of the time interval (only present if bucketed)
+	Bucket *time.Time `json:"bucket,omitempty"`
+
+	// ClientID Client ID (only present if grouped by clientId)
+	ClientID *string `json:"clientId,omitempty"`
+
+	// Count Number of jobs
+	Count int64 `json:"count"`
+
+	// Group Group of the current state (only present if grouped by group)
+	Group *string `json:"group,omitempty"`
+
+	// State Current state (only present if grouped by state)
+	State *string `json:"state,omitempty"`
+
+	// Tag Tag (only present if grouped by tag). A job with multiple tags is counted once per tag,
+	// a job without tags is counted with an empty tag.
+	Tag *string `json:"tag,omitempty"`
+
+	// Workflow Workflow name (only present if grouped by workflow)
+	Workflow *string `json:"workflow,omitempty"`
+}
+
+// JobStatsProperty defines model for JobStatsProperty.
+type JobStatsProperty string
+
+// JobStatsTimeField defines model for JobStatsTimeField.
+type JobStatsTimeField string
+
 // JobStatus Job status information
 type JobStatus struct {
 	// ClientID Client which sent the status update
@@ -303,6 +466,22 @@ type State struct {
 	Name        string `json:"name"`
 }
 
+// StateStats defines model for StateStats.
+type StateStats struct {
+	// Count The number of times the state was left
+	Count int64 `json:"count"`
+
+	// P50 Median dwell time in seconds
+	P50 float64 `json:"p50"`
+
+	// P95 95th percentile of the dwell time in seconds
+	P95 float64 `json:"p95"`
+
+	// P99 99th percentile of the dwell time in seconds
+	P99   float64 `json:"p99"`
+	State string  `json:"state"`
+}
+
 // TagList defines model for TagList.
 type TagList = []string
 
@@ -315,6 +494,14 @@ type Transition struct {
 	To          string       `json:"to"`
 }
 
+// TransitionStats defines model for TransitionStats.
+type TransitionStats struct {
+	// Count The number of times the transition was taken
+	Count int64  `json:"count"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
 // Workflow defines model for Workflow.
 type Workflow struct {
 	// Description Description of the workflow
@@ -327,6 +514,21 @@ type Workflow struct {
 	Transitions []Transition `json:"transitions,omitempty"`
 }
 
+// WorkflowStats defines model for WorkflowStats.
+type WorkflowStats struct {
+	// Jobs The number of jobs which contributed to the statistics
+	Jobs int64 `json:"jobs"`
+
+	// States Dwell times per state
+	States []StateStats `json:"states"`
+
+	// Transitions Number of transitions between states
+	Transitions []TransitionStats `json:"transitions"`
+
+	// Workflow The name of the workflow
+	Workflow string `json:"workflow"`
+}
+
 // paramClientID defines model for clientId.
 type paramClientID = string
 
@@ -336,6 +538,9 @@ type paramGroup = []string
 // paramHistory defines model for history.
 type paramHistory = bool
 
+// paramJobID defines model for jobId.
+type paramJobID = string
+
 // paramLimit defines model for limit.
 type paramLimit = int32
 
@@ -357,6 +562,9 @@ type paramState = string
 // paramTag defines model for tag.
 type paramTag = TagList
 
+// paramWorkflow defines model for workflow.
+type paramWorkflow = string
+
 // GetJobsParams defines parameters for GetJobs.
 type GetJobsParams struct {
 	// ParamLimit the maximum number of items to return
@@ -414,6 +622,54 @@ type GetJobsEventsParams struct {
 	Tags *string `form:"tags,omitempty" json:"tags,omitempty"`
 }
 
+// GetJobsEventsWsParams defines parameters for GetJobsEventsWs.
+type GetJobsEventsWsParams struct {
+	// ClientIDs Subscribe to events whose clientID matches one of the given clientIds (comma-separated). This is a filter.
+	ClientIDs *string `form:"clientIds,omitempty" json:"clientIds,omitempty"`
+
+	// JobIds Subscribe to events whose job ID is one of the given jobIds (comma-separated). This is a filter.
+	JobIds *string `form:"jobIds,omitempty" json:"jobIds,omitempty"`
+
+	// Workflows Subscribe to events whose workflow name is one of the given workflow names (comma-separated). This is a filter.
+	Workflows *string `form:"workflows,omitempty" json:"workflows,omitempty"`
+
+	// Actions Subscribe to events whose job event action is one of the given actions (comma-separated, case-insenstive). This is a filter.
+	Actions *string `form:"actions,omitempty" json:"actions,omitempty"`
+
+	// Tags A (comma-separated) list of tags to apply to each job event. This can be used to aggregrate events from multiple wfx instances.
+	Tags *string `form:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// GetJobsStatsParams defines parameters for GetJobsStats.
+type GetJobsStatsParams struct {
+	// ParamState Filter jobs based on the current state value
+	ParamState *paramState `form:"state,omitempty" json:"state,omitempty"`
+
+	// ParamGroup Filter jobs based on the group they are in
+	ParamGroup *paramGroup `form:"group,omitempty" json:"group,omitempty"`
+
+	// ParamClientID Filter jobs belonging to a specific client with clientId
+	ParamClientID *paramClientID `form:"clientId,omitempty" json:"clientId,omitempty"`
+
+	// ParamTag A list of tags
+	ParamTag *paramTag `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// ParamWorkflow Filter jobs matching by workflow
+	ParamWorkflow *paramWorkflow `form:"workflow,omitempty" json:"workflow,omitempty"`
+
+	// ParamGroupBy The properties to group the jobs by
+	ParamGroupBy *[]JobStatsProperty `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// ParamBucket Group jobs into time intervals based on the given timestamp
+	ParamBucket *JobStatsTimeField `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// ParamInterval The length of a time interval in seconds (only used in combination with `bucket`)
+	ParamInterval *int64 `form:"interval,omitempty" json:"interval,omitempty"`
+
+	// XResponseFilter Apply a jq-like filter to the response
+	XResponseFilter *ResponseFilter `json:"X-Response-Filter,omitempty"`
+}
+
 // GetJobsIdParams defines parameters for GetJobsId.
 type GetJobsIdParams struct {
 	// ParamHistory Boolean flag to include the transition history of the job
@@ -504,6 +760,30 @@ type GetWorkflowsNameParams struct {
 	XResponseFilter *ResponseFilter `json:"X-Response-Filter,omitempty"`
 }
 
+// PutWorkflowsNameParams defines parameters for PutWorkflowsName.
+type PutWorkflowsNameParams struct {
+	// ParamForce Replace the workflow even if there are jobs in a non-final state
+	ParamForce *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// XResponseFilter Apply a jq-like filter to the response
+	XResponseFilter *ResponseFilter `json:"X-Response-Filter,omitempty"`
+}
+
+// GetWorkflowsNameStatsParams defines parameters for GetWorkflowsNameStats.
+type GetWorkflowsNameStatsParams struct {
+	// ParamTag A list of tags
+	ParamTag *paramTag `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// ParamSince Only consider transitions at or after the given point in time (ISO8601)
+	ParamSince *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// ParamUntil Only consider transitions before the given point in time (ISO8601)
+	ParamUntil *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// XResponseFilter Apply a jq-like filter to the response
+	XResponseFilter *ResponseFilter `json:"X-Response-Filter,omitempty"`
+}
+
 // PostJobsJSONRequestBody defines body for PostJobs for application/json ContentType.
 type PostJobsJSONRequestBody = JobRequest
 
@@ -522,6 +802,9 @@ type PostJobsIdTagsJSONRequestBody = PostJobsIdTagsJSONBody
 // PostWorkflowsJSONRequestBody defines body for PostWorkflows for application/json ContentType.
 type PostWorkflowsJSONRequestBody = Workflow
 
+// PutWorkflowsNameJSONRequestBody defines body for PutWorkflowsName for application/json ContentType.
+type PutWorkflowsNameJSONRequestBody = Workflow
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -609,6 +892,12 @@ type ClientInterface interface {
 	// GetJobsEvents request
 	GetJobsEvents(ctx context.Context, params *GetJobsEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetJobsEventsWs request
+	GetJobsEventsWs(ctx context.Context, params *GetJobsEventsWsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJobsStats request
+	GetJobsStats(ctx context.Context, params *GetJobsStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteJobsId request
 	DeleteJobsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -660,6 +949,14 @@ type ClientInterface interface {
 
 	// GetWorkflowsName request
 	GetWorkflowsName(ctx context.Context, name string, params *GetWorkflowsNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutWorkflowsNameWithBody request with any body
+	PutWorkflowsNameWithBody(ctx context.Context, name string, params *PutWorkflowsNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutWorkflowsName(ctx context.Context, name string, params *PutWorkflowsNameParams, body PutWorkflowsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkflowsNameStats request
+	GetWorkflowsNameStats(ctx context.Context, name string, params *GetWorkflowsNameStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -722,6 +1019,30 @@ func (c *Client) GetJobsEvents(ctx context.Context, params *GetJobsEventsParams,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetJobsEventsWs(ctx context.Context, params *GetJobsEventsWsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobsEventsWsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetJobsStats(ctx context.Context, params *GetJobsStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobsStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteJobsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteJobsIdRequest(c.Server, id)
 	if err != nil {
@@ -950,6 +1271,42 @@ func (c *Client) GetWorkflowsName(ctx context.Context, name string, params *GetW
 	return c.Client.Do(req)
 }
 
+func (c *Client) PutWorkflowsNameWithBody(ctx context.Context, name string, params *PutWorkflowsNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWorkflowsNameRequestWithBody(c.Server, name, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutWorkflowsName(ctx context.Context, name string, params *PutWorkflowsNameParams, body PutWorkflowsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWorkflowsNameRequest(c.Server, name, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkflowsNameStats(ctx context.Context, name string, params *GetWorkflowsNameStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkflowsNameStatsRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetHealthRequest generates requests for GetHealth
 func NewGetHealthRequest(server string) (*http.Request, error) {
 	var err error
@@ -1299,57 +1656,16 @@ func NewGetJobsEventsRequest(server string, params *GetJobsEventsParams) (*http.
 	return req, nil
 }
 
-// NewDeleteJobsIdRequest generates requests for DeleteJobsId
-func NewDeleteJobsIdRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetJobsIdRequest generates requests for GetJobsId
-func NewGetJobsIdRequest(server string, id string, params *GetJobsIdParams) (*http.Request, error) {
+// NewGetJobsEventsWsRequest generates requests for GetJobsEventsWs
+func NewGetJobsEventsWsRequest(server string, params *GetJobsEventsWsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/jobs/events/ws")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1368,9 +1684,9 @@ func NewGetJobsIdRequest(server string, id string, params *GetJobsIdParams) (*ht
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.ParamHistory != nil {
+		if params.ClientIDs != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "history", *params.ParamHistory, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "clientIds", *params.ClientIDs, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -1380,13 +1696,309 @@ func NewGetJobsIdRequest(server string, id string, params *GetJobsIdParams) (*ht
 
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
+		if params.JobIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "jobIds", *params.JobIds, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
 		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+		if params.Workflows != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "workflows", *params.Workflows, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Actions != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "actions", *params.Actions, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Tags != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tags", *params.Tags, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJobsStatsRequest generates requests for GetJobsStats
+func NewGetJobsStatsRequest(server string, params *GetJobsStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.ParamState != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "state", *params.ParamState, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamGroup != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", false, "group", *params.ParamGroup, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamClientID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "clientId", *params.ParamClientID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamTag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", false, "tag", *params.ParamTag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamWorkflow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "workflow", *params.ParamWorkflow, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamGroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", false, "groupBy", *params.ParamGroupBy, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamBucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "bucket", *params.ParamBucket, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamInterval != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "interval", *params.ParamInterval, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XResponseFilter != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "X-Response-Filter", *params.XResponseFilter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Response-Filter", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteJobsIdRequest generates requests for DeleteJobsId
+func NewDeleteJobsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJobsIdRequest generates requests for GetJobsId
+func NewGetJobsIdRequest(server string, id string, params *GetJobsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.ParamHistory != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "history", *params.ParamHistory, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2074,6 +2686,195 @@ func NewGetWorkflowsNameRequest(server string, name string, params *GetWorkflows
 	return req, nil
 }
 
+// NewPutWorkflowsNameRequest calls the generic PutWorkflowsName builder with application/json body
+func NewPutWorkflowsNameRequest(server string, name string, params *PutWorkflowsNameParams, body PutWorkflowsNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutWorkflowsNameRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPutWorkflowsNameRequestWithBody generates requests for PutWorkflowsName with any type of body
+func NewPutWorkflowsNameRequestWithBody(server string, name string, params *PutWorkflowsNameParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.ParamForce != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "force", *params.ParamForce, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XResponseFilter != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "X-Response-Filter", *params.XResponseFilter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Response-Filter", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetWorkflowsNameStatsRequest generates requests for GetWorkflowsNameStats
+func NewGetWorkflowsNameStatsRequest(server string, name string, params *GetWorkflowsNameStatsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workflows/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.ParamTag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", false, "tag", *params.ParamTag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamSince != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "since", *params.ParamSince, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date-time"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParamUntil != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "until", *params.ParamUntil, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date-time"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XResponseFilter != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "X-Response-Filter", *params.XResponseFilter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Response-Filter", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -2131,6 +2932,12 @@ type ClientWithResponsesInterface interface {
 	// GetJobsEventsWithResponse request
 	GetJobsEventsWithResponse(ctx context.Context, params *GetJobsEventsParams, reqEditors ...RequestEditorFn) (*GetJobsEventsResponse, error)
 
+	// GetJobsEventsWsWithResponse request
+	GetJobsEventsWsWithResponse(ctx context.Context, params *GetJobsEventsWsParams, reqEditors ...RequestEditorFn) (*GetJobsEventsWsResponse, error)
+
+	// GetJobsStatsWithResponse request
+	GetJobsStatsWithResponse(ctx context.Context, params *GetJobsStatsParams, reqEditors ...RequestEditorFn) (*GetJobsStatsResponse, error)
+
 	// DeleteJobsIdWithResponse request
 	DeleteJobsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteJobsIdResponse, error)
 
@@ -2182,6 +2989,14 @@ type ClientWithResponsesInterface interface {
 
 	// GetWorkflowsNameWithResponse request
 	GetWorkflowsNameWithResponse(ctx context.Context, name string, params *GetWorkflowsNameParams, reqEditors ...RequestEditorFn) (*GetWorkflowsNameResponse, error)
+
+	// PutWorkflowsNameWithBodyWithResponse request with any body
+	PutWorkflowsNameWithBodyWithResponse(ctx context.Context, name string, params *PutWorkflowsNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWorkflowsNameResponse, error)
+
+	PutWorkflowsNameWithResponse(ctx context.Context, name string, params *PutWorkflowsNameParams, body PutWorkflowsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWorkflowsNameResponse, error)
+
+	// GetWorkflowsNameStatsWithResponse request
+	GetWorkflowsNameStatsWithResponse(ctx context.Context, name string, params *GetWorkflowsNameStatsParams, reqEditors ...RequestEditorFn) (*GetWorkflowsNameStatsResponse, error)
 }
 
 type GetHealthResponse struct {
@@ -2309,6 +3124,68 @@ func (r GetJobsEventsResponse) ContentType() string {
 	return ""
 }
 
+type GetJobsEventsWsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsEventsWsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsEventsWsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetJobsEventsWsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetJobsStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobStats
+	JSON400      *ErrorResponse
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetJobsStatsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type DeleteJobsIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2749,6 +3626,72 @@ func (r GetWorkflowsNameResponse) ContentType() string {
 	return ""
 }
 
+type PutWorkflowsNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Workflow
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutWorkflowsNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutWorkflowsNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutWorkflowsNameResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetWorkflowsNameStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowStats
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkflowsNameStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkflowsNameStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetWorkflowsNameStatsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 // GetHealthWithResponse request returning *GetHealthResponse
 func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
 	rsp, err := c.GetHealth(ctx, reqEditors...)
@@ -2793,6 +3736,24 @@ func (c *ClientWithResponses) GetJobsEventsWithResponse(ctx context.Context, par
 	return ParseGetJobsEventsResponse(rsp)
 }
 
+// GetJobsEventsWsWithResponse request returning *GetJobsEventsWsResponse
+func (c *ClientWithResponses) GetJobsEventsWsWithResponse(ctx context.Context, params *GetJobsEventsWsParams, reqEditors ...RequestEditorFn) (*GetJobsEventsWsResponse, error) {
+	rsp, err := c.GetJobsEventsWs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsEventsWsResponse(rsp)
+}
+
+// GetJobsStatsWithResponse request returning *GetJobsStatsResponse
+func (c *ClientWithResponses) GetJobsStatsWithResponse(ctx context.Context, params *GetJobsStatsParams, reqEditors ...RequestEditorFn) (*GetJobsStatsResponse, error) {
+	rsp, err := c.GetJobsStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsStatsResponse(rsp)
+}
+
 // DeleteJobsIdWithResponse request returning *DeleteJobsIdResponse
 func (c *ClientWithResponses) DeleteJobsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteJobsIdResponse, error) {
 	rsp, err := c.DeleteJobsId(ctx, id, reqEditors...)
@@ -2959,6 +3920,32 @@ func (c *ClientWithResponses) GetWorkflowsNameWithResponse(ctx context.Context,
 	return ParseGetWorkflowsNameResponse(rsp)
 }
 
+// PutWorkflowsNameWithBodyWithResponse request with arbitrary body returning *PutWorkflowsNameResponse
+func (c *ClientWithResponses) PutWorkflowsNameWithBodyWithResponse(ctx context.Context, name string, params *PutWorkflowsNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWorkflowsNameResponse, error) {
+	rsp, err := c.PutWorkflowsNameWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWorkflowsNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutWorkflowsNameWithResponse(ctx context.Context, name string, params *PutWorkflowsNameParams, body PutWorkflowsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWorkflowsNameResponse, error) {
+	rsp, err := c.PutWorkflowsName(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWorkflowsNameResponse(rsp)
+}
+
+// GetWorkflowsNameStatsWithResponse request returning *GetWorkflowsNameStatsResponse
+func (c *ClientWithResponses) GetWorkflowsNameStatsWithResponse(ctx context.Context, name string, params *GetWorkflowsNameStatsParams, reqEditors ...RequestEditorFn) (*GetWorkflowsNameStatsResponse, error) {
+	rsp, err := c.GetWorkflowsNameStats(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkflowsNameStatsResponse(rsp)
+}
+
 // ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
 func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3098,6 +4085,72 @@ func ParseGetJobsEventsResponse(rsp *http.Response) (*GetJobsEventsResponse, err
 	return response, nil
 }
 
+// ParseGetJobsEventsWsResponse parses an HTTP response from a GetJobsEventsWsWithResponse call
+func ParseGetJobsEventsWsResponse(rsp *http.Response) (*GetJobsEventsWsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJobsEventsWsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJobsStatsResponse parses an HTTP response from a GetJobsStatsWithResponse call
+func ParseGetJobsStatsResponse(rsp *http.Response) (*GetJobsStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJobsStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDeleteJobsIdResponse parses an HTTP response from a DeleteJobsIdWithResponse call
 func ParseDeleteJobsIdResponse(rsp *http.Response) (*DeleteJobsIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3461,44 +4514,103 @@ func ParseGetWorkflowsResponse(rsp *http.Response) (*GetWorkflowsResponse, error
 		return nil, err
 	}
 
-	response := &GetWorkflowsResponse{
+	response := &GetWorkflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaginatedWorkflowList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWorkflowsResponse parses an HTTP response from a PostWorkflowsWithResponse call
+func ParsePostWorkflowsResponse(rsp *http.Response) (*PostWorkflowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWorkflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Workflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWorkflowsNameResponse parses an HTTP response from a DeleteWorkflowsNameWithResponse call
+func ParseDeleteWorkflowsNameResponse(rsp *http.Response) (*DeleteWorkflowsNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWorkflowsNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PaginatedWorkflowList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostWorkflowsResponse parses an HTTP response from a PostWorkflowsWithResponse call
-func ParsePostWorkflowsResponse(rsp *http.Response) (*PostWorkflowsResponse, error) {
+// ParseGetWorkflowsNameResponse parses an HTTP response from a GetWorkflowsNameWithResponse call
+func ParseGetWorkflowsNameResponse(rsp *http.Response) (*GetWorkflowsNameResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostWorkflowsResponse{
+	response := &GetWorkflowsNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest Workflow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -3507,25 +4619,46 @@ func ParsePostWorkflowsResponse(rsp *http.Response) (*PostWorkflowsResponse, err
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteWorkflowsNameResponse parses an HTTP response from a DeleteWorkflowsNameWithResponse call
-func ParseDeleteWorkflowsNameResponse(rsp *http.Response) (*DeleteWorkflowsNameResponse, error) {
+// ParsePutWorkflowsNameResponse parses an HTTP response from a PutWorkflowsNameWithResponse call
+func ParsePutWorkflowsNameResponse(rsp *http.Response) (*PutWorkflowsNameResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteWorkflowsNameResponse{
+	response := &PutWorkflowsNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Workflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3533,27 +4666,34 @@ func ParseDeleteWorkflowsNameResponse(rsp *http.Response) (*DeleteWorkflowsNameR
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetWorkflowsNameResponse parses an HTTP response from a GetWorkflowsNameWithResponse call
-func ParseGetWorkflowsNameResponse(rsp *http.Response) (*GetWorkflowsNameResponse, error) {
+// ParseGetWorkflowsNameStatsResponse parses an HTTP response from a GetWorkflowsNameStatsWithResponse call
+func ParseGetWorkflowsNameStatsResponse(rsp *http.Response) (*GetWorkflowsNameStatsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkflowsNameResponse{
+	response := &GetWorkflowsNameStatsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Workflow
+		var dest WorkflowStats
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3573,6 +4713,13 @@ func ParseGetWorkflowsNameResponse(rsp *http.Response) (*GetWorkflowsNameRespons
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
@@ -3592,6 +4739,12 @@ type ServerInterface interface {
 	// Subscribe to job-related events such as status updates
 	// (GET /jobs/events)
 	GetJobsEvents(w http.ResponseWriter, r *http.Request, params GetJobsEventsParams)
+	// Subscribe to job-related events using a WebSocket
+	// (GET /jobs/events/ws)
+	GetJobsEventsWs(w http.ResponseWriter, r *http.Request, params GetJobsEventsWsParams)
+	// Count jobs grouped by their properties
+	// (GET /jobs/stats)
+	GetJobsStats(w http.ResponseWriter, r *http.Request, params GetJobsStatsParams)
 	// Delete a specific job
 	// (DELETE /jobs/{id})
 	DeleteJobsId(w http.ResponseWriter, r *http.Request, id string)
@@ -3634,6 +4787,12 @@ type ServerInterface interface {
 	// Get specific workflow's details
 	// (GET /workflows/{name})
 	GetWorkflowsName(w http.ResponseWriter, r *http.Request, name string, params GetWorkflowsNameParams)
+	// Replace a workflow
+	// (PUT /workflows/{name})
+	PutWorkflowsName(w http.ResponseWriter, r *http.Request, name string, params PutWorkflowsNameParams)
+	// Analyze the time jobs spend in the states of a workflow
+	// (GET /workflows/{name}/stats)
+	GetWorkflowsNameStats(w http.ResponseWriter, r *http.Request, name string, params GetWorkflowsNameStatsParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -3855,85 +5014,315 @@ func (siw *ServerInterfaceWrapper) PostJobs(w http.ResponseWriter, r *http.Reque
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// GetJobsEvents operation middleware
-func (siw *ServerInterfaceWrapper) GetJobsEvents(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-	_ = err
+	handler.ServeHTTP(w, r)
+}
+
+// GetJobsEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetJobsEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobsEventsParams
+
+	// ------------- Optional query parameter "clientIds" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "clientIds", r.URL.Query(), &params.ClientIDs, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "clientIds"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientIds", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "jobIds" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "jobIds", r.URL.Query(), &params.JobIds, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobIds"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobIds", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "workflows", r.URL.Query(), &params.Workflows, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "workflows"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflows", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "actions" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "actions", r.URL.Query(), &params.Actions, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "actions"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actions", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tags", r.URL.Query(), &params.Tags, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tags"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJobsEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetJobsEventsWs operation middleware
+func (siw *ServerInterfaceWrapper) GetJobsEventsWs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobsEventsWsParams
+
+	// ------------- Optional query parameter "clientIds" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "clientIds", r.URL.Query(), &params.ClientIDs, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "clientIds"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientIds", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "jobIds" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "jobIds", r.URL.Query(), &params.JobIds, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobIds"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobIds", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "workflows", r.URL.Query(), &params.Workflows, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "workflows"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflows", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "actions" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "actions", r.URL.Query(), &params.Actions, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "actions"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actions", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tags", r.URL.Query(), &params.Tags, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tags"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJobsEventsWs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetJobsStats operation middleware
+func (siw *ServerInterfaceWrapper) GetJobsStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobsStatsParams
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "state", r.URL.Query(), &params.ParamState, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "group" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "group", r.URL.Query(), &params.ParamGroup, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "clientId" -------------
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetJobsEventsParams
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "clientId", r.URL.Query(), &params.ParamClientID, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "clientId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientId", Err: err})
+		}
+		return
+	}
 
-	// ------------- Optional query parameter "clientIds" -------------
+	// ------------- Optional query parameter "tag" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "clientIds", r.URL.Query(), &params.ClientIDs, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tag", r.URL.Query(), &params.ParamTag, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "clientIds"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tag"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientIds", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
 		}
 		return
 	}
 
-	// ------------- Optional query parameter "jobIds" -------------
+	// ------------- Optional query parameter "workflow" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "jobIds", r.URL.Query(), &params.JobIds, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "workflow", r.URL.Query(), &params.ParamWorkflow, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobIds"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "workflow"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobIds", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow", Err: err})
 		}
 		return
 	}
 
-	// ------------- Optional query parameter "workflows" -------------
+	// ------------- Optional query parameter "groupBy" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "workflows", r.URL.Query(), &params.Workflows, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "groupBy", r.URL.Query(), &params.ParamGroupBy, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "workflows"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "groupBy"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflows", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
 		}
 		return
 	}
 
-	// ------------- Optional query parameter "actions" -------------
+	// ------------- Optional query parameter "bucket" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "actions", r.URL.Query(), &params.Actions, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "bucket", r.URL.Query(), &params.ParamBucket, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "actions"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "bucket"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actions", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bucket", Err: err})
 		}
 		return
 	}
 
-	// ------------- Optional query parameter "tags" -------------
+	// ------------- Optional query parameter "interval" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "tags", r.URL.Query(), &params.Tags, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "interval", r.URL.Query(), &params.ParamInterval, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tags"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "interval"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interval", Err: err})
 		}
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Response-Filter" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Response-Filter")]; found {
+		var XResponseFilter ResponseFilter
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Response-Filter", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Response-Filter", valueList[0], &XResponseFilter, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Response-Filter", Err: err})
+			return
+		}
+
+		params.XResponseFilter = &XResponseFilter
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetJobsEvents(w, r, params)
+		siw.Handler.GetJobsStats(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4606,6 +5995,158 @@ func (siw *ServerInterfaceWrapper) GetWorkflowsName(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
+// PutWorkflowsName operation middleware
+func (siw *ServerInterfaceWrapper) PutWorkflowsName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutWorkflowsNameParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "force", r.URL.Query(), &params.ParamForce, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "force"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		}
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Response-Filter" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Response-Filter")]; found {
+		var XResponseFilter ResponseFilter
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Response-Filter", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Response-Filter", valueList[0], &XResponseFilter, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Response-Filter", Err: err})
+			return
+		}
+
+		params.XResponseFilter = &XResponseFilter
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutWorkflowsName(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkflowsNameStats operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowsNameStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkflowsNameStatsParams
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tag", r.URL.Query(), &params.ParamTag, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tag"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", r.URL.Query(), &params.ParamSince, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "until", r.URL.Query(), &params.ParamUntil, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "until"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		}
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Response-Filter" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Response-Filter")]; found {
+		var XResponseFilter ResponseFilter
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Response-Filter", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Response-Filter", valueList[0], &XResponseFilter, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Response-Filter", Err: err})
+			return
+		}
+
+		params.XResponseFilter = &XResponseFilter
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkflowsNameStats(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -4730,6 +6271,8 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/jobs", wrapper.GetJobs)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/jobs", wrapper.PostJobs)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/jobs/events", wrapper.GetJobsEvents)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/jobs/events/ws", wrapper.GetJobsEventsWs)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/jobs/stats", wrapper.GetJobsStats)
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/jobs/{id}", wrapper.DeleteJobsId)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/jobs/{id}", wrapper.GetJobsId)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/jobs/{id}/definition", wrapper.GetJobsIdDefinition)
@@ -4744,6 +6287,8 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/workflows", wrapper.PostWorkflows)
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/workflows/{name}", wrapper.DeleteWorkflowsName)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/workflows/{name}", wrapper.GetWorkflowsName)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/workflows/{name}", wrapper.PutWorkflowsName)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/workflows/{name}/stats", wrapper.GetWorkflowsNameStats)
 
 	return m
 }
@@ -4986,41 +6531,141 @@ func (response GetJobsEvents200TexteventStreamResponse) VisitGetJobsEventsRespon
 	}
 }
 
-type GetJobsEvents400JSONResponse ErrorResponse
+type GetJobsEvents400JSONResponse ErrorResponse
+
+func (response GetJobsEvents400JSONResponse) VisitGetJobsEventsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetJobsEvents404JSONResponse ErrorResponse
+
+func (response GetJobsEvents404JSONResponse) VisitGetJobsEventsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetJobsEventsdefaultResponse struct {
+	StatusCode int
+}
+
+func (response GetJobsEventsdefaultResponse) VisitGetJobsEventsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
+type GetJobsEventsWsRequestObject struct {
+	Params GetJobsEventsWsParams
+}
+
+type GetJobsEventsWsResponseObject interface {
+	VisitGetJobsEventsWsResponse(w http.ResponseWriter) error
+}
+
+type GetJobsEventsWs101Response struct {
+}
+
+func (response GetJobsEventsWs101Response) VisitGetJobsEventsWsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(101)
+	return nil
+}
+
+type GetJobsEventsWs400JSONResponse ErrorResponse
+
+func (response GetJobsEventsWs400JSONResponse) VisitGetJobsEventsWsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetJobsEventsWsdefaultResponse struct {
+	StatusCode int
+}
 
-func (response GetJobsEvents400JSONResponse) VisitGetJobsEventsResponse(w http.ResponseWriter) error {
+func (response GetJobsEventsWsdefaultResponse) VisitGetJobsEventsWsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
+type GetJobsStatsRequestObject struct {
+	Params GetJobsStatsParams
+}
+
+type GetJobsStatsResponseObject interface {
+	VisitGetJobsStatsResponse(w http.ResponseWriter) error
+}
+
+type GetJobsStats200JSONResponse JobStats
+
+func (response GetJobsStats200JSONResponse) VisitGetJobsStatsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetJobsEvents404JSONResponse ErrorResponse
+type GetJobsStats400JSONResponse ErrorResponse
 
-func (response GetJobsEvents404JSONResponse) VisitGetJobsEventsResponse(w http.ResponseWriter) error {
+func (response GetJobsStats400JSONResponse) VisitGetJobsStatsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetJobsEventsdefaultResponse struct {
+type GetJobsStats403Response struct {
+}
+
+func (response GetJobsStats403Response) VisitGetJobsStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetJobsStatsdefaultJSONResponse struct {
+	Body       ErrorResponse
 	StatusCode int
 }
 
-func (response GetJobsEventsdefaultResponse) VisitGetJobsEventsResponse(w http.ResponseWriter) error {
+func (response GetJobsStatsdefaultJSONResponse) VisitGetJobsStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
-	return nil
+	_, err := buf.WriteTo(w)
+	return err
 }
 
 type DeleteJobsIdRequestObject struct {
@@ -5754,6 +7399,165 @@ func (response GetWorkflowsNamedefaultResponse) VisitGetWorkflowsNameResponse(w
 	return nil
 }
 
+type PutWorkflowsNameRequestObject struct {
+	Name   string `json:"name"`
+	Params PutWorkflowsNameParams
+	Body   *PutWorkflowsNameJSONRequestBody
+}
+
+type PutWorkflowsNameResponseObject interface {
+	VisitPutWorkflowsNameResponse(w http.ResponseWriter) error
+}
+
+type PutWorkflowsName200JSONResponse Workflow
+
+func (response PutWorkflowsName200JSONResponse) VisitPutWorkflowsNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PutWorkflowsName400JSONResponse ErrorResponse
+
+func (response PutWorkflowsName400JSONResponse) VisitPutWorkflowsNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PutWorkflowsName403Response struct {
+}
+
+func (response PutWorkflowsName403Response) VisitPutWorkflowsNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PutWorkflowsName404JSONResponse ErrorResponse
+
+func (response PutWorkflowsName404JSONResponse) VisitPutWorkflowsNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PutWorkflowsName409JSONResponse ErrorResponse
+
+func (response PutWorkflowsName409JSONResponse) VisitPutWorkflowsNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PutWorkflowsNamedefaultResponse struct {
+	StatusCode int
+}
+
+func (response PutWorkflowsNamedefaultResponse) VisitPutWorkflowsNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
+type GetWorkflowsNameStatsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetWorkflowsNameStatsParams
+}
+
+type GetWorkflowsNameStatsResponseObject interface {
+	VisitGetWorkflowsNameStatsResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowsNameStats200JSONResponse WorkflowStats
+
+func (response GetWorkflowsNameStats200JSONResponse) VisitGetWorkflowsNameStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetWorkflowsNameStats400JSONResponse ErrorResponse
+
+func (response GetWorkflowsNameStats400JSONResponse) VisitGetWorkflowsNameStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetWorkflowsNameStats403Response struct {
+}
+
+func (response GetWorkflowsNameStats403Response) VisitGetWorkflowsNameStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetWorkflowsNameStats404JSONResponse ErrorResponse
+
+func (response GetWorkflowsNameStats404JSONResponse) VisitGetWorkflowsNameStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetWorkflowsNameStatsdefaultJSONResponse struct {
+	Body       ErrorResponse
+	StatusCode int
+}
+
+func (response GetWorkflowsNameStatsdefaultJSONResponse) VisitGetWorkflowsNameStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Query wfx's health status
@@ -5768,6 +7572,12 @@ type StrictServerInterface interface {
 	// Subscribe to job-related events such as status updates
 	// (GET /jobs/events)
 	GetJobsEvents(ctx context.Context, request GetJobsEventsRequestObject) (GetJobsEventsResponseObject, error)
+	// Subscribe to job-related events using a WebSocket
+	// (GET /jobs/events/ws)
+	GetJobsEventsWs(ctx context.Context, request GetJobsEventsWsRequestObject) (GetJobsEventsWsResponseObject, error)
+	// Count jobs grouped by their properties
+	// (GET /jobs/stats)
+	GetJobsStats(ctx context.Context, request GetJobsStatsRequestObject) (GetJobsStatsResponseObject, error)
 	// Delete a specific job
 	// (DELETE /jobs/{id})
 	DeleteJobsId(ctx context.Context, request DeleteJobsIdRequestObject) (DeleteJobsIdResponseObject, error)
@@ -5810,6 +7620,12 @@ type StrictServerInterface interface {
 	// Get specific workflow's details
 	// (GET /workflows/{name})
 	GetWorkflowsName(ctx context.Context, request GetWorkflowsNameRequestObject) (GetWorkflowsNameResponseObject, error)
+	// Replace a workflow
+	// (PUT /workflows/{name})
+	PutWorkflowsName(ctx context.Context, request PutWorkflowsNameRequestObject) (PutWorkflowsNameResponseObject, error)
+	// Analyze the time jobs spend in the states of a workflow
+	// (GET /workflows/{name}/stats)
+	GetWorkflowsNameStats(ctx context.Context, request GetWorkflowsNameStatsRequestObject) (GetWorkflowsNameStatsResponseObject, error)
 }
 
 type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error)
@@ -5950,6 +7766,58 @@ func (sh *strictHandler) GetJobsEvents(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
+// GetJobsEventsWs operation middleware
+func (sh *strictHandler) GetJobsEventsWs(w http.ResponseWriter, r *http.Request, params GetJobsEventsWsParams) {
+	var request GetJobsEventsWsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJobsEventsWs(ctx, request.(GetJobsEventsWsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJobsEventsWs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobsEventsWsResponseObject); ok {
+		if err := validResponse.VisitGetJobsEventsWsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetJobsStats operation middleware
+func (sh *strictHandler) GetJobsStats(w http.ResponseWriter, r *http.Request, params GetJobsStatsParams) {
+	var request GetJobsStatsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJobsStats(ctx, request.(GetJobsStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJobsStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobsStatsResponseObject); ok {
+		if err := validResponse.VisitGetJobsStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DeleteJobsId operation middleware
 func (sh *strictHandler) DeleteJobsId(w http.ResponseWriter, r *http.Request, id string) {
 	var request DeleteJobsIdRequestObject
@@ -6356,98 +8224,193 @@ func (sh *strictHandler) GetWorkflowsName(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// PutWorkflowsName operation middleware
+func (sh *strictHandler) PutWorkflowsName(w http.ResponseWriter, r *http.Request, name string, params PutWorkflowsNameParams) {
+	var request PutWorkflowsNameRequestObject
+
+	request.Name = name
+	request.Params = params
+
+	var body PutWorkflowsNameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutWorkflowsName(ctx, request.(PutWorkflowsNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutWorkflowsName")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutWorkflowsNameResponseObject); ok {
+		if err := validResponse.VisitPutWorkflowsNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWorkflowsNameStats operation middleware
+func (sh *strictHandler) GetWorkflowsNameStats(w http.ResponseWriter, r *http.Request, name string, params GetWorkflowsNameStatsParams) {
+	var request GetWorkflowsNameStatsRequestObject
+
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorkflowsNameStats(ctx, request.(GetWorkflowsNameStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorkflowsNameStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWorkflowsNameStatsResponseObject); ok {
+		if err := validResponse.VisitGetWorkflowsNameStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
 // Stored as a slice of fixed-width chunks rather than one concatenated
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7D1pc9s4sn8FxbdVk9STZF2WLL1PnljJKOV18mLNztZGebMg2ZTgUAAHAC1rU/rvr3DwFHX52k3GVVMT",
-	"SwSB7kbf3YC+OR5bRIwClcIZfnMizPECJHD9yQsJUDn21d8+CI+TSBJGnaHzloQSOLphrkAuhIzOCJ0h",
-	"yRBGIgKPBMRD5m20JHKO0plqDlHv/xEDXzk1h+IFOEMn91h4c1hgtaJcReqZkJzQmbOuOXf1GavbNzSg",
-	"b8xrF+rhjLM42gMoFuAjRpGcA9Lj1V8rhDkgQp2aA3dRyHxwhgEOBVSDatbJw0kkLEQlwPYLzDleqc9C",
-	"rkL1RcD4wqnA552ee11z5kRIxleb6PzMWAiYoiDEmtyEemHsg8ZIckwFUQORfR+xQD+5Ye4WwicLVdDd",
-	"NUtVEv4X+9q65oRkQeQmoGrZBb4ji3iBaLxwgStgNKkU3BxkzOkWoMyUeZB8CHAcSmfYatY09bB0hg6h",
-	"stN2UjITKmEGvBLgSz3luuawIBCwBd4KOMVXEiEXAsYBCYm5tGxu4EccRBxKsQUPu1YlIiU8et3D8Phg",
-	"plzXnAjPCMUG+jIy4wBJHkMNZYPQAiT2scRoScIQuZCwjo+IEQgOImJUwBZkcutVImRF5gDm+ZjNtK45",
-	"ybJGUDdxOY+icIUwuvmjHpKvgAIj0JJVAj0H7APPoP57/ZMdUbcL7NEwXkgSYM1KWm4Z38IyjPuGYwxD",
-	"gI8ghIXWptVk1FPlYfgLh8AZOv91kiniE/NUnFwzLkc0XlSSUT00SgVLOELxeTHnSi/r99AtDuNtW25m",
-	"Pk4lX+t3lO7Ds4rNRCERUqslPBMHalw106EUm+DZJRHyEGU7wTNnvU4m1gr83FNwaooPvzmg//3sjP/6",
-	"19HF+HwycmrOb+fjifOltkmI81tMQuySkMiVokEsNrFXEwM34sgCFDEhiBsCwrl39bbEAkRDUcdCoA2O",
-	"z5ZK9GL6laq/NoCwKKov60pt1ZleF4f1iCmVwp2hVgrOXZ0tlIKL5Mp8ta45b+bgff2ktdkm3L8ADuUc",
-	"EWo0lgI/YBxh5Km3wEfpRiigI84i4JKApgBwziqkeqIYUb2N9AC0ACHwDGqIGItlngWYhOBrStzhRaS3",
-	"802yFqJMIg7Ym2M3BOdRySHSHdzFbBV7rhifLEBIvIiqsVaP0XIONIfnEgsEd+DFsoxtu9nu1FvNerMz",
-	"aTWHrdNhp/kPJ2c6fCyhrqZ8TPwzz4W5N+BpadIMAjxjkeI2+yAxCfWf2PeJWeljYcguSubZb10rUe3C",
-	"zI08RiUmVKD5Fn4Mw02O1HK0gY16tcJs0oBlq2R4FFbCLoslknOSwmGcgMp17s9H2jL+ERMOvtIAdqIv",
-	"FWuMQjJTaqSstt5cjkdXE6Wz3v69WlvQOAy17Bjtq+ZKpLW4u55W0Rvk8oFKEhDgSA3Qe2BsMhZGw6lP",
-	"RgFUaMyQzTRNytNeshnyGOcQGoKPL6retgqjQsnm9cnmmyW6asxSWLJpKwmtZk7ciU0iaURFISDYtemG",
-	"1AoTfDc2L7TaZ+WYoUoU3yWRTlkEc2T4ltMhF9mDZFOSIOZeKmOdWOb8Ih8+jq4U/Qi9BDqTc2fYqtg1",
-	"7VGIXX6BGVGMKbWwJSBvj7YWhCaErCBjftc1+Ck0VXv9SxaAlakcEEo2ifwNTZ1YAL9Qz8GfOkP0bY3W",
-	"U1qlFhZaY29Q4UI5ZJj6xka8Gl9/OOs1W68za3HDXG0rFsxXcuejVwIkcldoGdy93mYWOGD/Aw1Xib6v",
-	"3JT9Suo9c3O6aQOl98yt0Bu5/EFGKvNtt72N/6yTlgb3h7Plwzfn8LW2hugTs1E/iSQKb6BLLCQitIYC",
-	"whWbxxK9uhy//fC6gc4VgyIiVMBGPSxVPKbiT4FCLUcI7jwAX6B8QI3DkC3Bt0MaU/rzCtlArJYySpID",
-	"IAIpA69mDjhbpCGTQDENQSjPIwqJR2S4QkpIQKih7sr4JyaH80qAyTH80876T/Trp0uUZoteNzQtD1J8",
-	"udxBpvrOWoN2SWirPTNSkYz6lZI/YoP0+AK9WgZ39RlQ5WmD/7rgTnU6zT6cem692e969e7A7dcHA79b",
-	"P4Ve66wzwF2v7TsarkSLdXplpbZbnoo8fBT3PlQrhIrLjlINmQNgUHl8VaFeeRhWHgctFXl8Gug8lHMW",
-	"z+ZIT6+cNg8iGeMwXCEcLvFKsTURUtQQkT8JlGCKXPBwLAAtAfmM/iTRElOpMz3ACQ7Jv8BOSSgSTE2N",
-	"lai8gsasoT0cBRfcKnxfNx6NrjoaPjy0XTL+NQjZct8rvyXjygaQ+JVW7z1zRwq1TU2OvUSr7tl4/b6J",
-	"o9WM3j13X1M4v/+HBT3rmnNjDNEeMPNEPzR/W3YdLQSWNGblXVQ9T2mYuuifRiatcDG6HOk/zi8ufp+c",
-	"v7tOv0s+/frx4nwy+v16cj75Nff5YvR2fDWejD9cVWYl3jP3k1Hpu41zcXfeaJJrTk98+hm5BZpYA63V",
-	"Ku35Lvdvi3XfMNub1lRLsvIFFUTZ4DSTySEKsQe+KTVob1GnEYhAFmVtBSmTSBuTYoj9FM7BA+S5iH4i",
-	"wci6rBnYy+CukbzX8HHc8Ak3QO70wctMnBVeUiC28PC2rNZ75tqkVT5O3kgE7WA3WyeaE2+OhPpTcZyd",
-	"Mo58k4R8cv+x2t/wGJVwJ7dBXU+rXe+vP1whQzBTIIgYl/kkgp2pkEwQsTdHWNgkmE2i1BTu3lckOfZA",
-	"1BBIr8iwU4rQ1AkJBaG49bP90Jo6Nftne+qgL1O6JfzIROgXLObVO5oTs7kaVHCKet0D3aD70XxrbP/J",
-	"ZBbs8xNFyRoKuPJNFWW1e2u4owhvq9nuPiqEEWczDqJCGt7Y7LpSVckoFAH3gEqTkNgoX1mvXoHZrCoC",
-	"bUnwX+EFJPE8hSVK5BclafuMY0ZXF0fqBTNHlSaw1Rvw3zNX668NwNIRaVB/w1yxqRCUPBhf46C4IbHa",
-	"pZpqsQ62a4J83WkjDWRg2YlwoosPxTrZkIejnvlx/z787QJFRB5Y/E05tN3cLRc58c0JxlPVclOwDivS",
-	"SiZxWA2FflSGJb9Cq9k8ZJHSdiUF8rTAbECo2r20hJiv1TpYeLnykvmkoK90Iq8T/XP/fGOikh4v33g9",
-	"Of80OVKp6VmqiJS4Ynl5zGnPXz99+Dj6/bfR9aQyE53lb3ub4jlJmzLuG1TlipLl0sgeqmcNIQ8gPdjy",
-	"wt58dr4Msa45yhjfY8sUL5fIf7TxSkG2UOg5q/b9t5zfvZO5ywWpDVKnznPe7E50JCIQzj9+HLckaXg6",
-	"vN6Q9hbl+DW/fpZ0u7dXlEhpKTsngCtX6Jb44KPY5OqW94lrig5okSMiLCVwtd7/fcb1f53X/9GsD6bT",
-	"+nTa+PLff3F21iIOol/a2JDRr9sc9A5KWmaCePh6OcVRUjKds+4Bq1aXPPKQ7CiArHM10pB4YAteNrI6",
-	"j7A3B9RuNJ2aE/PQGTpzKaPhyclyuWxg/bTB+OzEvipOLsdvRlfXo3q70WzM5SI0dXKpNzwNcUe6Aq6L",
-	"hbfAheGeVqOll7mr3xJBTI3UGTpwp3Yb64lYBBRHxBk6nUZTD46wnGsCn5gSrfpzVuUp/G8MXKcUf0qr",
-	"uTbFqac1vRoqZnXegTRtEE7WsqSXaDebJZcOR1FIPP3qyY0wCuSw7pVikV3vQqlOFoZIrIT2aOJIZ9B4",
-	"TKlRHKb3SQP1Rm1B/Q2jkrOwuP6m6hzdRYQbZHYN+8jxzDzbPkqNO212no8g12wB6JUp/r9OSYM5INsu",
-	"8x9ClNT1KnPgBzkHbuN/nb7CNOkBMlV1vceW4ZDL/BUy3mLDNC/FiwXmqz2sbNJRnx3BYjl3WUx9p+ZQ",
-	"xpMPX0qdb1Zq1AInOnbbJj+mNaMU6ZXLUUQgoL62H9bXNtWs/DuI0HzrsGmrU6hHHHyQwBeEgm/6GWco",
-	"s9WNKZ3MQUCynt774ZQiVEfnwgPqq/GCcYkYNZl987DVREAlJ6CjczUx6GTJhtS/t7FrrjH6czXTZkNO",
-	"Sk2N69reN0LboLp3IEs6QPeOFKZBcP84a9n2DpxZF2LvwDTXd8BYiWeHDIvyTaO7eh0XWHpztenuKu92",
-	"VTUW5h4f0+KYReNfntAWbORZqsxB0uALvk44KYlqKJi7BwGSOl1Z88rnb840bjY7nvm/jkFvcUh8W0aw",
-	"XStf1oc2ZBabZipwGAdJ1Vm5ynY1p6gyH4WgeyGxuBW0qqJ80p8ZQpLIOlafqn2p63fXNSdiVemjc99H",
-	"WOfxTLN+UQ99ZOJxFNEX4xWCkD8zf/VotM3VmSoI+565SXZ/jk3JJqvqZU6qdZZLEtV6TCC3QpcrNH5f",
-	"4vMz9lFKegV5p6IVnHGX+D5Q51k8kTIrJ9KyQ0Cw7yv5yDyOE1Ni3+p4fHAlJhQRKiQ2rcDKb9D7I9Iq",
-	"MgftCSrN6M0xnUHOOujmFk4kcIIbaFJwUmJJQvIvEEgAvwVe1zUpAxB6dX09el1TS3DItdKodaaON4/p",
-	"V/CnjiGgz2KlNCgsQ0IBuRzwV+WxXDEJQzSZp4cZMoG1Z5F8iID6alkWIMDeHDGFz/8gTG1pXCMCwjQL",
-	"IYElEYFyZtSmJUk3402Bv7lMY0rfMo4sB9eU60XoLEzq7gsym0sUMvYV6TMXyofTTpWPJR6ib9O0mjd1",
-	"htNEEH43X06d2tRElvrh+Op6cn55Ob56N3XW0ylV/211tEa39uhEScuVnP7YVR9dQJIl+7KcM5G0K40v",
-	"UvIwmtZI8iXssS/QK48tFrguQK0lwX9t2UAnbAzFGnuOq4nDPYekGikq3Jft+NiWJlKByA1zH4iFmWEX",
-	"CseAWsjoVEJcGPEgyPOVlUcBPm3qQSYnWwm/ebQJeE33CNUJFUCFJLdwBCZ2zuPwON+kXeFcjT6HqY9N",
-	"KTyV9kjxs5B5mCorHAvw9eDZjMNMzZSQRZdSF3EoSRSCiiqtqvV0yOVsPaizG5P9/rKEO2l0f11IDnhR",
-	"NO9p5qyUrcUSH9qb5GxpIpykXUfji0Z1CSbJWCmPwcxVkVNOBl1r24Gu1YypWis1FFU49AZr3Qm9YXwe",
-	"2TW5Ye5EB9Y41MnNZ3BOuo8I+xWTb5Uz8fhgXzGJ9NRP4y+V3KWCVrphbl2ffAA/EcWkR6TQEHNUFDI3",
-	"zl+aFzYe1jfirw1WIVT1F1zo7/NZmarIxIxStls3Ee202++1NUuUR4R1MtXqDrIZCxynSbpbO7HRHAvk",
-	"AlBkUPUbh3nKj8iuicH6bnm27ONvY44DfH2zCcbdr1V79+9AJs1QShPuYUHrO1bx3/Fpuifj2P0pruRE",
-	"wZOmlXYEwblvdN+nOeNpfB/TqPk9x8UvpqdCjJWg5WXrJ5HI3T2yXDOQpSBemZiTYnfvDnlPGw6PEPmL",
-	"bPL/ZOF/qETf65zZNilPKfYiF0fJRY7T7ika9SLxo7gCh78ynwSrnauXcsPxdygL90tBP5IYTGzHbPE4",
-	"wQG56H+b0KanukTseSBEEIfh6sUi/4Ca5wDxP1b5aOZZlfWPHsR8mAGtW3msK7iSt94zN6dQSjY9Owe4",
-	"1Z5b5I+w5ddJu8KPa8cPPWBdqQREeqbyRXYOtdr3boFJLHZG9F3WGm9dd4ut/l54/UlKxdvZ3CSnkxtY",
-	"ZM5OpwR9XBv9IIF8scp/bqt8b+WSs8jpHLut8VVRDErmODluujeVK/HMlHaOyOpOTFXnh1RU97tMZlNt",
-	"2aKbpf9zKqn0FHFFb2xOKyWpb2Ta7X7oDpcXHbY7Zb9dCRyVva+bexl36y2tPHal+XW9+ogg4fvQRs8v",
-	"7AoDaYn9IgCHhge2Y+G+wUFC8F0tnRKX78Xe1eD5Ym6PMLfY9/8jbS32fWNpbUX/xeD+KfXNbvk/vCv2",
-	"CEOrwoL07NwBZ97s2NLFLRum9292ygfKVun0c0T+loGaJctvWw84reyxhb2RIHeH7mnQ9f1OMDhrn52e",
-	"drwBdDt93O4G/QB3T1sYes1Bqxe0H7DsbRUizUancX9c1geUBjKtkzGkZtmKfW04z34MrZq7CiyeEE5z",
-	"btbWefB5s+xoRr4ndIOBf8tfxfHjnuTKH5J6lrNJhStRKvsZNy9DeRY2LJ3byTPHvQ7vFMDfd4Ind65s",
-	"08t7PFZ8ogRt7tLAjf1Mz2pnF125YPydZz3KcxCMT3eoJ9fOZ+4eTS5z3jpybHy2P9n5n5wgHOjuLPM3",
-	"VmYG4eSbGnNku+pWMTRDU0G8Mlcj7GxdLd/DVxFG2SdP0MOaHpx4aWR9hkbW45jW5sOWuRu6jmxp3cqm",
-	"eb+lmkcfmhWwFyfrEzvJTZ/lM9OPzuTN57UC+a5Wez8oZNfRfIXVj3Je+kXKD0z9Jdg9uNl1WeA/c17H",
-	"0NNcinOCI3KyDO5OblvaW7NrbLMtIj2PZY+1EnsLr6g6+VaZ41MYcXKbXPWejtaESn5hBrkrhLN7MrPT",
-	"gFWT6htnUpqg84/j9JysyP+qVUq16ikySm6bIkfrtSa2vsSnLmERhclFTaPsJzYqpCz9oRJ9h1RxRPYb",
-	"H0Nn0MOdPuBW0PfbzdMgcAPcanc6Xa931mr32/3cz4AY+5vIm3Io7bSaoB6LQ19fK+yC/sUZU2KzV+cL",
-	"ybiaw9xJXRSlIqi5x3k4Wy3P6/X7vXZz0ITWqdsf4FbnrA8e7p26uHdagNMkeDWICqAgka3qQ14b6xfG",
-	"FIjV6gVNPGh1cAe6+LSNBz3Xb/db0GwPPOXSH0Ks5NL15F53Yu97kXZVlFxBUu0vF6EtDSlQ7Cw47WPf",
-	"6zd9vz/w+oHbDVrtbs+FM9yDZrcAbGoh9DT2Z4R0diEPyK59K4/Jg+J1T9v91qDf7zbPem4Pzpod1z0L",
-	"euABHpwNBtWgpFunzbE5eqHlowhRPujYCpIZlIcJWgCtoI0Bn50O3IHvd7qn/UGv1YTOWc/HvWqYtOLV",
-	"LgIOOWB/ZW/W1zcb/X8AAAD//w==",
+	"7H1rc9s20+hfwfC8M03mSLIk27LlfnJip1UmdXJi9ckzrXJqkAQlJBSgAqBlNeP//g5uJEiBEuVbmzQz",
+	"ndQ2cVks9obF7uJLENH5ghJEBA9OvgQLyOAcCcTUb1GKERGjWP4cIx4xvBCYkuAkeIVTgRj4REMOQpRS",
+	"MsVkCgQFEPAFinCCI6B7gyUWM5CP1Aqw7P9nhtgqaAUEzlFwEjifeTRDcyhnFKuF/MYFw2Qa3LaCm/aU",
+	"tk0PBehL3e1Mfpwymi22AAo5igElQMwQUO3lTysAGQKYBK0A3SxSGqPgJIEpR35Q9TwunFigOfcCbP4A",
+	"GYMr+TsXq1T+IaFsHnjW85Ma+7YVzDAXlK3Wl/OC0hRBApIUKnRjEqVZjNSKBIOEY9kQmP6AJurLJxrW",
+	"IN5O5MF7qKfyIv5n0+22FXyioY9AXtMQjM7As3nGBYgo4ZgLCU40gwxGksAA1hvBkQC/n7Z/g+2/uu3h",
+	"H532x+cW2AUUswJWLMmDoT8zzFAcnAiWIRfsBRQCMdnt/5eH+7//E7QaENNrGmpKSvEci/UVSVjn8AbP",
+	"szkg2TxETK5Hbb7cCYZExkgNmvWQLrQxSmCWiuCk120peoBCLpGI/X4BLSYCTRHzgvtGDXnbCmiScFQD",
+	"rwdO/hkvQIgSyhDgAjJhGFfDDxjiWSp4zTrMXN6FVNYxOGi2jrd6yNtWsIBTTKCGvrqYUQLUfoOiEZgj",
+	"AWMoIFjiNAUhsswQW8piiC8o4ahmMc583gUZIdCAHd4VI922AjutFj3razldLNIVgODTn+0Uf0YgUe3k",
+	"HviAniEYI1ZA/d/2e9OibSbYIjOjFFtg9Uzyz5yyGpKhLNYUowkCxQClaK70gx+NaigXhv9hKAlOgv+z",
+	"V6iWPf2V711SJs5JNveiUX7UYhIKtIMojzLGpKZR/cA1TLO6Ldcj76ZkLlUfKc3h1LOZIDWSTcApb6hD",
+	"5EhNMTaG0zeYiybqYwzVCpaUfU5SutyMwTkU0UzyfrgCeQ8/uM7nXRD3wXa7vbUdlZo8jSQ4igpOvgRI",
+	"/f/3YPTLL+dno9PxedAKPpyOxsFHj9A+vYY4hSFOsVjJfcn4+iLlwIhpEUETsKCc4zBFADp9FalkHPFO",
+	"0MohUGo9pksStIKMfCbypzUgzDrlH9tSlLapmhem7QWVYo5ZxXTTpnMpdBdipf902wpezlD0+b2SsOtw",
+	"/4xgKmYAEy1FJfgJZQCCSPZCMciJQwK9YHSBmMBIYQAxRj2SZjxDujdQDcAccQ6nqAWwtgv0twTiFMUK",
+	"EzdwvlAk9tLOBQgVgCEYzWCYouBB0cHzHdzEAJ49l8yI54gLOF/4Vy0/g+UMEWedS8gBukFRJqqr7Xf7",
+	"++1et93dH/e6J73Dk/3ub4GjzmIoUFsO+ZDrL+xDGn5CkQgsgSBWkEh5m2MkIE7VjzCOsZ7pXanJJky6",
+	"5HfbqmDtTI8tbTUBMeFgVkOPabpOkYqP1lYju3pUOUloMUuxjtJMMKSZAGKGczi0YeKd5+50dOsalL/b",
+	"gT565jhP8VSKkarYevlmdH4xljLr1X/90oJkaap4R2sEOZbl1vLuRkptrKErRkTgBCMGZAO1B9pOgFxL",
+	"OPmbFgAeiZnSqcJJddg3dAoiyhhKNcJHZ77eRmB4hKwrT9Z7VvCqVpbDUgzrRbQc2Zo460hSC+WlY9em",
+	"TdeoliuBNyPdodc/rp7MfKz4kz1PVlnQQcMXR4acFR/sptij4p1Exq1Vv+4kb9+dX0j8YfIGkamYBSc9",
+	"z64pK4dvslV0i/LJXTGbBbn+TDvHxCLSg0Z31xX4OTS+vf65OOZWsZxggteR/AVMgowjdia/o3gSnIAv",
+	"t+B2QnxiYa4k9hoWzqSRCEmsdcSz0eXb40G397zQFp9oqHTFnMaS72LwjCOhzKTk5nmdWmAIxm9JurLy",
+	"3rsp24XUaxo6smltSa9p6JEbjpemQJX+60G/jv6MpZa7UJqT5f03p/lctY6Qsd6oH7j1dXTAG8gFwKQF",
+	"EswkmWcCPHszevX2eQecSgIFmMtDJImgkGdEeSbmIFV8BNBNhFDMgXvIh2lKlyg2TToT8mIFzOGwlROK",
+	"mV2OLRW8HDlhdJ4f4zjISIq4tDwWKY6wSFdAMgnismm4UgMZT9kzjrQn58qMegV+ff8G5D65550JcXlz",
+	"EyE5HppC9B33hv0K0/otM+zx6PxK8J+ZXrR07CyTm/YUEcQkNp+XzKn9/e4ROozCdvfoIGofDMOj9nAY",
+	"H7QP0aB3vD+EB1E/DhRcVortD6pCbTM/lWl4J+q9r1RIJZXtJBoKA0Av5eFFhexyv1VFDEFRWU8HnKZi",
+	"RrPpDKjhpdEWoYXIYCr9F+kSriRZYy54C2DxAwd2pSBEEcw4AksEYkp+EGAJiVDeJ8QwTPFfyAyJCeBU",
+	"Dg0lqzxDnWlHWTgSLnQt1/u882B4VSf05sdt9xy9qUvpnOsqQBx7td5rGp7Lpa1LchhZqbpl41V/fY6W",
+	"I0Z33H2FYXf/mx16tNe3AZgu0pt6yaumo4HAoEbPvAmrpzkOcxP9/bl2K5ydvzlXP5yenf0xPv3pMv+b",
+	"/e3Xd2en4/M/Lsen41+d38/OX40uRuPR2wuvV8JOzV/S+RwSj+x8OYNkirR64VlYshQh+IDCSxp9RsIh",
+	"e8lsBNlFV8gkTden+DBDYqbdiGaGEMlfnlEGMlL8SWqn5+oUpycKfB5Oa1LssnN+u4LndwR8t7sS/fuG",
+	"VcI4VvjUTk3l26YMMDSn10qLzrUirqLccfjkSFEOn+I33x5bYXAfWlYfN9Eu/6XuvHVqz1qAS5Y1dgNH",
+	"7BoxQOU/u5NR7jRaWwWy4qmJGJIdSghu2I9fup1qd1zBcgJgsajCdVnd2x8nxP31BMBIuvFSFEvmgyDS",
+	"/Nkq3A/G456l6hqkMpZC0IlqY3oqWcmQ3DcUt9bZGUtzL1LMHk9cUtMYrSCqZbbg47bz83bCuaxsgM/v",
+	"y7W0cYijAs0OUqaQH+r2lKEI4WuJEoamkMXK4jWnYKo6GC79p0qbu7K3b0fea/N+80Gtoh+U+lVbY/07",
+	"U3yNiD0ZjM5KBrZzttvkCvDjKFg7wq2frJRVJzlCQlQ0zm/aGFqkMEKxvtxXngPlUsYcmCWrExGhAqiD",
+	"Rdnd+hgHxXvYdhUKN1+AcV8UYC+Tm47t14lh1okx00Bu9MdUDZoi1CEHooa1pXnP/VfrEc2IMO6a4hxZ",
+	"HCwdwmutuRmJMOK90UnSAnJOBFttt9fM6JuWpEdaY48wk8prfb2XAjJhpYk5NQjErmEKnlGSrsCCIaUV",
+	"cQL0GNUDab/bH0j/fu943O2eqP9+a2zqbuBay5zrcDgbY/s/r2Phpkyr9nwdiov8jl/e67mTHPQbXcnX",
+	"RM4oB6hFe/mKddNy1Y/ltRqvpd9P6UFr47lUi/Jco4vL8embN6OLnwL/CdAj8OB04ywCTuVZWJ+Vpbyb",
+	"S3NhkSL5hUs5p3ZG3UdHCCwQkx9aEwLzLuoyo9JYDQWJFpDyq/buFEs5//X923fnf3w4vxwHGyzSLSJs",
+	"49LsGM8bCrptbv5sC+ebu6qVezxzb5fN9XzuOFf35DkD1py91MhjPEevMEpjd2hueFr7fDZ0z2rErPbE",
+	"uDdT6+J0m3hYznA002a7shf1kNki1mt9dI+t38On5PSNqIO6nUfxvb58ewH0TuowoQVlwr22MyOVru94",
+	"Fs0A5Oba2VxbtuTao89AMBgh3gJIRGWzYEIAmAQpJohLm+B380tvErTMj/1JAD5OSI3DvzBUfoZ85t/R",
+	"og2YyUYlN+TgoKHj8W44r71Ne6/v8sz3PYnJFkgYQkBhVp1jNXWU4e11+wcPCuGCySs6zuuFspRotpUU",
+	"dREiQl8BrgWxGT+6BLPr0zs14v9CCi2jdgha5jIKWOngSMiLsx2tLz2GT0SZGC4Uv6ahshLXAMtb5Ndo",
+	"RuHe277ynUjK0XCbBnCjz3awxvLlWHXRdNXFWem+Sy88p3/f+s0E5YXcMwQ0p9B+dzNf9A98jPFYEZ05",
+	"WM1CNQUVMPVDoT5VYXFn6HW7TSapbJcNk83DTDUIvt3LAwndiM0A8shxuujfJPRe3X9p5c/db/itSHq4",
+	"G/7L8en78Y5CTY3iRZIELz9FVnnVe5oYl2hMRVkVK9UXYCgRQSMCWhx21yf4BcUYEhAvUZqC/A4IRZTE",
+	"vHQmo1kp4EwDpYYdHq4POzwUM6uPcJorkHtNM/RMM3zoaXIt2ERx2UOgxq1GhYbUt/3W3+GK48bHCydg",
+	"ZrAuncd5rsFdb7GcKNBqLNoWpivyHO7BecjEc20NIHLjvm5bgbTF7sCxrUDQCvp3tl1ykA0UakzvvucI",
+	"ehjeLxCuBICAnxFpJgEstmqwsXm9xSJbG46WH5xj8EZBXg13XKMr5yBabNNY+Tbl5YHz+WFMcOt6aR7N",
+	"lucHOczpzl9yjd/tBGA1UiX2gyMmzf5rLNMrMh0JsryLp7R82CqTv5vBA9t/nbZ/67aHk0l7MunUJPEU",
+	"kW6N8JeH8hf4O+gOB41CYgomaD6fIyUrEnX/+KDBrP6AOheSjeF1ljVqpIA6vWwRArKN8WBElAiGQxk9",
+	"bXNV5NyYCxzxZuKgLjDxLFef6kyZm1XNN1Uv0XeNXN62Otep0wyESCwRIsBA29p1r2thqffYKaQ7515H",
+	"1myWkU5DcxjNoXZXvk4dt058doojZIJtNfsHpwsYzRDod6SRkbE0OAlmQixO9vaWy2UHqq8dyqZ7pivf",
+	"ezN6eX5xed7ud7qdmZinav1YKHGQ+yPPVfQ9ZUEruEaM67X3Oj01zU37GnOs47ODkwDdCMQIVAPRBSJw",
+	"gWVoWaerGsvMQLUlezo8XP449Z2Z/p9MZZHhTD/kkeQmvEoNq/NEpPcu+AkJnYIRFClcaop+t1s53MKF",
+	"jOVTXfc+ca1emmXzlAP81S5ULvrTFPAVV2e7bKGid1hGiFYrOhdMAfVSbkH7peRJmpbnX6eY85sFZohv",
+	"a/aOwekcbm4l2x12958OIZdU+bDVlfPzHDWQIWBSdf4hSMkPoVUKfKvuv7Un1Pj8bf6RjuhXe2wIDoQ0",
+	"XgEtSjtqfp7N55CttpCyvv78PeA0E7OQZiQOWgGhzP7ysZIJqPurCfasHvDyj04Lqfi8qqGwmANEYmVd",
+	"GK+DNhzdPvJg5CSH6zRDufQFQzESiM3lXbDO75w695edCRnPEEd2PrX3J9JV3AanPEIklu05ZQJQoqMK",
+	"9cdeFyCpsYxOWcApUm7jNa5/bbx4Tur7736iLZrsVZI8b1tbe6QmYXdrQ2ozYre25JQ1a2fsnq0Np8bA",
+	"3Nowv/Vo0FbAaZNmCzeJ9p+SufjxEXXBmsfZpw5swjOK5eIVR3UkzAeNAMlN8iJx5vcvwSTrdvcj/a8y",
+	"0K5himMTtmIyZj7eNk1QLSfseNYwSmxggjxImdmCssh8EIRuhcSsrSRVJeZtbmiKrEt/V3kq96Wt+krP",
+	"EfU50k/jGEB1o6HLMZTl0DvKH0YQfdQGIuLiBY1XD4ZbJ67Jg1h5s2buOWdQhwgVEcXlkg23axzVe0gg",
+	"a6Fzgpy/LvZ5AWOQo15Cvu8J8KMsxHGMSPAklkiVlC23bGAQGMeSPwqLY8+EH9cZHm9DATEBmHABdRoy",
+	"Tsz+8DyCnSFlCUrJGJkQ61JwaMSwQAzDDhiXjJRMYJmEwE0EbVvdzmuAwLPLy/PnLTkFQ04aj5xnEkSz",
+	"jHyWIWsagdqtKzEhb6dByBD8LC2WCyrQCRjn0clFDg831WZitEAkltPSBMj0ah0n+aOKCCmiXBHXiUqA",
+	"y5N2Io0ZuWn2+kFbUyhen6YzIa8oA4aCW9L0wmSa2pj/OZ7OBEgp/QxUDQppwymjKoYCnoAvkzyuYRKc",
+	"TCwj/KH/OAlaE33OVB+LkJtJcDuZEPlfraF1boPOK1KuYvS7EexmX5Yzym2q1OgsRw8l+anZDZkcxRw8",
+	"U1G7bY7kXDIorAMKd57GWGdLQSLe3HJwY1Cbr8ekU2HPQnQc631WoUfYtIRdQC35+7wQl1rcC3L3jvlB",
+	"gC+ix/X1hBd+/Wkd8JbKT2pjwhHhAl+jHVZixtxtHafruCvVGZHLg6qMjKBaeuTrM5BFkEgtnHHtrYPT",
+	"KUNTOZJFiwoqySPZlsmNEbWROnIFtYVLNq9ku70sI1q07G9zwRCcl9V77mur+PKhgLskJPgSGMd5xtPo",
+	"rOO/jLYeK2kxnJuQ/TW3qm10qbMvLuWI55VcGhsc6zHo9arlTq4rnwc2TT7RcKwO1jBVXtInME4OHhD2",
+	"CypeSWPi4cG+oAKooR/HXqqYSyWp9ImGbVV1AcVFGoaOliuFBu50Cplp4y+/NXAtrL1lvZF1KSU55MBt",
+	"D8JMFNmBxtWGUnyNGIrXc42KBCPwLFtMGYxtCvTP4/G7vV6np4wpHM0mRGVUc6OhlRDTVpucDrNyKo0N",
+	"m2XIzkCmHWVSmaynBSZT7qZPLxDDNMaRTE/9cULsLGpuEFOVjQAJXyKm14S5GRnFnQk5l1J0Q5KVEvXV",
+	"RK0OeGlmkfK2fjFQKNpRAQLhakK4cVtBUM0d/NGds5S+pMR8noek8y7qkpdyE/SNKjBGWWmHlTXow7U2",
+	"RnirSDvhisZzbWwTfbiTANTZZux9+G7ufTf3vpt7/wZzr6edOZVdWmJ7JNfXxoXyWDAqaERTLfWM9NUq",
+	"pypqTQw6/7q9N3+7rZFxrXfyPbi/mcFteIHXxDhVpJg7sHXiWguoSg9iPdZggVghV+Sa5eioMyFSnyjC",
+	"cNJXJI4iOg9tRVDD3cXRwTA6JuBKdXuxuuqAUVL8JllaRVy0lHVSeI8+2elMuk4HnOYpD+mqpb8brrNJ",
+	"ZwATQUGCb1BczlMr6lVOiLYOlCtS2UxX6srq6jmgzK0tomnZVG64mus2+i6sxqckV86NPSc1/tVP52Ot",
+	"9q82qGgdrPD4t19fxRXUsohH9x0eHboStCggbSqSrnYpIP1i5S8h3SQRM0+iKsUUHd6x3PSLlWe16ou9",
+	"thVUk+E6NTu6tKjH6F+15pHGVU/X07p893Qv9KD+3TKVnVTOewl+J0TXJMcptYlJSZYoIXylwb56XrMq",
+	"O6S/gPDx4MAbBT/HxCQANKqQPLKTPOptpMV43QWKFtzfytXjTrcof9/l5EuJdM2H5VRvzMop3g1uYKQx",
+	"wM0OW9X9Bce3Gg8p8uVinam/u3EbvrtL3UpqE5XWvvGop+vC36nA+3Zf40FtnTiZ7AdChAjQS407zajg",
+	"AR1aVrl8tV6t6i1gHXE0oEW9CfpCsOW3G39CwiaOahm+kQSNNeOjv91NmUej2O0WiK13+Niivk7KO39R",
+	"RmSiKlBrFT86+8qk/3fndBM2lozm8tYP3PLdHeJgpkhUrvmlitkr15vZwO+22S4sf1YM/k9m/vty9J2q",
+	"4NZxeY6x73yxE184lHZH1miXkb/IPGv4RboAVhtnr0SPZV8hL9wtSO2B2GBsqguUC1w1iFb725g2rznL",
+	"syhCnCdZmq6+a+RvUPI0YP9dhY8inlVV/qhGNEZTRNqGH9sSLtvrNQ0dgVLR6UWV4lp9bha/gy6/tAkN",
+	"364eb1r+3SsEDNK/884OWvvOSTJWYxdI36StYe28Nbr6a6H1RwkmrydzfZ/pFmi1ejpH6MPq6Hsx5Het",
+	"/O/WyncWLo5GzsfYrI0vymxQUce2AOpWV66AUx0NsINXd6wDAb5JQXW3p27WxZaJ0zD4f0ohlde19WTP",
+	"OlLJur6Bvg39pnNgvsuwzS77eiGwk/e+rSt0bpZbSnhscvPLKXc5JHwd0ujpmV2uQBhkf2eApscDE+R2",
+	"18OBRfimpE8Bq2+jb0oB/a5ud1C3MI7/kboWxrHWtCYO77vC/VfKm8383zxvdgdFK48FeXWdBlVxTNtK",
+	"kes11fsfM+Q9eatSKnCB/1OAmlNHcN27R2m/iM5N9VbnBYDD5CCO95Phcf/48HA/GqKD/SPYP0iOEnhw",
+	"2INo0B32Bkn/HtNe+xbS7ex37r6W2wZXA4XUKQhSkaxnXzvBkxeq8VNXicRNE025pYdYmlWkKYo3FJ09",
+	"BPzB+fgN13pxy6g8SfWSUvlob8bjeuHoJyHDSmUPlzjuVN6jBP62Gh9O5Zl1K+/hSPGRHLTOk4Zr+2m/",
+	"OY8ChEjbO09a7KMRjI9X9sMJ59Mvo9qnpmtbjrTN9i+rEOIwQkNzZ+m+p1kohL0vss2O4aq1bKib5owo",
+	"Hx3YFrpafRnKc4wyXx4hhtUu5Hsg61MEsu5GtMYftnReM9gxpLWWTF27xU+j9/UKmGed5VLyt+eqVdUe",
+	"nMi7T6sFnD8XmdN5OePPaPXtpDV85/JGrj+7unsHu7pM740OeK+fStTPBJTjW0nxzmJeLRucO08vcvAZ",
+	"oYXJ9yg/wSZXnXGU3487Q8NEILaELJaZvZeYRN5WugIUoQLwbKHedFp/582+F9pyXxcEuHgCVb/66JTh",
+	"MmU+CSXtBBOY6oFa9hH8q4SyCKmsS46ELy3xXfbYAu8x1Hhr067nqhupXNRGGKvJN1Po8yebmYzD9ddV",
+	"1/LJXqlB/o6jw4X7nFNBiz8CLLjWQPOMm8pnmmahflFD/my26OlcvY0PGPY11KfQIl/D8eLr1UIH3eFD",
+	"Qz4iv/JHKLw0biBDnkStWkkHdzSaDdNsOe1tKS5QPBzgPMrjvCGgVuE8X5IoiUcijHgLSLSr1wzyd+JN",
+	"9pWtI6BLXeuKCa4g12WInDd/tDGvJsS8eKbWPiSAiEBSUShoUgSv5c9Y/Oi8rjAhUL3eJd9XxiQfzVQt",
+	"Kp5eAIIqYVlMbmoCqAmXmMR0adK1whW44lL7X6l5rzKJnCtVR8RksOeAwsrzTjX1AkqK+akKB9jU/Sc/",
+	"tazNKV+FlFvBcYxY6cUIKABl2u5yUuJ1qVFsiziMLt8eD7q9ulRytVUl1d7seeI19a4svmAX8M3bcXeF",
+	"XBHWQ0D+qxroSY6LtanuH9zXJs3bJv+anPdv4vj492XrnxKYrv5ChVxV8p0vEImtEavkrPH+7KYvbes8",
+	"h19OrcrEafzrV1r24ALvLZObveueYiQzcN0ZiOcVo0ydZQyFBttXm8sbUiIP0AxfWzWat1ZKB6kHX0yx",
+	"nuIJ26IigW9Q9QRKfgQHp+9GeeFmB6yiRc0QBS7rhihaKFzdtKXG4G2B5ovUPlR0bij8i5dvFbnF9smr",
+	"cougFaR0qmlsOID7Rwj2kqO43z1MkjCBvf7+/kE0OO71j/pHgfM4sHL3Wg6WGtkMqxAa0SyNlU0QImXA",
+	"6IhOW6pQUCbHuG2tB1GUQXU+u3D2elE0ODoa9LvDLuodhkdD2Ns/PkIRHByGcHBYglPHEykQJUCJ5UV/",
+	"1dG1+UttSsjqDZIuHPb24T46gId9OByEcf+oh7r9YSRvkJogK0QRtP4RGTSNjY0szKyFiewx1Muwlhq4",
+	"gO73k95gEMPDwTAOj4+T7mHU7w16x2HUHx7Gg7AEaK5YsGG6cLXZdPee6+ogU01KO3mcHB7BODrqxvHR",
+	"MDpKwoOk1z8YhOgYDlD3wA+bGkbXv0nUJbsLyCZ6qrZxQYkODvtHveHR0UH3eBAO0HF3PwyPkwGKEBwe",
+	"D4d+UHKSUvadtksU35Yhcu/eakHSjVyYUA+hXtKHCB4fDsNhHO8fHB4NB70u2j8exHDgh0kdlJTNCVOG",
+	"YLzSDjwljW//dwA=",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
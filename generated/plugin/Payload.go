@@ -7,26 +7,33 @@ import (
 	"strconv"
 
 	generated__plugin__client "github.com/siemens/wfx/generated/plugin/client"
+	generated__plugin__event "github.com/siemens/wfx/generated/plugin/event"
 )
 
 type Payload byte
 
 const (
-	PayloadNONE                             Payload = 0
-	Payloadgenerated_plugin_client_Request  Payload = 1
-	Payloadgenerated_plugin_client_Response Payload = 2
+	PayloadNONE                                   Payload = 0
+	Payloadgenerated_plugin_client_Request        Payload = 1
+	Payloadgenerated_plugin_client_Response       Payload = 2
+	Payloadgenerated_plugin_client_ServerResponse Payload = 3
+	Payloadgenerated_plugin_event_Verdict         Payload = 4
 )
 
 var EnumNamesPayload = map[Payload]string{
-	PayloadNONE:                             "NONE",
-	Payloadgenerated_plugin_client_Request:  "generated_plugin_client_Request",
-	Payloadgenerated_plugin_client_Response: "generated_plugin_client_Response",
+	PayloadNONE:                                   "NONE",
+	Payloadgenerated_plugin_client_Request:        "generated_plugin_client_Request",
+	Payloadgenerated_plugin_client_Response:       "generated_plugin_client_Response",
+	Payloadgenerated_plugin_client_ServerResponse: "generated_plugin_client_ServerResponse",
+	Payloadgenerated_plugin_event_Verdict:         "generated_plugin_event_Verdict",
 }
 
 var EnumValuesPayload = map[string]Payload{
-	"NONE":                             PayloadNONE,
-	"generated_plugin_client_Request":  Payloadgenerated_plugin_client_Request,
-	"generated_plugin_client_Response": Payloadgenerated_plugin_client_Response,
+	"NONE":                                   PayloadNONE,
+	"generated_plugin_client_Request":        Payloadgenerated_plugin_client_Request,
+	"generated_plugin_client_Response":       Payloadgenerated_plugin_client_Response,
+	"generated_plugin_client_ServerResponse": Payloadgenerated_plugin_client_ServerResponse,
+	"generated_plugin_event_Verdict":         Payloadgenerated_plugin_event_Verdict,
 }
 
 func (v Payload) String() string {
@@ -50,6 +57,10 @@ func (t *PayloadT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 		return t.Value.(*generated__plugin__client.RequestT).Pack(builder)
 	case Payloadgenerated_plugin_client_Response:
 		return t.Value.(*generated__plugin__client.ResponseT).Pack(builder)
+	case Payloadgenerated_plugin_client_ServerResponse:
+		return t.Value.(*generated__plugin__client.ServerResponseT).Pack(builder)
+	case Payloadgenerated_plugin_event_Verdict:
+		return t.Value.(*generated__plugin__event.VerdictT).Pack(builder)
 	}
 	return 0
 }
@@ -64,6 +75,14 @@ func (rcv Payload) UnPack(table flatbuffers.Table) *PayloadT {
 		var x generated__plugin__client.Response
 		x.Init(table.Bytes, table.Pos)
 		return &PayloadT{Type: Payloadgenerated_plugin_client_Response, Value: x.UnPack()}
+	case Payloadgenerated_plugin_client_ServerResponse:
+		var x generated__plugin__client.ServerResponse
+		x.Init(table.Bytes, table.Pos)
+		return &PayloadT{Type: Payloadgenerated_plugin_client_ServerResponse, Value: x.UnPack()}
+	case Payloadgenerated_plugin_event_Verdict:
+		var x generated__plugin__event.Verdict
+		x.Init(table.Bytes, table.Pos)
+		return &PayloadT{Type: Payloadgenerated_plugin_event_Verdict, Value: x.UnPack()}
 	}
 	return nil
 }
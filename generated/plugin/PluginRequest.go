@@ -6,12 +6,15 @@ import (
 	flatbuffers "github.com/google/flatbuffers/go"
 
 	generated__plugin__client "github.com/siemens/wfx/generated/plugin/client"
+	generated__plugin__event "github.com/siemens/wfx/generated/plugin/event"
 )
 
 type PluginRequestT struct {
-	Version uint64                              `json:"version"`
-	Cookie  uint64                              `json:"cookie"`
-	Request *generated__plugin__client.RequestT `json:"request"`
+	Version  uint64                                     `json:"version"`
+	Cookie   uint64                                     `json:"cookie"`
+	Request  *generated__plugin__client.RequestT        `json:"request"`
+	Response *generated__plugin__client.ServerResponseT `json:"response"`
+	Event    *generated__plugin__event.JobEventT        `json:"event"`
 }
 
 func (t *PluginRequestT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
@@ -19,10 +22,14 @@ func (t *PluginRequestT) Pack(builder *flatbuffers.Builder) flatbuffers.UOffsetT
 		return 0
 	}
 	requestOffset := t.Request.Pack(builder)
+	responseOffset := t.Response.Pack(builder)
+	eventOffset := t.Event.Pack(builder)
 	PluginRequestStart(builder)
 	PluginRequestAddVersion(builder, t.Version)
 	PluginRequestAddCookie(builder, t.Cookie)
 	PluginRequestAddRequest(builder, requestOffset)
+	PluginRequestAddResponse(builder, responseOffset)
+	PluginRequestAddEvent(builder, eventOffset)
 	return PluginRequestEnd(builder)
 }
 
@@ -30,6 +37,8 @@ func (rcv *PluginRequest) UnPackTo(t *PluginRequestT) {
 	t.Version = rcv.Version()
 	t.Cookie = rcv.Cookie()
 	t.Request = rcv.Request(nil).UnPack()
+	t.Response = rcv.Response(nil).UnPack()
+	t.Event = rcv.Event(nil).UnPack()
 }
 
 func (rcv *PluginRequest) UnPack() *PluginRequestT {
@@ -113,8 +122,34 @@ func (rcv *PluginRequest) Request(obj *generated__plugin__client.Request) *gener
 	return nil
 }
 
+func (rcv *PluginRequest) Response(obj *generated__plugin__client.ServerResponse) *generated__plugin__client.ServerResponse {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(generated__plugin__client.ServerResponse)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *PluginRequest) Event(obj *generated__plugin__event.JobEvent) *generated__plugin__event.JobEvent {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(generated__plugin__event.JobEvent)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
 func PluginRequestStart(builder *flatbuffers.Builder) {
-	builder.StartObject(3)
+	builder.StartObject(5)
 }
 func PluginRequestAddVersion(builder *flatbuffers.Builder, version uint64) {
 	builder.PrependUint64Slot(0, version, 0)
@@ -125,6 +160,12 @@ func PluginRequestAddCookie(builder *flatbuffers.Builder, cookie uint64) {
 func PluginRequestAddRequest(builder *flatbuffers.Builder, request flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(request), 0)
 }
+func PluginRequestAddResponse(builder *flatbuffers.Builder, response flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(response), 0)
+}
+func PluginRequestAddEvent(builder *flatbuffers.Builder, event flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(event), 0)
+}
 func PluginRequestEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -8,3 +8,8 @@ import "github.com/Southclaws/fault/ftag"
 // It will typically result in a 400 response, so clients can retry the operation with fresh data instead of receiving
 // an opaque server error.
 const TOCTOU = ftag.Kind("TOCTOU")
+
+// Conflict is an error kind indicating that the operation conflicts with the current state of the system, e.g. a
+// workflow cannot be modified because it is still used by jobs.
+// It will typically result in a 409 response.
+const Conflict = ftag.Kind("CONFLICT")
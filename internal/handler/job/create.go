@@ -19,6 +19,7 @@ import (
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/handler/job/definition"
 	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
 	"github.com/siemens/wfx/internal/workflow"
 	"github.com/siemens/wfx/middleware/logging"
 	"github.com/siemens/wfx/persistence"
@@ -71,6 +72,12 @@ func CreateJob(ctx context.Context, storage persistence.Storage, request *api.Jo
 		})
 	}()
 
+	notifications := []hooks.Event{{Kind: hooks.KindJobCreated, Ctime: now, Job: createdJob}}
+	if path := workflow.ImmediatePath(wf, *initial); len(path) > 1 {
+		notifications = append(notifications, hooks.TransitionEvents(createdJob, api.WFX, path[0], path[1:])...)
+	}
+	hooks.Notify(ctx, notifications...)
+
 	contextLogger.Info().Str("id", createdJob.ID).Msgf("Created new job %q", createdJob.ID)
 	return createdJob, nil
 }
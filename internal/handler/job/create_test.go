@@ -15,6 +15,7 @@ import (
 
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
 	"github.com/siemens/wfx/internal/persistence/entgo"
 	"github.com/siemens/wfx/persistence"
 	"github.com/siemens/wfx/workflow/dau"
@@ -53,6 +54,41 @@ func TestCreateJob_Notification(t *testing.T) {
 	assert.Equal(t, job.ID, jobEvent.Job.ID)
 }
 
+type testNotifier struct{ events chan hooks.Event }
+
+func (n testNotifier) Name() string { return "test" }
+
+func (n testNotifier) Notify(_ context.Context, event hooks.Event) { n.events <- event }
+
+func TestCreateJob_Hooks(t *testing.T) {
+	db := newInMemoryDB(t)
+	immediate := api.IMMEDIATE
+	wf, err := db.CreateWorkflow(context.Background(), &api.Workflow{
+		Name:   "wfx.test.immediate",
+		States: []api.State{{Name: "INIT"}, {Name: "READY"}, {Name: "DONE"}},
+		Transitions: []api.Transition{
+			{From: "INIT", To: "READY", Eligible: api.WFX, Action: &immediate},
+			{From: "READY", To: "DONE", Eligible: api.CLIENT},
+		},
+	})
+	require.NoError(t, err)
+
+	notifier := testNotifier{events: make(chan hooks.Event, 2)}
+	hooks.RegisterNotifier(notifier)
+	t.Cleanup(func() { hooks.Unregister(notifier) })
+
+	job, err := CreateJob(context.Background(), db, &api.JobRequest{ClientID: "foo", Workflow: wf.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "READY", job.Status.State)
+
+	created := <-notifier.events
+	assert.Equal(t, hooks.KindJobCreated, created.Kind)
+	assert.Equal(t, job.ID, created.Job.ID)
+	transition := <-notifier.events
+	assert.Equal(t, hooks.KindTransition, transition.Kind)
+	assert.Equal(t, hooks.Transition{From: "INIT", To: "READY", Actor: api.WFX}, *transition.Transition)
+}
+
 func newInMemoryDB(t *testing.T) persistence.Storage {
 	db := &entgo.SQLite{}
 	err := db.Initialize("file:wfx?mode=memory&cache=shared&_fk=1")
@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
 	"github.com/siemens/wfx/middleware/logging"
 	"github.com/siemens/wfx/persistence"
 )
@@ -59,6 +60,8 @@ func Update(ctx context.Context, storage persistence.Storage, jobID string, defi
 		})
 	}()
 
+	hooks.Notify(ctx, hooks.Event{Kind: hooks.KindDefinitionChanged, Ctime: time.Now(), Job: result})
+
 	contextLogger.Info().Msg("Updated job definition")
 	return result.Definition, nil
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
 	"github.com/siemens/wfx/middleware/logging"
 	"github.com/siemens/wfx/persistence"
 )
@@ -46,6 +47,8 @@ func DeleteJob(ctx context.Context, storage persistence.Storage, jobID string) e
 		})
 	}()
 
+	hooks.Notify(ctx, hooks.Event{Kind: hooks.KindJobDeleted, Ctime: time.Now(), Job: job})
+
 	log.Info().Str("id", jobID).Msgf("Deleted job %q", jobID)
 	return nil
 }
@@ -10,6 +10,7 @@ package events
 
 import (
 	"context"
+	"maps"
 	"slices"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ type Subscriber struct {
 	lastBlock     time.Time     // time of the last failed attempt to send a message
 	graceInterval time.Duration // duration after which non-responsive subscribers are dropped
 
+	all         bool           // receive all events, regardless of the filters
 	jobIDSet    map[string]any // job filter
 	clientIDSet map[string]any // clientID filter
 	workflowSet map[string]any // workflow filter
@@ -42,6 +44,67 @@ func (s *Subscriber) ID() string {
 	return s.id
 }
 
+// Subscription returns the events the subscriber is interested in.
+func (s *Subscriber) Subscription() Subscription {
+	muSubscribers.RLock()
+	defer muSubscribers.RUnlock()
+	return s.subscription()
+}
+
+// Subscribe adds the job IDs, client IDs and workflows of the filter to the
+// subscription and returns the resulting subscription. If All is set, the
+// subscriber receives all events. Actions are ignored.
+func (s *Subscriber) Subscribe(subscription Subscription) Subscription {
+	muSubscribers.Lock()
+	defer muSubscribers.Unlock()
+	filter := subscription.FilterParams
+	s.all = s.all || subscription.All
+	for _, id := range filter.JobIDs {
+		s.jobIDSet[id] = nil
+	}
+	for _, id := range filter.ClientIDs {
+		s.clientIDSet[id] = nil
+	}
+	for _, wf := range filter.Workflows {
+		s.workflowSet[wf] = nil
+	}
+	return s.subscription()
+}
+
+// Unsubscribe removes the job IDs, client IDs and workflows of the filter from
+// the subscription and returns the resulting subscription. If All is set, the
+// subscriber no longer receives events which do not match its filters, i.e.
+// a subscription without any filters receives no events at all. Actions are
+// ignored.
+func (s *Subscriber) Unsubscribe(subscription Subscription) Subscription {
+	muSubscribers.Lock()
+	defer muSubscribers.Unlock()
+	filter := subscription.FilterParams
+	s.all = s.all && !subscription.All
+	for _, id := range filter.JobIDs {
+		delete(s.jobIDSet, id)
+	}
+	for _, id := range filter.ClientIDs {
+		delete(s.clientIDSet, id)
+	}
+	for _, wf := range filter.Workflows {
+		delete(s.workflowSet, wf)
+	}
+	return s.subscription()
+}
+
+// subscription must be called while holding muSubscribers.
+func (s *Subscriber) subscription() Subscription {
+	return Subscription{
+		All: s.all,
+		FilterParams: FilterParams{
+			JobIDs:    slices.Sorted(maps.Keys(s.jobIDSet)),
+			ClientIDs: slices.Sorted(maps.Keys(s.clientIDSet)),
+			Workflows: slices.Sorted(maps.Keys(s.workflowSet)),
+		},
+	}
+}
+
 type Backlog struct {
 	data []JobEvent
 	mu   sync.Mutex
@@ -66,6 +129,13 @@ func (b *Backlog) Deq() (*JobEvent, bool) {
 	return &ev, true
 }
 
+// Len returns the number of events in the backlog.
+func (b *Backlog) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.data)
+}
+
 type JobEvent struct {
 	// Ctime is the time when the event was created
 	Ctime  strfmt.DateTime `json:"ctime"`
@@ -81,6 +151,13 @@ type FilterParams struct {
 	Actions   []Action
 }
 
+// Subscription is the set of events a subscriber receives.
+type Subscription struct {
+	// All is set if the subscriber receives all events, regardless of the filters.
+	All bool
+	FilterParams
+}
+
 type Action string
 
 const (
@@ -135,10 +212,12 @@ func AddSubscriber(ctx context.Context, graceInterval time.Duration, filter Filt
 		Backlog:       &Backlog{data: make([]JobEvent, 0)},
 		lastBlock:     time.Time{},
 		graceInterval: graceInterval,
-		jobIDSet:      jobIDSet,
-		clientIDSet:   clientIDSet,
-		workflowSet:   workflowSet,
-		tags:          tags,
+		// special case: no filters means "catch-all"
+		all:         len(jobIDSet) == 0 && len(clientIDSet) == 0 && len(workflowSet) == 0,
+		jobIDSet:    jobIDSet,
+		clientIDSet: clientIDSet,
+		workflowSet: workflowSet,
+		tags:        tags,
 	}
 
 	muSubscribers.Lock()
@@ -187,6 +266,17 @@ func SubscriberCount() int {
 	return len(subscribers)
 }
 
+// BacklogSize counts the total number of events waiting in the backlogs of all subscribers.
+func BacklogSize() int {
+	muSubscribers.RLock()
+	defer muSubscribers.RUnlock()
+	total := 0
+	for _, sub := range subscribers {
+		total += sub.Backlog.Len()
+	}
+	return total
+}
+
 // PublishEvent publishes a new event. This is a synchronous operation.
 func PublishEvent(ctx context.Context, event JobEvent) {
 	log := logging.LoggerFromCtx(ctx).With().Str("jobID", event.Job.ID).Str("action", string(event.Action)).Logger()
@@ -203,9 +293,7 @@ func PublishEvent(ctx context.Context, event JobEvent) {
 		ctxLog := log.With().Str("id", sub.id).Logger()
 
 		// check if we shall notify the subscriber about the event
-		// special case: no filters means "catch-all"
-		isCatchAll := len(sub.jobIDSet) == 0 && len(sub.clientIDSet) == 0 && len(sub.workflowSet) == 0
-		interested := isCatchAll ||
+		interested := sub.all ||
 			mapContains(sub.jobIDSet, event.Job.ID) ||
 			mapContains(sub.actionSet, event.Action) ||
 			mapContains(sub.clientIDSet, event.Job.ClientID) ||
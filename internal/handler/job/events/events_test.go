@@ -143,6 +143,8 @@ func TestBacklog(t *testing.T) {
 
 	assert.Len(t, sub.Backlog.data, 1)
 	assert.Equal(t, "BETA", sub.Backlog.data[0].Job.Status.State)
+	assert.Equal(t, 1, sub.Backlog.Len())
+	assert.Equal(t, 1, BacklogSize())
 }
 
 func TestGracePeriod(t *testing.T) {
@@ -166,6 +168,69 @@ func TestGracePeriod(t *testing.T) {
 	assert.False(t, ok, "channel should be closed")
 }
 
+func TestSubscribeUnsubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+	t.Cleanup(ShutdownSubscribers)
+
+	job1 := api.Job{ID: "1", ClientID: "foo", Workflow: &api.Workflow{Name: "workflow"}}
+	job2 := api.Job{ID: "2", ClientID: "bar", Workflow: &api.Workflow{Name: "workflow"}}
+	sub := AddSubscriber(ctx, time.Minute, FilterParams{JobIDs: []string{job1.ID}}, nil)
+
+	subscription := sub.Subscribe(Subscription{FilterParams: FilterParams{JobIDs: []string{job1.ID}, ClientIDs: []string{"bar"}}})
+	assert.Equal(t, Subscription{FilterParams: FilterParams{JobIDs: []string{"1"}, ClientIDs: []string{"bar"}}}, subscription)
+
+	PublishEvent(ctx, JobEvent{Action: ActionUpdateStatus, Job: &job2})
+	ev := <-sub.Events
+	assert.Equal(t, job2.ID, ev.Job.ID)
+
+	subscription = sub.Unsubscribe(Subscription{FilterParams: FilterParams{ClientIDs: []string{"bar"}, Workflows: []string{"unknown"}}})
+	assert.Equal(t, []string{"1"}, subscription.JobIDs)
+	assert.Empty(t, subscription.ClientIDs)
+	assert.Equal(t, subscription, sub.Subscription())
+
+	PublishEvent(ctx, JobEvent{Action: ActionUpdateStatus, Job: &job2})
+	PublishEvent(ctx, JobEvent{Action: ActionUpdateStatus, Job: &job1})
+	ev = <-sub.Events
+	assert.Equal(t, job1.ID, ev.Job.ID)
+	assert.Equal(t, 0, sub.Backlog.Len())
+}
+
+func TestSubscribeUnsubscribe_All(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+	t.Cleanup(ShutdownSubscribers)
+
+	job1 := api.Job{ID: "1", ClientID: "foo"}
+	job2 := api.Job{ID: "2", ClientID: "bar"}
+	sub := AddSubscriber(ctx, time.Minute, FilterParams{}, nil)
+	assert.True(t, sub.Subscription().All)
+
+	// subscribing to a job does not restrict a subscriber which receives all events
+	subscription := sub.Subscribe(Subscription{FilterParams: FilterParams{JobIDs: []string{job1.ID}}})
+	assert.True(t, subscription.All)
+	PublishEvent(ctx, JobEvent{Action: ActionUpdateStatus, Job: &job2})
+	ev := <-sub.Events
+	assert.Equal(t, job2.ID, ev.Job.ID)
+
+	subscription = sub.Unsubscribe(Subscription{All: true})
+	assert.Equal(t, Subscription{FilterParams: FilterParams{JobIDs: []string{"1"}}}, subscription)
+
+	// removing the last job leaves a subscription which receives nothing
+	subscription = sub.Unsubscribe(Subscription{FilterParams: FilterParams{JobIDs: []string{job1.ID}}})
+	assert.False(t, subscription.All)
+	assert.Empty(t, subscription.JobIDs)
+	PublishEvent(ctx, JobEvent{Action: ActionUpdateStatus, Job: &job1})
+	PublishEvent(ctx, JobEvent{Action: ActionUpdateStatus, Job: &job2})
+	assert.Empty(t, sub.Events)
+	assert.Equal(t, 0, sub.Backlog.Len())
+
+	sub.Subscribe(Subscription{All: true})
+	PublishEvent(ctx, JobEvent{Action: ActionUpdateStatus, Job: &job1})
+	ev = <-sub.Events
+	assert.Equal(t, job1.ID, ev.Job.ID)
+}
+
 func receiveEventBlocking(sub *Subscriber) *JobEvent {
 	for {
 		select {
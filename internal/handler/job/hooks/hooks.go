@@ -0,0 +1,228 @@
+package hooks
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/middleware/logging"
+)
+
+// Kind is the type of a domain event.
+type Kind string
+
+const (
+	KindJobCreated        Kind = "JOB_CREATED"
+	KindTransition        Kind = "TRANSITION"
+	KindDefinitionChanged Kind = "DEFINITION_CHANGED"
+	KindTagsChanged       Kind = "TAGS_CHANGED"
+	KindJobDeleted        Kind = "JOB_DELETED"
+)
+
+// Transition describes a single step of a job from one state to another.
+type Transition struct {
+	From  string
+	To    string
+	Actor api.EligibleEnum
+}
+
+// Event is a change of a job.
+type Event struct {
+	Kind  Kind
+	Ctime time.Time
+	// Job is the job after the change (resp. before the change when validating a transition).
+	Job *api.Job
+	// Transition is only set for events of kind KindTransition.
+	Transition *Transition
+}
+
+// Validator is invoked synchronously before a transition is persisted. Any error rejects the transition.
+type Validator interface {
+	Name() string
+	Validate(ctx context.Context, event Event) error
+}
+
+// Notifier is invoked asynchronously after a change has been persisted.
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, event Event)
+}
+
+// VetoError is returned by a Validator which rejects a transition.
+type VetoError struct {
+	Hook   string
+	Reason string
+}
+
+func (e *VetoError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("transition rejected by %s", e.Hook)
+	}
+	return fmt.Sprintf("transition rejected by %s: %s", e.Hook, e.Reason)
+}
+
+// notifierQueueSize is the number of events buffered for each notifier. If a notifier cannot keep up, further events
+// are dropped.
+const notifierQueueSize = 1024
+
+var (
+	validators []Validator
+	notifiers  []*notifierQueue
+	muHooks    sync.RWMutex
+	// dropped is the number of events which were dropped because the queue of a notifier was full
+	dropped atomic.Uint64
+)
+
+// notifierQueue delivers events to a notifier one at a time, in the order in which they were queued.
+type notifierQueue struct {
+	notifier Notifier
+	ch       chan notification
+}
+
+type notification struct {
+	ctx   context.Context
+	event Event
+}
+
+func (q *notifierQueue) run() {
+	for n := range q.ch {
+		q.notifier.Notify(n.ctx, n.event)
+	}
+}
+
+// RegisterValidator adds a validator. Validators are invoked in the order of registration.
+func RegisterValidator(validator Validator) {
+	muHooks.Lock()
+	validators = append(validators, validator)
+	muHooks.Unlock()
+}
+
+// RegisterNotifier adds a notifier. Events are delivered to the notifier by a dedicated goroutine.
+func RegisterNotifier(notifier Notifier) {
+	q := newNotifierQueue(notifier)
+	muHooks.Lock()
+	notifiers = append(notifiers, q)
+	muHooks.Unlock()
+}
+
+func newNotifierQueue(notifier Notifier) *notifierQueue {
+	q := &notifierQueue{notifier: notifier, ch: make(chan notification, notifierQueueSize)}
+	go q.run()
+	return q
+}
+
+// Unregister removes the hook from the validators and notifiers. Events already queued for a notifier are still
+// delivered.
+func Unregister(hook any) {
+	muHooks.Lock()
+	defer muHooks.Unlock()
+	unregister(hook)
+}
+
+// Replace atomically unregisters the old hooks and registers the new validators and notifiers, i.e. each event is
+// passed either to the old or to the new hooks, but never to both or neither.
+func Replace(old []any, newValidators []Validator, newNotifiers []Notifier) {
+	queues := make([]*notifierQueue, 0, len(newNotifiers))
+	for _, notifier := range newNotifiers {
+		queues = append(queues, newNotifierQueue(notifier))
+	}
+	muHooks.Lock()
+	defer muHooks.Unlock()
+	for _, hook := range old {
+		unregister(hook)
+	}
+	validators = append(validators, newValidators...)
+	notifiers = append(notifiers, queues...)
+}
+
+// unregister removes the hook; the caller must hold muHooks.
+func unregister(hook any) {
+	validators = slices.DeleteFunc(validators, func(v Validator) bool { return v == hook })
+	notifiers = slices.DeleteFunc(notifiers, func(q *notifierQueue) bool {
+		if q.notifier != hook {
+			return false
+		}
+		close(q.ch)
+		return true
+	})
+}
+
+// DroppedNotifications returns the number of events which were dropped because a notifier could not keep up.
+func DroppedNotifications() uint64 {
+	return dropped.Load()
+}
+
+// Validate passes the event to all validators and returns the first error.
+func Validate(ctx context.Context, event Event) error {
+	muHooks.RLock()
+	current := slices.Clone(validators)
+	muHooks.RUnlock()
+
+	log := logging.LoggerFromCtx(ctx)
+	for _, validator := range current {
+		if err := validator.Validate(ctx, event); err != nil {
+			log.Info().Err(err).Str("hook", validator.Name()).Str("kind", string(event.Kind)).Msg("Event rejected by hook")
+			return err
+		}
+	}
+	return nil
+}
+
+// Notify queues the events for all notifiers without waiting for them to be delivered. Each notifier receives the
+// events in the order in which they were queued. If the queue of a notifier is full, the event is dropped.
+func Notify(ctx context.Context, events ...Event) {
+	// the request context is canceled as soon as the response has been sent
+	ctx = context.WithoutCancel(ctx)
+
+	log := logging.LoggerFromCtx(ctx)
+
+	muHooks.RLock()
+	defer muHooks.RUnlock()
+	for _, q := range notifiers {
+		for _, event := range events {
+			select {
+			case q.ch <- notification{ctx: ctx, event: event}:
+			default:
+				dropped.Add(1)
+				log.Warn().
+					Str("hook", q.notifier.Name()).
+					Str("kind", string(event.Kind)).
+					Msg("Notification queue is full, dropping event")
+			}
+		}
+	}
+}
+
+// TransitionEvents returns an event for each step from `from` along the path of states. The first step is performed by
+// the actor, all subsequent steps are immediate transitions performed by wfx. If the path starts with `from`, i.e. the
+// status was updated without changing the state (e.g. to report progress), the first step is not a transition.
+func TransitionEvents(job *api.Job, actor api.EligibleEnum, from string, path []string) []Event {
+	now := time.Now()
+	result := make([]Event, 0, len(path))
+	for i, to := range path {
+		if i == 0 && to == from {
+			actor = api.WFX
+			continue
+		}
+		result = append(result, Event{
+			Kind:       KindTransition,
+			Ctime:      now,
+			Job:        job,
+			Transition: &Transition{From: from, To: to, Actor: actor},
+		})
+		from, actor = to, api.WFX
+	}
+	return result
+}
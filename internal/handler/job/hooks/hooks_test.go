@@ -0,0 +1,177 @@
+package hooks
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+type testHook struct {
+	name   string
+	err    error
+	events chan Event
+}
+
+func newTestHook(name string, err error) *testHook {
+	return &testHook{name: name, err: err, events: make(chan Event, 16)}
+}
+
+func (h *testHook) Name() string { return h.name }
+
+func (h *testHook) Validate(_ context.Context, event Event) error {
+	h.events <- event
+	return h.err
+}
+
+func (h *testHook) Notify(_ context.Context, event Event) {
+	h.events <- event
+}
+
+func TestValidate(t *testing.T) {
+	first := newTestHook("first", nil)
+	second := newTestHook("second", &VetoError{Hook: "second", Reason: "not today"})
+	third := newTestHook("third", nil)
+	for _, h := range []*testHook{first, second, third} {
+		RegisterValidator(h)
+		t.Cleanup(func() { Unregister(h) })
+	}
+
+	err := Validate(t.Context(), Event{Kind: KindTransition})
+	assert.EqualError(t, err, "transition rejected by second: not today")
+	assert.Len(t, first.events, 1)
+	assert.Len(t, second.events, 1)
+	assert.Empty(t, third.events, "validation must stop at the first veto")
+}
+
+func TestValidate_NoValidators(t *testing.T) {
+	assert.NoError(t, Validate(t.Context(), Event{Kind: KindTransition}))
+}
+
+func TestNotify(t *testing.T) {
+	hook := newTestHook("notifier", nil)
+	RegisterNotifier(hook)
+	t.Cleanup(func() { Unregister(hook) })
+
+	ctx, cancel := context.WithCancel(t.Context())
+	Notify(ctx, Event{Kind: KindJobCreated}, Event{Kind: KindTransition})
+	// notifications must outlive the request
+	cancel()
+
+	assert.Equal(t, KindJobCreated, (<-hook.events).Kind)
+	assert.Equal(t, KindTransition, (<-hook.events).Kind)
+}
+
+func TestNotify_Order(t *testing.T) {
+	hook := newTestHook("notifier", nil)
+	RegisterNotifier(hook)
+	t.Cleanup(func() { Unregister(hook) })
+
+	kinds := []Kind{KindJobCreated, KindTransition, KindTagsChanged, KindDefinitionChanged, KindJobDeleted}
+	for _, kind := range kinds {
+		Notify(t.Context(), Event{Kind: kind})
+	}
+	for _, kind := range kinds {
+		assert.Equal(t, kind, (<-hook.events).Kind)
+	}
+}
+
+// blockingHook is a notifier which blocks until it is released.
+type blockingHook struct {
+	received chan Event
+	release  chan struct{}
+}
+
+func (h *blockingHook) Name() string { return "blocking" }
+
+func (h *blockingHook) Notify(_ context.Context, event Event) {
+	h.received <- event
+	<-h.release
+}
+
+func TestNotify_QueueFull(t *testing.T) {
+	hook := &blockingHook{received: make(chan Event, 1), release: make(chan struct{})}
+	RegisterNotifier(hook)
+	t.Cleanup(func() { Unregister(hook) })
+
+	Notify(t.Context(), Event{Kind: KindJobCreated})
+	// the notifier is busy with the first event
+	<-hook.received
+
+	before := DroppedNotifications()
+	events := make([]Event, notifierQueueSize+1)
+	for i := range events {
+		events[i] = Event{Kind: KindTransition}
+	}
+	Notify(t.Context(), events...)
+	assert.Equal(t, uint64(1), DroppedNotifications()-before)
+
+	close(hook.release)
+	for range notifierQueueSize {
+		<-hook.received
+	}
+}
+
+func TestUnregister(t *testing.T) {
+	hook := newTestHook("hook", errors.New("boom"))
+	RegisterValidator(hook)
+	RegisterNotifier(hook)
+	Unregister(hook)
+
+	assert.NoError(t, Validate(t.Context(), Event{Kind: KindTransition}))
+	Notify(t.Context(), Event{Kind: KindJobDeleted})
+	assert.Empty(t, hook.events)
+}
+
+func TestReplace(t *testing.T) {
+	old := newTestHook("old", nil)
+	RegisterValidator(old)
+	RegisterNotifier(old)
+	replacement := newTestHook("new", nil)
+	Replace([]any{old}, []Validator{replacement}, []Notifier{replacement})
+	t.Cleanup(func() { Unregister(replacement) })
+
+	require.NoError(t, Validate(t.Context(), Event{Kind: KindTransition}))
+	Notify(t.Context(), Event{Kind: KindJobDeleted})
+	assert.Equal(t, KindTransition, (<-replacement.events).Kind)
+	assert.Equal(t, KindJobDeleted, (<-replacement.events).Kind)
+	assert.Empty(t, old.events)
+}
+
+func TestTransitionEvents(t *testing.T) {
+	job := &api.Job{ID: "1"}
+	events := TransitionEvents(job, api.CLIENT, "INSTALLING", []string{"INSTALLED", "ACTIVATE"})
+	require.Len(t, events, 2)
+	assert.Equal(t, Transition{From: "INSTALLING", To: "INSTALLED", Actor: api.CLIENT}, *events[0].Transition)
+	assert.Equal(t, Transition{From: "INSTALLED", To: "ACTIVATE", Actor: api.WFX}, *events[1].Transition)
+	for _, ev := range events {
+		assert.Equal(t, KindTransition, ev.Kind)
+		assert.Same(t, job, ev.Job)
+	}
+}
+
+func TestTransitionEvents_SameState(t *testing.T) {
+	job := &api.Job{ID: "1"}
+	assert.Empty(t, TransitionEvents(job, api.CLIENT, "INSTALLING", []string{"INSTALLING"}))
+
+	// only the immediate transitions are reported
+	events := TransitionEvents(job, api.CLIENT, "INSTALLED", []string{"INSTALLED", "ACTIVATE"})
+	require.Len(t, events, 1)
+	assert.Equal(t, Transition{From: "INSTALLED", To: "ACTIVATE", Actor: api.WFX}, *events[0].Transition)
+}
+
+func TestVetoError(t *testing.T) {
+	assert.EqualError(t, &VetoError{Hook: "foo"}, "transition rejected by foo")
+}
@@ -0,0 +1,33 @@
+package job
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/middleware/logging"
+	"github.com/siemens/wfx/persistence"
+)
+
+func JobStats(
+	ctx context.Context,
+	storage persistence.Storage,
+	filterParams persistence.FilterParams,
+	statsParams persistence.StatsParams,
+) (*api.JobStats, error) {
+	log := logging.LoggerFromCtx(ctx)
+	stats, err := storage.JobStats(ctx, filterParams, statsParams)
+	if err != nil {
+		log.Err(err).Msg("Failed to compute job stats")
+		return nil, fault.Wrap(err)
+	}
+	return stats, nil
+}
@@ -0,0 +1,46 @@
+package job
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobStats(t *testing.T) {
+	db := newInMemoryDB(t)
+	createDirectWorkflow(t, db)
+
+	for _, state := range []string{"INSTALLING", "INSTALLING", "ACTIVATED"} {
+		tmpJob := newValidJob("abc", state)
+		_, err := db.CreateJob(t.Context(), &tmpJob)
+		require.NoError(t, err)
+	}
+
+	stats, err := JobStats(t.Context(), db, persistence.FilterParams{}, persistence.StatsParams{
+		GroupBy: []api.JobStatsProperty{api.JobStatsPropertyGroup},
+	})
+	require.NoError(t, err)
+	require.Len(t, stats.Content, 2)
+	assert.Equal(t, "CLOSED", *stats.Content[0].Group)
+	assert.Equal(t, int64(1), stats.Content[0].Count)
+	assert.Equal(t, "OPEN", *stats.Content[1].Group)
+	assert.Equal(t, int64(2), stats.Content[1].Count)
+}
+
+func TestJobStats_Empty(t *testing.T) {
+	db := newInMemoryDB(t)
+	stats, err := JobStats(t.Context(), db, persistence.FilterParams{}, persistence.StatsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, []api.JobStatsEntry{{Count: 0}}, stats.Content)
+}
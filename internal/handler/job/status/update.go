@@ -10,7 +10,7 @@ package status
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"time"
 
 	"github.com/Southclaws/fault"
@@ -18,6 +18,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
 	"github.com/siemens/wfx/internal/workflow"
 	"github.com/siemens/wfx/middleware/logging"
 	"github.com/siemens/wfx/persistence"
@@ -41,31 +42,31 @@ func Update(ctx context.Context, storage persistence.Storage, jobID string, newS
 		Str("to", to).
 		Logger()
 	contextLogger.Debug().Msg("Checking if transition is allowed")
-	isAllowed := from == to // always allow trivial updates
-	foundTransition := false
-	for i := 0; !isAllowed && i < len(job.Workflow.Transitions); i++ {
-		transition := job.Workflow.Transitions[i]
-		if transition.From == from && transition.To == to {
-			foundTransition = true
-			if actor == transition.Eligible {
-				isAllowed = true
-				break
-			}
-		}
+	if err := workflow.CheckTransition(job.Workflow, from, to, actor); err != nil {
+		contextLogger.Warn().Err(err).Msg("Transition rejected")
+		return nil, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
 	}
-	if !isAllowed {
-		if !foundTransition {
-			contextLogger.Warn().Msg("Transition does not exist")
-			return nil, fault.Wrap(fmt.Errorf("transition from '%s' to '%s' does not exist", from, to), ftag.With(ftag.InvalidArgument))
+
+	// transition is allowed, now apply wfx transitions.
+	path := workflow.ImmediatePath(job.Workflow, to)
+	newTo := path[len(path)-1]
+
+	// give the hooks a chance to reject any of the transitions
+	transitions := hooks.TransitionEvents(job, actor, from, path)
+	for _, event := range transitions {
+		if err := hooks.Validate(ctx, event); err != nil {
+			var vetoErr *hooks.VetoError
+			if errors.As(err, &vetoErr) {
+				contextLogger.Warn().Err(err).Msg("Transition vetoed")
+				return nil, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+			}
+			contextLogger.Err(err).Msg("Failed to validate transition")
+			return nil, fault.Wrap(err)
 		}
-		contextLogger.Warn().Msg("Transition exists but actor is not allowed to trigger it")
-		return nil, fault.Wrap(fmt.Errorf("transition from '%s' to '%s' is not allowed for actor '%s'", from, to, actor), ftag.With(ftag.InvalidArgument))
 	}
 
-	// transition is allowed, now apply wfx transitions.
 	// Make a local copy so we do not mutate the caller-provided newStatus,
 	// which may be shared across goroutines (e.g. concurrent requests).
-	newTo := workflow.FollowImmediateTransitions(job.Workflow, to)
 	var updatedStatus api.JobStatus
 	if newTo == to {
 		updatedStatus = *newStatus
@@ -96,6 +97,8 @@ func Update(ctx context.Context, storage persistence.Storage, jobID string, newS
 		})
 	}()
 
+	hooks.Notify(ctx, hooks.TransitionEvents(result, actor, from, path)...)
+
 	contextLogger.Info().
 		Str("from", from).
 		Str("to", updatedStatus.State).
@@ -10,15 +10,18 @@ package status
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/Southclaws/fault/ftag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
 	"github.com/siemens/wfx/persistence"
 	"github.com/siemens/wfx/workflow/dau"
 )
@@ -174,6 +177,123 @@ func TestUpdateJob_NotifySubscribers(t *testing.T) {
 	assert.Equal(t, wf.Name, receivedEvent.Job.Workflow.Name)
 }
 
+type testHook struct {
+	err    error
+	events chan hooks.Event
+}
+
+func (h *testHook) Name() string { return "test" }
+
+func (h *testHook) Validate(_ context.Context, event hooks.Event) error {
+	h.events <- event
+	return h.err
+}
+
+func (h *testHook) Notify(_ context.Context, event hooks.Event) {
+	h.events <- event
+}
+
+func TestUpdateJob_Hooks(t *testing.T) {
+	db := newInMemoryDB(t)
+	wf := createDirectWorkflow(t, db)
+	job, err := db.CreateJob(context.Background(), &api.Job{
+		ClientID: "foo",
+		Workflow: wf,
+		Status:   &api.JobStatus{ClientID: "foo", State: "INSTALLING"},
+	})
+	require.NoError(t, err)
+
+	validator := &testHook{events: make(chan hooks.Event, 8)}
+	notifier := &testHook{events: make(chan hooks.Event, 8)}
+	hooks.RegisterValidator(validator)
+	hooks.RegisterNotifier(notifier)
+	t.Cleanup(func() {
+		hooks.Unregister(validator)
+		hooks.Unregister(notifier)
+	})
+
+	status, err := Update(context.Background(), db, job.ID, &api.JobStatus{State: "INSTALLED"}, api.CLIENT)
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVATE", status.State)
+
+	expected := []hooks.Transition{
+		{From: "INSTALLING", To: "INSTALLED", Actor: api.CLIENT},
+		{From: "INSTALLED", To: "ACTIVATE", Actor: api.WFX},
+	}
+	for _, hook := range []*testHook{validator, notifier} {
+		for _, transition := range expected {
+			ev := <-hook.events
+			assert.Equal(t, hooks.KindTransition, ev.Kind)
+			assert.Equal(t, transition, *ev.Transition)
+			assert.Equal(t, job.ID, ev.Job.ID)
+		}
+	}
+}
+
+func TestUpdateJob_HooksProgress(t *testing.T) {
+	db := newInMemoryDB(t)
+	wf := createDirectWorkflow(t, db)
+	job, err := db.CreateJob(context.Background(), &api.Job{
+		ClientID: "foo",
+		Workflow: wf,
+		Status:   &api.JobStatus{ClientID: "foo", State: "INSTALLING"},
+	})
+	require.NoError(t, err)
+
+	validator := &testHook{events: make(chan hooks.Event, 8)}
+	notifier := &testHook{events: make(chan hooks.Event, 8)}
+	hooks.RegisterValidator(validator)
+	hooks.RegisterNotifier(notifier)
+	t.Cleanup(func() {
+		hooks.Unregister(validator)
+		hooks.Unregister(notifier)
+	})
+
+	progress := int32(50)
+	_, err = Update(context.Background(), db, job.ID, &api.JobStatus{State: "INSTALLING", Progress: &progress}, api.CLIENT)
+	require.NoError(t, err)
+	// a progress update is not a transition
+	assert.Empty(t, validator.events)
+
+	_, err = Update(context.Background(), db, job.ID, &api.JobStatus{State: "INSTALLED"}, api.CLIENT)
+	require.NoError(t, err)
+	for range 2 {
+		ev := <-notifier.events
+		assert.NotEqual(t, ev.Transition.From, ev.Transition.To)
+	}
+	assert.Never(t, func() bool { return len(notifier.events) > 0 }, 50*time.Millisecond, 5*time.Millisecond)
+}
+
+func TestUpdateJob_Veto(t *testing.T) {
+	db := newInMemoryDB(t)
+	wf := createDirectWorkflow(t, db)
+	job, err := db.CreateJob(context.Background(), &api.Job{
+		ClientID: "foo",
+		Workflow: wf,
+		Status:   &api.JobStatus{ClientID: "foo", State: "INSTALLING"},
+	})
+	require.NoError(t, err)
+
+	validator := &testHook{err: &hooks.VetoError{Hook: "test", Reason: "maintenance window"}, events: make(chan hooks.Event, 8)}
+	hooks.RegisterValidator(validator)
+	t.Cleanup(func() { hooks.Unregister(validator) })
+
+	status, err := Update(context.Background(), db, job.ID, &api.JobStatus{State: "INSTALLED"}, api.CLIENT)
+	assert.ErrorContains(t, err, "maintenance window")
+	assert.Equal(t, ftag.InvalidArgument, ftag.Get(err))
+	assert.Nil(t, status)
+
+	// other errors are internal errors
+	validator.err = errors.New("plugin unavailable")
+	_, err = Update(context.Background(), db, job.ID, &api.JobStatus{State: "INSTALLED"}, api.CLIENT)
+	assert.Error(t, err)
+	assert.NotEqual(t, ftag.InvalidArgument, ftag.Get(err))
+
+	actual, err := db.GetJob(context.Background(), job.ID, persistence.FetchParams{})
+	require.NoError(t, err)
+	assert.Equal(t, "INSTALLING", actual.Status.State)
+}
+
 func createDirectWorkflow(t *testing.T, db persistence.Storage) *api.Workflow {
 	wf, err := db.CreateWorkflow(context.Background(), dau.DirectWorkflow())
 	require.NoError(t, err)
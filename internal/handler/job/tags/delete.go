@@ -16,6 +16,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
 	"github.com/siemens/wfx/middleware/logging"
 	"github.com/siemens/wfx/persistence"
 )
@@ -50,6 +51,8 @@ func Delete(ctx context.Context, storage persistence.Storage, jobID string, tags
 		})
 	}()
 
+	hooks.Notify(ctx, hooks.Event{Kind: hooks.KindTagsChanged, Ctime: time.Now(), Job: updatedJob})
+
 	contextLogger.Info().Msg("Deleted job tags")
 	return updatedJob.Tags, nil
 }
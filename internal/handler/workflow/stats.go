@@ -0,0 +1,33 @@
+package workflow
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/middleware/logging"
+	"github.com/siemens/wfx/persistence"
+)
+
+func WorkflowStats(ctx context.Context, storage persistence.Storage, name string, statsParams persistence.WorkflowStatsParams) (*api.WorkflowStats, error) {
+	log := logging.LoggerFromCtx(ctx).With().Str("name", name).Logger()
+	stats, err := storage.WorkflowStats(ctx, name, statsParams)
+	if err != nil {
+		if ftag.Get(err) == ftag.NotFound {
+			log.Debug().Msgf("Workflow %q not found", name)
+		} else {
+			log.Error().Err(err).Msgf("Failed to compute stats for workflow %q", name)
+		}
+		return nil, fault.Wrap(err)
+	}
+	return stats, nil
+}
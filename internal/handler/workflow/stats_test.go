@@ -0,0 +1,49 @@
+package workflow
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Southclaws/fault/ftag"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStats(t *testing.T) {
+	db := newInMemoryDB(t)
+	wf, err := CreateWorkflow(t.Context(), db, dau.DirectWorkflow())
+	require.NoError(t, err)
+
+	job, err := db.CreateJob(t.Context(), &api.Job{
+		ClientID: "foo",
+		Workflow: wf,
+		Status:   &api.JobStatus{State: "INSTALL"},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.DeleteJob(context.Background(), job.ID) })
+	_, err = db.UpdateJob(t.Context(), job, persistence.JobUpdate{Status: &api.JobStatus{State: "INSTALLING"}})
+	require.NoError(t, err)
+
+	stats, err := WorkflowStats(t.Context(), db, wf.Name, persistence.WorkflowStatsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Jobs)
+	assert.Equal(t, []api.TransitionStats{{From: "INSTALL", To: "INSTALLING", Count: 1}}, stats.Transitions)
+}
+
+func TestWorkflowStats_NotFound(t *testing.T) {
+	db := newInMemoryDB(t)
+	stats, err := WorkflowStats(t.Context(), db, "foo", persistence.WorkflowStatsParams{})
+	assert.Nil(t, stats)
+	assert.Equal(t, ftag.NotFound, ftag.Get(err))
+}
@@ -0,0 +1,39 @@
+package workflow
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/middleware/logging"
+	"github.com/siemens/wfx/persistence"
+	"github.com/siemens/wfx/workflow"
+)
+
+// UpdateWorkflow replaces the definition of an existing workflow. Unless force is set, the update is refused
+// (errkind.Conflict) if any job of the workflow is in a non-final state, since such jobs might end up in a state
+// which no longer exists.
+func UpdateWorkflow(ctx context.Context, storage persistence.Storage, wf *api.Workflow, force bool) (*api.Workflow, error) {
+	log := logging.LoggerFromCtx(ctx).With().Str("name", wf.Name).Logger()
+	if err := workflow.ValidateWorkflow(wf); err != nil {
+		return nil, fault.Wrap(err, ftag.With(ftag.InvalidArgument))
+	}
+
+	// the storage checks for active jobs within the same transaction as the update
+	wf, err := storage.UpdateWorkflow(ctx, wf, force)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update workflow")
+		return nil, fault.Wrap(err)
+	}
+	log.Info().Bool("force", force).Msgf("Updated workflow %q", wf.Name)
+	return wf, nil
+}
@@ -0,0 +1,68 @@
+package workflow
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Southclaws/fault/ftag"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/errkind"
+	"github.com/siemens/wfx/persistence"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateWorkflow(t *testing.T) {
+	db := newInMemoryDB(t)
+	wf, err := CreateWorkflow(t.Context(), db, dau.DirectWorkflow())
+	require.NoError(t, err)
+
+	job, err := db.CreateJob(t.Context(), &api.Job{
+		ClientID: "foo",
+		Workflow: wf,
+		Status:   &api.JobStatus{State: "INSTALL"},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.DeleteJob(context.Background(), job.ID) })
+
+	updated := dau.DirectWorkflow()
+	updated.Description = "updated"
+
+	_, err = UpdateWorkflow(t.Context(), db, updated, false)
+	assert.Equal(t, errkind.Conflict, ftag.Get(err))
+
+	result, err := UpdateWorkflow(t.Context(), db, updated, true)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", result.Description)
+
+	// jobs in a final state do not prevent updates
+	_, err = db.UpdateJob(t.Context(), job, persistence.JobUpdate{Status: &api.JobStatus{State: "TERMINATED"}})
+	require.NoError(t, err)
+	updated.Description = "updated again"
+	result, err = UpdateWorkflow(t.Context(), db, updated, false)
+	require.NoError(t, err)
+	assert.Equal(t, "updated again", result.Description)
+}
+
+func TestUpdateWorkflow_Invalid(t *testing.T) {
+	db := newInMemoryDB(t)
+	wf := dau.DirectWorkflow()
+	wf.States = nil
+	_, err := UpdateWorkflow(t.Context(), db, wf, false)
+	assert.Equal(t, ftag.InvalidArgument, ftag.Get(err))
+}
+
+func TestUpdateWorkflow_NotFound(t *testing.T) {
+	db := newInMemoryDB(t)
+	_, err := UpdateWorkflow(t.Context(), db, dau.DirectWorkflow(), false)
+	assert.Equal(t, ftag.NotFound, ftag.Get(err))
+}
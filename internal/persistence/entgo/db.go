@@ -18,6 +18,8 @@ import (
 // It holds a pointer to the connection and is safe to copy by value.
 type Database struct {
 	client *ent.Client
+	// dialect is the SQL dialect of the database, see entgo.io/ent/dialect
+	dialect string
 }
 
 func (db Database) Shutdown() {
@@ -15,10 +15,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/Southclaws/fault"
+	"github.com/rs/zerolog"
 
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/generated/ent"
 	"github.com/siemens/wfx/generated/ent/job"
+	"github.com/siemens/wfx/generated/ent/predicate"
 	"github.com/siemens/wfx/generated/ent/tag"
 	"github.com/siemens/wfx/generated/ent/workflow"
 	"github.com/siemens/wfx/middleware/logging"
@@ -36,38 +38,12 @@ func (db Database) QueryJobs(ctx context.Context,
 		q.Order(ent.Asc(tag.FieldName))
 	})
 
-	if filterParams.ClientID != nil && *filterParams.ClientID != "" {
-		log.Debug().Str("clientID", *filterParams.ClientID).Msgf("Adding clientID filter %q", *filterParams.ClientID)
-		builder.Where(job.ClientID(*filterParams.ClientID))
-	}
-	if filterParams.State != nil && *filterParams.State != "" {
-		log.Debug().Str("state", *filterParams.State).Msgf("Adding state filter %q", *filterParams.State)
-		builder.Where(func(s *sql.Selector) {
-			s.Where(sqljson.ValueEQ("status", filterParams.State, sqljson.Path("state")))
-		})
-	}
-	if filterParams.Group != nil {
-		log.Debug().Strs("groups", filterParams.Group).Msgf("Adding groups filter %v", filterParams.Group)
-		builder.Where(job.GroupIn(filterParams.Group...))
-	}
-	if filterParams.Workflow != nil && *filterParams.Workflow != "" {
-		log.Debug().Str("workflow", *filterParams.Workflow).Msgf("Adding workflow filter %q", *filterParams.Workflow)
-		builder.Where(job.HasWorkflowWith(workflow.Name(*filterParams.Workflow)))
-	}
-
+	builder.Where(jobFilter(log, filterParams)...)
 	if len(filterParams.Tags) > 0 {
 		log.Debug().Strs("tags", filterParams.Tags).Msgf("Adding tags filter %v", filterParams.Tags)
 		builder.Where(func(s *sql.Selector) {
-			tagJobsTable := sql.Table(tag.JobsTable)
-			tagTable := sql.Table(tag.Table)
-
-			s.Join(tagJobsTable).On(s.C(job.FieldID), tagJobsTable.C(tag.JobsPrimaryKey[1]))
-			s.Join(tagTable).On(tagJobsTable.C(tag.JobsPrimaryKey[0]), tagTable.C(tag.FieldID))
-			values := make([]any, len(filterParams.Tags))
-			for i, v := range filterParams.Tags {
-				values[i] = v
-			}
-			s.Where(sql.In(tagTable.C(tag.FieldName), values...))
+			tagTable := joinTags(s, "")
+			s.Where(sql.In(tagTable.C(tag.FieldName), tagValues(filterParams.Tags)...))
 		})
 	}
 
@@ -115,3 +91,51 @@ func (db Database) QueryJobs(ctx context.Context,
 	}
 	return &result, nil
 }
+
+// jobFilter returns the predicates for all filterParams except tags, which require a join (see joinTags).
+func jobFilter(log zerolog.Logger, filterParams persistence.FilterParams) []predicate.Job {
+	result := make([]predicate.Job, 0, 4)
+	if filterParams.ClientID != nil && *filterParams.ClientID != "" {
+		log.Debug().Str("clientID", *filterParams.ClientID).Msgf("Adding clientID filter %q", *filterParams.ClientID)
+		result = append(result, job.ClientID(*filterParams.ClientID))
+	}
+	if filterParams.State != nil && *filterParams.State != "" {
+		log.Debug().Str("state", *filterParams.State).Msgf("Adding state filter %q", *filterParams.State)
+		result = append(result, func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ("status", filterParams.State, sqljson.Path("state")))
+		})
+	}
+	if filterParams.Group != nil {
+		log.Debug().Strs("groups", filterParams.Group).Msgf("Adding groups filter %v", filterParams.Group)
+		result = append(result, job.GroupIn(filterParams.Group...))
+	}
+	if filterParams.Workflow != nil && *filterParams.Workflow != "" {
+		log.Debug().Str("workflow", *filterParams.Workflow).Msgf("Adding workflow filter %q", *filterParams.Workflow)
+		result = append(result, job.HasWorkflowWith(workflow.Name(*filterParams.Workflow)))
+	}
+	return result
+}
+
+// joinTags joins the tags of the jobs using the given join kind (empty for an inner join)
+// and returns the tag table.
+func joinTags(s *sql.Selector, kind string) *sql.SelectTable {
+	tagJobsTable := sql.Table(tag.JobsTable)
+	tagTable := sql.Table(tag.Table)
+	switch kind {
+	case "LEFT":
+		s.LeftJoin(tagJobsTable).On(s.C(job.FieldID), tagJobsTable.C(tag.JobsPrimaryKey[1]))
+		s.LeftJoin(tagTable).On(tagJobsTable.C(tag.JobsPrimaryKey[0]), tagTable.C(tag.FieldID))
+	default:
+		s.Join(tagJobsTable).On(s.C(job.FieldID), tagJobsTable.C(tag.JobsPrimaryKey[1]))
+		s.Join(tagTable).On(tagJobsTable.C(tag.JobsPrimaryKey[0]), tagTable.C(tag.FieldID))
+	}
+	return tagTable
+}
+
+func tagValues(tags []string) []any {
+	values := make([]any, len(tags))
+	for i, v := range tags {
+		values[i] = v
+	}
+	return values
+}
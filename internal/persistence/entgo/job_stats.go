@@ -0,0 +1,184 @@
+package entgo
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/generated/ent/job"
+	"github.com/siemens/wfx/generated/ent/tag"
+	"github.com/siemens/wfx/generated/ent/workflow"
+	"github.com/siemens/wfx/middleware/logging"
+	"github.com/siemens/wfx/persistence"
+)
+
+// JobStats counts the jobs matching filterParams using a `GROUP BY` query.
+func (db Database) JobStats(ctx context.Context, filterParams persistence.FilterParams, statsParams persistence.StatsParams) (*api.JobStats, error) {
+	log := logging.LoggerFromCtx(ctx)
+
+	s := sql.Dialect(db.dialect).Select().From(sql.Table(job.Table))
+	for _, p := range jobFilter(log, filterParams) {
+		p(s)
+	}
+
+	groupByTag := slices.Contains(statsParams.GroupBy, api.JobStatsPropertyTag)
+	var tagTable *sql.SelectTable
+	switch {
+	case len(filterParams.Tags) > 0:
+		log.Debug().Strs("tags", filterParams.Tags).Msgf("Adding tags filter %v", filterParams.Tags)
+		tagTable = joinTags(s, "")
+		s.Where(sql.In(tagTable.C(tag.FieldName), tagValues(filterParams.Tags)...))
+	case groupByTag:
+		// jobs without tags are counted, too
+		tagTable = joinTags(s, "LEFT")
+	}
+
+	// the columns to group by, in the order of the result
+	var columns []string
+	var properties []api.JobStatsProperty
+	if bucket := statsParams.Bucket; bucket != nil {
+		expr, err := db.bucketExpr(s, *bucket)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		columns = append(columns, expr)
+	}
+	for _, property := range statsParams.GroupBy {
+		if slices.Contains(properties, property) {
+			continue
+		}
+		var column string
+		switch property {
+		case api.JobStatsPropertyWorkflow:
+			workflowTable := sql.Table(workflow.Table)
+			s.LeftJoin(workflowTable).On(s.C(job.WorkflowColumn), workflowTable.C(workflow.FieldID))
+			column = coalesce(workflowTable.C(workflow.FieldName))
+		case api.JobStatsPropertyState:
+			column = sql.Dialect(db.dialect).String(func(b *sql.Builder) {
+				b.Join(sqljson.ValuePath(s.C(job.FieldStatus), sqljson.Path("state"), sqljson.Unquote(true)))
+			})
+		case api.JobStatsPropertyGroup:
+			column = coalesce(s.C(job.FieldGroup))
+		case api.JobStatsPropertyTag:
+			column = coalesce(tagTable.C(tag.FieldName))
+		case api.JobStatsPropertyClientId:
+			column = s.C(job.FieldClientID)
+		default:
+			return nil, fault.Wrap(fmt.Errorf("unsupported property %q", property), ftag.With(ftag.InvalidArgument))
+		}
+		properties = append(properties, property)
+		columns = append(columns, column)
+	}
+
+	s.Select(columns...)
+	// a job might be joined multiple times due to its tags
+	s.AppendSelectExpr(sql.Raw(fmt.Sprintf("COUNT(DISTINCT %s)", s.C(job.FieldID))))
+	if len(columns) > 0 {
+		s.GroupBy(columns...)
+		s.OrderBy(columns...)
+	}
+
+	query, args := s.Query()
+	start := time.Now()
+	rows, err := db.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to compute job stats")
+		return nil, fault.Wrap(err)
+	}
+	defer rows.Close()
+
+	result := api.JobStats{Content: make([]api.JobStatsEntry, 0)}
+	for rows.Next() {
+		var bucket stdsql.NullInt64
+		values := make([]stdsql.NullString, len(properties))
+		dest := make([]any, 0, len(columns)+1)
+		if statsParams.Bucket != nil {
+			dest = append(dest, &bucket)
+		}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		var entry api.JobStatsEntry
+		dest = append(dest, &entry.Count)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fault.Wrap(err)
+		}
+
+		if statsParams.Bucket != nil {
+			t := time.Unix(bucket.Int64, 0).UTC()
+			entry.Bucket = &t
+		}
+		for i, property := range properties {
+			value := values[i].String
+			switch property {
+			case api.JobStatsPropertyWorkflow:
+				entry.Workflow = &value
+			case api.JobStatsPropertyState:
+				entry.State = &value
+			case api.JobStatsPropertyGroup:
+				entry.Group = &value
+			case api.JobStatsPropertyTag:
+				entry.Tag = &value
+			case api.JobStatsPropertyClientId:
+				entry.ClientID = &value
+			}
+		}
+		result.Content = append(result.Content, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	log.Debug().Dur("duration", time.Since(start)).Int("rows", len(result.Content)).Msg("Computed job stats")
+	return &result, nil
+}
+
+// coalesce maps NULL to the empty string, which ensures the same ordering in all databases.
+func coalesce(column string) string {
+	return fmt.Sprintf("COALESCE(%s, '')", column)
+}
+
+// bucketExpr returns an expression which maps the timestamp to the start of its
+// interval (in seconds since the UNIX epoch).
+func (db Database) bucketExpr(s *sql.Selector, bucket persistence.TimeBucket) (string, error) {
+	var column string
+	switch bucket.Field {
+	case api.Stime:
+		column = s.C(job.FieldStime)
+	case api.Mtime:
+		column = s.C(job.FieldMtime)
+	default:
+		return "", fault.Wrap(fmt.Errorf("unsupported time field %q", bucket.Field), ftag.With(ftag.InvalidArgument))
+	}
+
+	// safe to inline, it's a number
+	seconds := int64(bucket.Interval / time.Second)
+	if seconds < 1 {
+		return "", fault.Wrap(fmt.Errorf("interval must be at least one second, got %s", bucket.Interval), ftag.With(ftag.InvalidArgument))
+	}
+
+	switch db.dialect {
+	case dialect.Postgres:
+		return fmt.Sprintf("CAST(FLOOR(EXTRACT(EPOCH FROM %s) / %d) * %d AS BIGINT)", column, seconds, seconds), nil
+	case dialect.MySQL:
+		return fmt.Sprintf("CAST(FLOOR(UNIX_TIMESTAMP(%s) / %d) * %d AS SIGNED)", column, seconds, seconds), nil
+	default:
+		return fmt.Sprintf("(CAST(strftime('%%s', %s) AS INTEGER) / %d) * %d", column, seconds, seconds), nil
+	}
+}
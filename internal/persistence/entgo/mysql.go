@@ -102,6 +102,6 @@ func (wrapper *MySQL) Initialize(options string) error {
 		client = client.Debug()
 	}
 
-	wrapper.Database = Database{client: client}
+	wrapper.Database = Database{client: client, dialect: dialect.MySQL}
 	return nil
 }
@@ -194,6 +194,6 @@ func (wrapper *PostgreSQL) Initialize(options string) error {
 		client = client.Debug()
 	}
 
-	wrapper.Database = Database{client: client}
+	wrapper.Database = Database{client: client, dialect: dialect.Postgres}
 	return nil
 }
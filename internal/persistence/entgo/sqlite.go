@@ -57,7 +57,7 @@ func (instance *SQLite) Initialize(dsn string) error {
 	}
 
 	log.Debug().Msg("Connected to SQLite")
-	instance.Database = Database{client: client}
+	instance.Database = Database{client: client, dialect: dialect.SQLite}
 
 	{
 		// run schema migrations
@@ -0,0 +1,225 @@
+package entgo
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/generated/ent"
+	"github.com/siemens/wfx/generated/ent/history"
+	"github.com/siemens/wfx/generated/ent/job"
+	"github.com/siemens/wfx/generated/ent/predicate"
+	"github.com/siemens/wfx/generated/ent/tag"
+	"github.com/siemens/wfx/generated/ent/workflow"
+	"github.com/siemens/wfx/middleware/logging"
+	"github.com/siemens/wfx/persistence"
+)
+
+// workflowStatsPageSize is the number of jobs (including their history) loaded at once.
+const workflowStatsPageSize = 1000
+
+// WorkflowStats replays the history of all jobs using the workflow and computes the dwell times
+// per state as well as the number of transitions between states.
+//
+// Each history entry records the status a job had before an update (if the update changed the status) along with
+// the time of the preceding modification of the job. Hence the time of an update is the mtime of the next history
+// entry (resp. of the job itself for the latest update). Together with the job's current status, this yields the
+// sequence of states a job went through and the times at which they were entered.
+func (db Database) WorkflowStats(ctx context.Context, name string, statsParams persistence.WorkflowStatsParams) (*api.WorkflowStats, error) {
+	log := logging.LoggerFromCtx(ctx).With().Str("workflow", name).Logger()
+
+	exists, err := db.client.Workflow.Query().Where(workflow.Name(name)).Exist(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if !exists {
+		return nil, fault.Wrap(fmt.Errorf("workflow %s does not exist", name), ftag.With(ftag.NotFound))
+	}
+
+	predicates := []predicate.Job{job.HasWorkflowWith(workflow.Name(name))}
+	if len(statsParams.Tags) > 0 {
+		predicates = append(predicates, job.HasTagsWith(tag.NameIn(statsParams.Tags...)))
+	}
+	if statsParams.Since != nil {
+		// a job which was last modified before `since` did not leave any state afterwards
+		predicates = append(predicates, job.MtimeGTE(*statsParams.Since))
+	}
+	if statsParams.Until != nil {
+		predicates = append(predicates, job.StimeLT(*statsParams.Until))
+	}
+
+	start := time.Now()
+	collector := newDwellCollector(statsParams.Since, statsParams.Until)
+	var lastID string
+	for {
+		// keyset pagination, which is stable even if jobs are created concurrently
+		entities, err := db.client.Job.Query().
+			Where(predicates...).
+			Where(job.IDGT(lastID)).
+			Order(ent.Asc(job.FieldID)).
+			Limit(workflowStatsPageSize).
+			Select(job.FieldMtime, job.FieldStatus).
+			WithHistory(func(query *ent.HistoryQuery) {
+				// updates which did not change the status (e.g. of the tags) are needed for their timestamps
+				query.
+					Select(history.FieldMtime, history.FieldStatus).
+					Order(ent.Asc(history.FieldID))
+			}).
+			All(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to fetch job history")
+			return nil, fault.Wrap(err)
+		}
+		for _, entity := range entities {
+			collector.add(stateEvents(entity))
+		}
+		if len(entities) < workflowStatsPageSize {
+			break
+		}
+		lastID = entities[len(entities)-1].ID
+	}
+
+	result := collector.result(name)
+	log.Debug().Dur("duration", time.Since(start)).Int64("jobs", result.Jobs).Msg("Computed workflow stats")
+	return &result, nil
+}
+
+// stateEvents returns the chronologically ordered states of the job along with the time each state was entered.
+// The history of the job must be ordered by ID.
+func stateEvents(entity *ent.Job) []stateEvent {
+	hist := entity.Edges.History
+	events := make([]stateEvent, 0, len(hist)+1)
+	entered := entity.Mtime
+	if len(hist) > 0 {
+		// the first entry was recorded by the first update, i.e. it carries the creation time
+		entered = hist[0].Mtime
+	}
+	for i, h := range hist {
+		if h.Status.State == "" {
+			// the status was not changed
+			continue
+		}
+		events = append(events, stateEvent{state: h.Status.State, time: entered})
+		// the status recorded by the next entry (resp. the current status) was set by this update
+		entered = entity.Mtime
+		if i+1 < len(hist) {
+			entered = hist[i+1].Mtime
+		}
+	}
+	return append(events, stateEvent{state: entity.Status.State, time: entered})
+}
+
+// stateEvent denotes that a job entered the given state at the given time.
+type stateEvent struct {
+	state string
+	time  time.Time
+}
+
+type transitionKey struct {
+	from, to string
+}
+
+type dwellCollector struct {
+	since, until *time.Time
+
+	jobs        int64
+	dwellTimes  map[string][]time.Duration
+	transitions map[transitionKey]int64
+}
+
+func newDwellCollector(since, until *time.Time) *dwellCollector {
+	return &dwellCollector{
+		since:       since,
+		until:       until,
+		dwellTimes:  make(map[string][]time.Duration),
+		transitions: make(map[transitionKey]int64),
+	}
+}
+
+// add processes the chronologically ordered events of a single job.
+// Consecutive events with the same state (e.g. progress updates) are merged.
+func (c *dwellCollector) add(events []stateEvent) {
+	if len(events) == 0 {
+		return
+	}
+	contributed := false
+	current := events[0]
+	for _, ev := range events[1:] {
+		if ev.state == current.state {
+			continue
+		}
+		if c.inWindow(ev.time) {
+			c.dwellTimes[current.state] = append(c.dwellTimes[current.state], ev.time.Sub(current.time))
+			c.transitions[transitionKey{from: current.state, to: ev.state}]++
+			contributed = true
+		}
+		current = ev
+	}
+	if contributed {
+		c.jobs++
+	}
+}
+
+func (c *dwellCollector) inWindow(t time.Time) bool {
+	if c.since != nil && t.Before(*c.since) {
+		return false
+	}
+	if c.until != nil && !t.Before(*c.until) {
+		return false
+	}
+	return true
+}
+
+func (c *dwellCollector) result(name string) api.WorkflowStats {
+	result := api.WorkflowStats{
+		Workflow:    name,
+		Jobs:        c.jobs,
+		States:      make([]api.StateStats, 0, len(c.dwellTimes)),
+		Transitions: make([]api.TransitionStats, 0, len(c.transitions)),
+	}
+	for state, samples := range c.dwellTimes {
+		slices.Sort(samples)
+		result.States = append(result.States, api.StateStats{
+			State: state,
+			Count: int64(len(samples)),
+			P50:   percentile(samples, 50),
+			P95:   percentile(samples, 95),
+			P99:   percentile(samples, 99),
+		})
+	}
+	slices.SortFunc(result.States, func(a, b api.StateStats) int {
+		return cmp.Compare(a.State, b.State)
+	})
+	for key, count := range c.transitions {
+		result.Transitions = append(result.Transitions, api.TransitionStats{From: key.from, To: key.to, Count: count})
+	}
+	slices.SortFunc(result.Transitions, func(a, b api.TransitionStats) int {
+		return cmp.Or(cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To))
+	})
+	return result
+}
+
+// percentile computes the p-th percentile (nearest-rank method) in seconds. The samples must be sorted.
+func percentile(samples []time.Duration, p float64) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(samples))))
+	idx := max(rank-1, 0)
+	return samples[idx].Seconds()
+}
@@ -0,0 +1,75 @@
+package entgo
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDwellCollector(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := newDwellCollector(nil, nil)
+	c.add([]stateEvent{
+		{state: "INSTALL", time: t0},
+		{state: "DOWNLOADING", time: t0.Add(time.Second)},
+		{state: "DOWNLOADING", time: t0.Add(5 * time.Second)}, // progress update
+		{state: "INSTALLING", time: t0.Add(11 * time.Second)},
+	})
+	c.add([]stateEvent{
+		{state: "INSTALL", time: t0},
+		{state: "DOWNLOADING", time: t0.Add(3 * time.Second)},
+	})
+	// job without any transition
+	c.add([]stateEvent{{state: "INSTALL", time: t0}})
+
+	assert.Equal(t, api.WorkflowStats{
+		Workflow: "foo",
+		Jobs:     2,
+		States: []api.StateStats{
+			{State: "DOWNLOADING", Count: 1, P50: 10, P95: 10, P99: 10},
+			{State: "INSTALL", Count: 2, P50: 1, P95: 3, P99: 3},
+		},
+		Transitions: []api.TransitionStats{
+			{From: "DOWNLOADING", To: "INSTALLING", Count: 1},
+			{From: "INSTALL", To: "DOWNLOADING", Count: 2},
+		},
+	}, c.result("foo"))
+}
+
+func TestDwellCollector_Window(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	since, until := t0.Add(time.Minute), t0.Add(time.Hour)
+	c := newDwellCollector(&since, &until)
+	c.add([]stateEvent{
+		{state: "A", time: t0},
+		{state: "B", time: t0.Add(time.Second)}, // before since
+		{state: "C", time: t0.Add(2 * time.Minute)},
+		{state: "D", time: t0.Add(time.Hour)}, // until is exclusive
+	})
+
+	result := c.result("foo")
+	assert.Equal(t, int64(1), result.Jobs)
+	assert.Equal(t, []api.StateStats{{State: "B", Count: 1, P50: 119, P95: 119, P99: 119}}, result.States)
+	assert.Equal(t, []api.TransitionStats{{From: "B", To: "C", Count: 1}}, result.Transitions)
+}
+
+func TestPercentile(t *testing.T) {
+	samples := make([]time.Duration, 100)
+	for i := range samples {
+		samples[i] = time.Duration(i+1) * time.Second
+	}
+	assert.Equal(t, 50.0, percentile(samples, 50))
+	assert.Equal(t, 95.0, percentile(samples, 95))
+	assert.Equal(t, 99.0, percentile(samples, 99))
+	assert.Equal(t, 0.0, percentile(nil, 50))
+}
@@ -0,0 +1,108 @@
+package entgo
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/generated/ent"
+	"github.com/siemens/wfx/generated/ent/job"
+	"github.com/siemens/wfx/generated/ent/workflow"
+	"github.com/siemens/wfx/internal/errkind"
+	wfutil "github.com/siemens/wfx/internal/workflow"
+	"github.com/siemens/wfx/middleware/logging"
+)
+
+// UpdateWorkflow replaces the definition of an existing workflow. Unless force is set, the update is refused if any
+// job of the workflow is in a non-final state.
+func (db Database) UpdateWorkflow(ctx context.Context, wf *api.Workflow, force bool) (*api.Workflow, error) {
+	log := logging.LoggerFromCtx(ctx).With().Str("name", wf.Name).Logger()
+
+	tx, err := db.client.Tx(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to start transaction")
+		return nil, fault.Wrap(err)
+	}
+
+	result, err := doUpdateWorkflow(ctx, tx, wf, force)
+	if err != nil {
+		if txErr := tx.Rollback(); txErr != nil {
+			log.Error().Err(txErr).Msg("Rollback failed")
+		}
+		return nil, fault.Wrap(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error().Err(err).Msg("Failed to commit transaction")
+		return nil, fault.Wrap(err)
+	}
+	return result, nil
+}
+
+func doUpdateWorkflow(ctx context.Context, tx *ent.Tx, wf *api.Workflow, force bool) (*api.Workflow, error) {
+	log := logging.LoggerFromCtx(ctx)
+
+	entity, err := tx.Workflow.
+		Query().
+		Where(workflow.Name(wf.Name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(fmt.Errorf("workflow with name %s not found", wf.Name), ftag.With(ftag.NotFound))
+		}
+		log.Error().Err(err).Msg("Failed to fetch workflow")
+		return nil, fault.Wrap(err, ftag.With(ftag.Internal))
+	}
+	existing := convertWorkflow(entity)
+
+	// The workflow is updated before the jobs are counted: the update locks the workflow row until the transaction
+	// ends, hence jobs which are created for the workflow in the meantime (and thus reference the row) are either
+	// counted or wait for the update.
+	updated, err := entity.Update().
+		SetStates(wf.States).
+		SetTransitions(wf.Transitions).
+		SetGroups(wf.Groups).
+		SetDescription(wf.Description).
+		Save(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update workflow")
+		return nil, fault.Wrap(err, ftag.With(ftag.Internal))
+	}
+
+	if !force {
+		query := tx.Job.Query().Where(job.HasWorkflowWith(workflow.ID(entity.ID)))
+		if finalStates := wfutil.FindFinalStates(&existing); len(finalStates) > 0 {
+			args := make([]any, 0, len(finalStates))
+			for _, state := range finalStates {
+				args = append(args, state)
+			}
+			query.Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueNotIn(job.FieldStatus, args, sqljson.Path("state")))
+			})
+		}
+		active, err := query.Count(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to count active jobs")
+			return nil, fault.Wrap(err, ftag.With(ftag.Internal))
+		}
+		if active > 0 {
+			return nil, fault.Wrap(fmt.Errorf("workflow '%s' is used by %d job(s) in a non-final state", wf.Name, active),
+				ftag.With(errkind.Conflict))
+		}
+	}
+
+	result := convertWorkflow(updated)
+	return &result, nil
+}
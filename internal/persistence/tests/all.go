@@ -23,6 +23,7 @@ var AllTests = []PersistenceTest{
 	TestJobDeleteTags,
 	TestJobDeleteTagsNonExisting,
 	TestJobReuseExistingTags,
+	TestJobStats,
 	TestJobsPagination,
 	TestQueryJobsFilter,
 	TestQueryWorkflows,
@@ -31,5 +32,8 @@ var AllTests = []PersistenceTest{
 	TestUpdateJobStatus,
 	TestUpdateJobStatusNonExisting,
 	TestUpdateJobStatusStaleView,
+	TestUpdateWorkflow,
+	TestWorkflowStats,
+	TestWorkflowStatsTagUpdate,
 	TestWorkflowsPagination,
 }
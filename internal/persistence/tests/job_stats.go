@@ -0,0 +1,141 @@
+//go:build testing
+
+package tests
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Southclaws/fault/ftag"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobStats(t *testing.T, db persistence.Storage) {
+	wf := dau.DirectWorkflow()
+	_, err := db.CreateWorkflow(t.Context(), wf)
+	require.NoError(t, err)
+
+	base := time.Date(2026, 1, 1, 10, 15, 0, 0, time.UTC)
+	for _, j := range []struct {
+		clientID string
+		state    string
+		tags     []string
+		stime    time.Time
+	}{
+		{clientID: "a", state: "INSTALL", tags: []string{"foo", "bar"}, stime: base},
+		{clientID: "a", state: "INSTALL", tags: []string{"foo"}, stime: base.Add(30 * time.Minute)},
+		{clientID: "b", state: "ACTIVATED", stime: base.Add(2 * time.Hour)},
+	} {
+		job := api.Job{
+			ClientID: j.clientID,
+			Workflow: wf,
+			Status:   &api.JobStatus{State: j.state},
+			Stime:    &j.stime,
+		}
+		if len(j.tags) > 0 {
+			job.Tags = &j.tags
+		}
+		_, err := db.CreateJob(t.Context(), &job)
+		require.NoError(t, err)
+	}
+
+	{ // no grouping
+		stats, err := db.JobStats(t.Context(), persistence.FilterParams{}, persistence.StatsParams{})
+		require.NoError(t, err)
+		assert.Equal(t, []api.JobStatsEntry{{Count: 3}}, stats.Content)
+	}
+
+	{ // group by state
+		stats, err := db.JobStats(t.Context(), persistence.FilterParams{}, persistence.StatsParams{
+			GroupBy: []api.JobStatsProperty{api.JobStatsPropertyState},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []api.JobStatsEntry{
+			{State: ptr("ACTIVATED"), Count: 1},
+			{State: ptr("INSTALL"), Count: 2},
+		}, stats.Content)
+	}
+
+	{ // group by workflow and group; duplicates are ignored
+		stats, err := db.JobStats(t.Context(), persistence.FilterParams{}, persistence.StatsParams{
+			GroupBy: []api.JobStatsProperty{api.JobStatsPropertyWorkflow, api.JobStatsPropertyGroup, api.JobStatsPropertyWorkflow},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []api.JobStatsEntry{
+			{Workflow: &wf.Name, Group: ptr("CLOSED"), Count: 1},
+			{Workflow: &wf.Name, Group: ptr("OPEN"), Count: 2},
+		}, stats.Content)
+	}
+
+	{ // group by workflow, state and group (as used by the job metrics)
+		stats, err := db.JobStats(t.Context(), persistence.FilterParams{}, persistence.StatsParams{
+			GroupBy: []api.JobStatsProperty{api.JobStatsPropertyWorkflow, api.JobStatsPropertyState, api.JobStatsPropertyGroup},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []api.JobStatsEntry{
+			{Workflow: &wf.Name, State: ptr("ACTIVATED"), Group: ptr("CLOSED"), Count: 1},
+			{Workflow: &wf.Name, State: ptr("INSTALL"), Group: ptr("OPEN"), Count: 2},
+		}, stats.Content)
+	}
+
+	{ // group by tag, jobs without tags are counted with an empty tag
+		stats, err := db.JobStats(t.Context(), persistence.FilterParams{}, persistence.StatsParams{
+			GroupBy: []api.JobStatsProperty{api.JobStatsPropertyTag},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []api.JobStatsEntry{
+			{Tag: ptr(""), Count: 1},
+			{Tag: ptr("bar"), Count: 1},
+			{Tag: ptr("foo"), Count: 2},
+		}, stats.Content)
+	}
+
+	{ // filter by tags; each job is counted once
+		stats, err := db.JobStats(t.Context(), persistence.FilterParams{Tags: []string{"foo", "bar"}}, persistence.StatsParams{
+			GroupBy: []api.JobStatsProperty{api.JobStatsPropertyClientId},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []api.JobStatsEntry{{ClientID: ptr("a"), Count: 2}}, stats.Content)
+	}
+
+	{ // filter by client and state
+		stats, err := db.JobStats(t.Context(), persistence.FilterParams{ClientID: ptr("b"), Group: []string{"CLOSED"}}, persistence.StatsParams{
+			GroupBy: []api.JobStatsProperty{api.JobStatsPropertyState},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []api.JobStatsEntry{{State: ptr("ACTIVATED"), Count: 1}}, stats.Content)
+	}
+
+	{ // bucketed by stime
+		stats, err := db.JobStats(t.Context(), persistence.FilterParams{}, persistence.StatsParams{
+			Bucket: &persistence.TimeBucket{Field: api.Stime, Interval: time.Hour},
+		})
+		require.NoError(t, err)
+		require.Len(t, stats.Content, 2)
+		assert.True(t, time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC).Equal(*stats.Content[0].Bucket))
+		assert.Equal(t, int64(2), stats.Content[0].Count)
+		assert.True(t, time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC).Equal(*stats.Content[1].Bucket))
+		assert.Equal(t, int64(1), stats.Content[1].Count)
+	}
+
+	{ // invalid interval
+		_, err := db.JobStats(t.Context(), persistence.FilterParams{}, persistence.StatsParams{
+			Bucket: &persistence.TimeBucket{Field: api.Mtime, Interval: time.Millisecond},
+		})
+		assert.Equal(t, ftag.InvalidArgument, ftag.Get(err))
+	}
+}
+
+func ptr(s string) *string { return &s }
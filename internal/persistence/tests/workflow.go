@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/Southclaws/fault/ftag"
+	"github.com/siemens/wfx/internal/errkind"
 	"github.com/siemens/wfx/persistence"
 	"github.com/siemens/wfx/workflow/dau"
 	"github.com/stretchr/testify/assert"
@@ -124,3 +125,45 @@ func TestQueryWorkflowsSort(t *testing.T, db persistence.Storage) {
 		assert.IsDecreasing(t, keys)
 	})
 }
+
+func TestUpdateWorkflow(t *testing.T, db persistence.Storage) {
+	tmpJob := newValidJob(defaultClientID)
+	_, err := db.CreateWorkflow(context.Background(), tmpJob.Workflow)
+	require.NoError(t, err)
+	job, err := db.CreateJob(context.Background(), tmpJob)
+	require.NoError(t, err)
+
+	updated := dau.PhasedWorkflow()
+	updated.Name = tmpJob.Workflow.Name
+	updated.Description = "updated"
+
+	// the job is in a non-final state
+	actual, err := db.UpdateWorkflow(context.Background(), updated, false)
+	assert.Nil(t, actual)
+	assert.Equal(t, errkind.Conflict, ftag.Get(err))
+	fetched, err := db.GetWorkflow(context.Background(), updated.Name)
+	require.NoError(t, err)
+	assert.NotEqual(t, "updated", fetched.Description)
+
+	actual, err = db.UpdateWorkflow(context.Background(), updated, true)
+	require.NoError(t, err)
+	assert.Equal(t, updated, actual)
+
+	fetched, err = db.GetWorkflow(context.Background(), updated.Name)
+	require.NoError(t, err)
+	assert.Equal(t, updated, fetched)
+
+	// jobs keep referencing the workflow and see the new definition
+	job, err = db.GetJob(context.Background(), job.ID, persistence.FetchParams{})
+	require.NoError(t, err)
+	assert.Equal(t, "updated", job.Workflow.Description)
+
+	{
+		t.Log("Updating non-existing workflow")
+		wf := dau.DirectWorkflow()
+		wf.Name = "TestUpdateWorkflowNotFound"
+		actual, err := db.UpdateWorkflow(context.Background(), wf, false)
+		assert.Nil(t, actual)
+		assert.Equal(t, ftag.NotFound, ftag.Get(err))
+	}
+}
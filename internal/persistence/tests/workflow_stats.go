@@ -0,0 +1,142 @@
+//go:build testing
+
+package tests
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Southclaws/fault/ftag"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStats(t *testing.T, db persistence.Storage) {
+	wf := dau.PhasedWorkflow()
+	_, err := db.CreateWorkflow(t.Context(), wf)
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i, path := range [][]string{
+		{"CREATED", "DOWNLOAD", "DOWNLOADING", "DOWNLOADING", "DOWNLOADED"},
+		{"CREATED", "DOWNLOAD", "DOWNLOADING"},
+		{"CREATED"},
+	} {
+		tags := []string{"all"}
+		if i == 0 {
+			tags = append(tags, "first")
+		}
+		job, err := db.CreateJob(t.Context(), &api.Job{
+			ClientID: "foo",
+			Workflow: wf,
+			Status:   &api.JobStatus{State: path[0]},
+			Tags:     &tags,
+		})
+		require.NoError(t, err)
+		for j, state := range path[1:] {
+			progress := int32(j)
+			job, err = db.UpdateJob(t.Context(), job, persistence.JobUpdate{
+				Status: &api.JobStatus{State: state, Progress: &progress},
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	{ // all jobs
+		stats, err := db.WorkflowStats(t.Context(), wf.Name, persistence.WorkflowStatsParams{})
+		require.NoError(t, err)
+		assert.Equal(t, wf.Name, stats.Workflow)
+		assert.Equal(t, int64(2), stats.Jobs)
+		assert.Equal(t, []api.TransitionStats{
+			{From: "CREATED", To: "DOWNLOAD", Count: 2},
+			{From: "DOWNLOAD", To: "DOWNLOADING", Count: 2},
+			{From: "DOWNLOADING", To: "DOWNLOADED", Count: 1},
+		}, stats.Transitions)
+		require.Len(t, stats.States, 3)
+		assert.Equal(t, "CREATED", stats.States[0].State)
+		assert.Equal(t, int64(2), stats.States[0].Count)
+		assert.Equal(t, "DOWNLOADING", stats.States[2].State)
+		assert.Equal(t, int64(1), stats.States[2].Count)
+		for _, s := range stats.States {
+			assert.GreaterOrEqual(t, s.P50, 0.0)
+			assert.LessOrEqual(t, s.P50, s.P95)
+			assert.LessOrEqual(t, s.P95, s.P99)
+			assert.Less(t, s.P99, time.Since(start).Seconds())
+		}
+	}
+
+	{ // tag filter
+		stats, err := db.WorkflowStats(t.Context(), wf.Name, persistence.WorkflowStatsParams{Tags: []string{"first"}})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), stats.Jobs)
+		assert.Len(t, stats.Transitions, 3)
+	}
+
+	{ // time window without any transitions
+		until := start.Add(-time.Hour)
+		stats, err := db.WorkflowStats(t.Context(), wf.Name, persistence.WorkflowStatsParams{Until: &until})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), stats.Jobs)
+		assert.Empty(t, stats.States)
+		assert.Empty(t, stats.Transitions)
+
+		since := time.Now().Add(time.Hour)
+		stats, err = db.WorkflowStats(t.Context(), wf.Name, persistence.WorkflowStatsParams{Since: &since})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), stats.Jobs)
+	}
+
+	{ // unknown workflow
+		_, err := db.WorkflowStats(t.Context(), "does.not.exist", persistence.WorkflowStatsParams{})
+		assert.Equal(t, ftag.NotFound, ftag.Get(err))
+	}
+}
+
+func TestWorkflowStatsTagUpdate(t *testing.T, db persistence.Storage) {
+	wf := dau.PhasedWorkflow()
+	_, err := db.CreateWorkflow(t.Context(), wf)
+	require.NoError(t, err)
+
+	job, err := db.CreateJob(t.Context(), &api.Job{
+		ClientID: "foo",
+		Workflow: wf,
+		Status:   &api.JobStatus{State: "CREATED"},
+	})
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	job, err = db.UpdateJob(t.Context(), job, persistence.JobUpdate{Status: &api.JobStatus{State: "DOWNLOAD"}})
+	require.NoError(t, err)
+	time.Sleep(400 * time.Millisecond)
+	// modifies the job without changing its status
+	job, err = db.UpdateJob(t.Context(), job, persistence.JobUpdate{AddTags: &[]string{"foo"}})
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	_, err = db.UpdateJob(t.Context(), job, persistence.JobUpdate{Status: &api.JobStatus{State: "DOWNLOADING"}})
+	require.NoError(t, err)
+
+	stats, err := db.WorkflowStats(t.Context(), wf.Name, persistence.WorkflowStatsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, []api.TransitionStats{
+		{From: "CREATED", To: "DOWNLOAD", Count: 1},
+		{From: "DOWNLOAD", To: "DOWNLOADING", Count: 1},
+	}, stats.Transitions)
+	require.Len(t, stats.States, 2)
+	assert.Equal(t, "CREATED", stats.States[0].State)
+	assert.GreaterOrEqual(t, stats.States[0].P50, 0.1)
+	assert.Less(t, stats.States[0].P50, 0.4)
+	// the job entered DOWNLOAD before its tags were updated
+	assert.Equal(t, "DOWNLOAD", stats.States[1].State)
+	assert.GreaterOrEqual(t, stats.States[1].P50, 0.5)
+}
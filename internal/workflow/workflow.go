@@ -9,6 +9,7 @@ package workflow
  */
 
 import (
+	"fmt"
 	"slices"
 	"sort"
 
@@ -27,6 +28,13 @@ func FindStateGroup(workflow *api.Workflow, state string) string {
 
 // FollowImmediateTransitions follows the edges of type `actor` starting at the `from` state.
 func FollowImmediateTransitions(workflow *api.Workflow, from string) string {
+	path := ImmediatePath(workflow, from)
+	return path[len(path)-1]
+}
+
+// ImmediatePath returns the states visited when following the immediate WFX transitions starting at the `from` state,
+// including `from` itself.
+func ImmediatePath(workflow *api.Workflow, from string) []string {
 	// map of transitions which we handle
 	jump := make(map[string]string, len(workflow.Transitions))
 	for _, t := range workflow.Transitions {
@@ -35,18 +43,41 @@ func FollowImmediateTransitions(workflow *api.Workflow, from string) string {
 		}
 	}
 
+	path := []string{from}
 	current := from
 	for {
 		// follow the path
 		to, ok := jump[current]
 		if !ok {
 			// we have reached the final destination
-			return current
+			return path
 		}
+		path = append(path, to)
 		current = to
 	}
 }
 
+// CheckTransition checks whether the actor is allowed to move a job from one state to another.
+// Trivial transitions (from == to) are always allowed.
+func CheckTransition(workflow *api.Workflow, from string, to string, actor api.EligibleEnum) error {
+	if from == to {
+		return nil
+	}
+	found := false
+	for _, t := range workflow.Transitions {
+		if t.From == from && t.To == to {
+			found = true
+			if t.Eligible == actor {
+				return nil
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("transition from '%s' to '%s' does not exist", from, to)
+	}
+	return fmt.Errorf("transition from '%s' to '%s' is not allowed for actor '%s'", from, to, actor)
+}
+
 func FindInitialState(workflow *api.Workflow) *string {
 	parent := make(map[string]string, len(workflow.States))
 	for _, state := range workflow.States {
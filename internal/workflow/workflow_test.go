@@ -46,6 +46,22 @@ func TestFollowTransitions(t *testing.T) {
 	assert.Equal(t, d, actual, "should warp from a to d")
 }
 
+func TestImmediatePath(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	assert.Equal(t, []string{"INSTALLED", "ACTIVATE"}, ImmediatePath(wf, "INSTALLED"))
+	assert.Equal(t, []string{"INSTALL"}, ImmediatePath(wf, "INSTALL"))
+}
+
+func TestCheckTransition(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	assert.NoError(t, CheckTransition(wf, "INSTALL", "INSTALLING", api.CLIENT))
+	assert.NoError(t, CheckTransition(wf, "INSTALL", "INSTALL", api.WFX))
+	assert.EqualError(t, CheckTransition(wf, "INSTALL", "INSTALLING", api.WFX),
+		"transition from 'INSTALL' to 'INSTALLING' is not allowed for actor 'WFX'")
+	assert.EqualError(t, CheckTransition(wf, "INSTALL", "ACTIVATED", api.CLIENT),
+		"transition from 'INSTALL' to 'ACTIVATED' does not exist")
+}
+
 func TestFindInitialState(t *testing.T) {
 	wf := dau.DirectWorkflow()
 	initial := FindInitialState(wf)
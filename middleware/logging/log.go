@@ -20,6 +20,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type key int
@@ -37,7 +38,7 @@ func NewLoggingMiddleware() func(http.Handler) http.Handler {
 			if r.URL != nil {
 				path = r.URL.Path
 			}
-			contextLogger := log.With().
+			contextLogger := LoggerFromCtx(r.Context()).With().
 				Str("reqID", reqID).
 				Str("remoteAddr", r.RemoteAddr).
 				Str("method", r.Method).
@@ -83,11 +84,21 @@ func NewLoggingMiddleware() func(http.Handler) http.Handler {
 	}
 }
 
+// LoggerFromCtx returns the request logger stored in the context (or the
+// global logger). If the context carries a valid trace span, its trace and span
+// IDs are added to the logger.
 func LoggerFromCtx(ctx context.Context) zerolog.Logger {
-	if log, ok := ctx.Value(KeyRequestLogger).(zerolog.Logger); ok {
-		return log
+	logger := log.Logger
+	if l, ok := ctx.Value(KeyRequestLogger).(zerolog.Logger); ok {
+		logger = l
 	}
-	return log.Logger
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		logger = logger.With().
+			Str("traceID", sc.TraceID().String()).
+			Str("spanID", sc.SpanID().String()).
+			Logger()
+	}
+	return logger
 }
 
 func PeekBody(r *http.Request) ([]byte, error) {
@@ -9,6 +9,7 @@ package logging
  */
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestLog(t *testing.T) {
@@ -71,6 +73,21 @@ func TestLoggerFomCtx_Default(t *testing.T) {
 	assert.Equal(t, log.Logger, actual)
 }
 
+func TestLoggerFomCtx_TraceID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	ctx := context.WithValue(context.Background(), KeyRequestLogger, logger)
+	traceID := trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	spanID := trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+	ctx = trace.ContextWithSpanContext(ctx, sc)
+
+	actual := LoggerFromCtx(ctx)
+	actual.Info().Msg("hello")
+	assert.Contains(t, buf.String(), `"traceID":"4bf92f3577b34da6a3ce929d0e0e4736"`)
+	assert.Contains(t, buf.String(), `"spanID":"00f067aa0ba902b7"`)
+}
+
 type FaultyReadCloser struct{}
 
 func (r FaultyReadCloser) Read([]byte) (n int, err error) {
@@ -19,21 +19,28 @@ import (
 	"github.com/Southclaws/fault"
 )
 
-type responseWriter struct {
+// ResponseWriter wraps an http.ResponseWriter and records the status code (and
+// optionally the body) of the response.
+type ResponseWriter struct {
 	responseBody bytes.Buffer
 	bodyWriter   io.Writer
 	httpWriter   http.ResponseWriter
 	statusCode   int
 }
 
-// responseWriter implements the following interfaces (compile-time check):
+// ResponseWriter implements the following interfaces (compile-time check):
 var (
-	_ http.Flusher  = (*responseWriter)(nil)
-	_ http.Hijacker = (*responseWriter)(nil)
+	_ http.Flusher  = (*ResponseWriter)(nil)
+	_ http.Hijacker = (*ResponseWriter)(nil)
 )
 
-func newMyResponseWriter(w http.ResponseWriter, interceptBody bool) *responseWriter {
-	var result responseWriter
+// NewResponseWriter wraps w in order to record the status code of the response.
+func NewResponseWriter(w http.ResponseWriter) *ResponseWriter {
+	return newMyResponseWriter(w, false)
+}
+
+func newMyResponseWriter(w http.ResponseWriter, interceptBody bool) *ResponseWriter {
+	var result ResponseWriter
 	if interceptBody {
 		result.bodyWriter = io.MultiWriter(w, &result.responseBody)
 	} else {
@@ -43,31 +50,47 @@ func newMyResponseWriter(w http.ResponseWriter, interceptBody bool) *responseWri
 	return &result
 }
 
-func (w *responseWriter) Header() http.Header {
+func (w *ResponseWriter) Header() http.Header {
 	return w.httpWriter.Header()
 }
 
-func (w *responseWriter) WriteHeader(statusCode int) {
+func (w *ResponseWriter) WriteHeader(statusCode int) {
 	w.statusCode = statusCode
 	w.httpWriter.WriteHeader(statusCode)
 }
 
-func (w *responseWriter) Write(b []byte) (int, error) {
+// StatusCode returns the status code sent to the client. If the handler did not
+// call WriteHeader explicitly, http.StatusOK is assumed.
+func (w *ResponseWriter) StatusCode() int {
+	if w.statusCode == 0 {
+		return http.StatusOK
+	}
+	return w.statusCode
+}
+
+func (w *ResponseWriter) Write(b []byte) (int, error) {
 	n, err := w.bodyWriter.Write(b)
 	return n, fault.Wrap(err)
 }
 
 // Flush is used by the server-sent events implementation to flush a single event to the client.
 // This is part of the http.Flusher interface.
-func (w *responseWriter) Flush() {
-	flusher := w.httpWriter.(http.Flusher)
-	flusher.Flush()
+func (w *ResponseWriter) Flush() {
+	_ = w.FlushError()
+}
+
+// FlushError flushes the response to the client; it is used by http.ResponseController.
+// A flushed response is streamed, hence its body is no longer recorded.
+func (w *ResponseWriter) FlushError() error {
+	w.bodyWriter = w.httpWriter
+	w.responseBody.Reset()
+	return fault.Wrap(http.NewResponseController(w.httpWriter).Flush())
 }
 
 // Hijack allows an HTTP handler to take over the underlying connection.
-// This is used by the server-sent events implementation to keep the long-running (idle) connection open.
+// This is used by the WebSocket implementation.
 // NOTE: The "funny" name comes from Golang's http.Hijacker interface.
-func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+func (w *ResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	hj, ok := w.httpWriter.(http.Hijacker)
 	if !ok {
 		return nil, nil, errors.New("hijacker interface not supported")
@@ -75,3 +98,9 @@ func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	conn, bw, err := hj.Hijack()
 	return conn, bw, fault.Wrap(err)
 }
+
+// Unwrap returns the underlying writer; this is used by http.ResponseController,
+// e.g. to set the write deadline of a stream.
+func (w *ResponseWriter) Unwrap() http.ResponseWriter {
+	return w.httpWriter
+}
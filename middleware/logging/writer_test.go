@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -48,6 +49,26 @@ func TestWriterImplementsFlusher(t *testing.T) {
 	flusher.Flush()
 }
 
+func TestWriterFlushStopsRecording(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	w := newMyResponseWriter(recorder, true)
+	_, _ = w.Write([]byte("data: 1\n\n"))
+	require.NoError(t, http.NewResponseController(w).Flush())
+	_, _ = w.Write([]byte("data: 2\n\n"))
+
+	assert.True(t, recorder.Flushed)
+	assert.Equal(t, "data: 1\n\ndata: 2\n\n", recorder.Body.String())
+	assert.Empty(t, w.responseBody.String())
+}
+
+func TestWriterUnwrap(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	w := NewResponseWriter(recorder)
+	assert.Same(t, recorder, w.Unwrap())
+	// the recorder does not support deadlines
+	assert.ErrorIs(t, http.NewResponseController(w).SetWriteDeadline(time.Now()), http.ErrNotSupported)
+}
+
 func TestWriterIgnoreBody(t *testing.T) {
 	recorder := httptest.NewRecorder()
 	w := newMyResponseWriter(recorder, false)
@@ -55,3 +76,11 @@ func TestWriterIgnoreBody(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, w.responseBody.String())
 }
+
+func TestWriterStatusCode(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	w := NewResponseWriter(recorder)
+	assert.Equal(t, http.StatusOK, w.StatusCode())
+	w.WriteHeader(http.StatusNotFound)
+	assert.Equal(t, http.StatusNotFound, w.StatusCode())
+}
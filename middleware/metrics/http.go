@@ -0,0 +1,54 @@
+package metrics
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/siemens/wfx/middleware/logging"
+)
+
+// NewMetricsMiddleware creates a middleware which records the number and
+// duration of requests. The operation is the route pattern matched by the
+// router, e.g. "GET /api/wfx/v1/jobs/{id}".
+func NewMetricsMiddleware(server string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			writer := logging.NewResponseWriter(w)
+			next.ServeHTTP(writer, r)
+			duration := time.Since(start)
+
+			operation := r.Pattern
+			if operation == "" {
+				operation = "unknown"
+			}
+			code := strconv.Itoa(writer.StatusCode())
+			httpRequests.WithLabelValues(server, operation, code).Inc()
+			httpDuration.WithLabelValues(server, operation, code).Observe(duration.Seconds())
+		})
+	}
+}
+
+// ObservePluginRoundtrip records the time it took for the plugin to respond to a request.
+func ObservePluginRoundtrip(plugin string, duration time.Duration) {
+	pluginDuration.WithLabelValues(plugin).Observe(duration.Seconds())
+}
+
+// IncPluginFailures counts a failed plugin request.
+func IncPluginFailures(plugin string, reason string) {
+	pluginFailures.WithLabelValues(plugin, reason).Inc()
+}
+
+// IncPluginRestarts counts a restart of the plugin.
+func IncPluginRestarts(plugin string) {
+	pluginRestarts.WithLabelValues(plugin).Inc()
+}
@@ -0,0 +1,58 @@
+package metrics
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /jobs/{id}", NewMetricsMiddleware("test")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})))
+
+	for range 2 {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jobs/42", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(httpRequests.WithLabelValues("test", "GET /jobs/{id}", "404")))
+}
+
+func TestMetricsMiddleware_DefaultStatus(t *testing.T) {
+	handler := NewMetricsMiddleware("test")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, 1.0, testutil.ToFloat64(httpRequests.WithLabelValues("test", "unknown", "200")))
+}
+
+func TestObservePluginRoundtrip(t *testing.T) {
+	ObservePluginRoundtrip("TestObservePluginRoundtrip", time.Millisecond)
+	assert.Equal(t, 1, testutil.CollectAndCount(pluginDuration, "wfx_plugin_roundtrip_duration_seconds"))
+}
+
+func TestIncPluginFailures(t *testing.T) {
+	IncPluginFailures("TestIncPluginFailures", "timeout")
+	assert.Equal(t, 1.0, testutil.ToFloat64(pluginFailures.WithLabelValues("TestIncPluginFailures", "timeout")))
+}
+
+func TestIncPluginRestarts(t *testing.T) {
+	IncPluginRestarts("TestIncPluginRestarts")
+	assert.Equal(t, 1.0, testutil.ToFloat64(pluginRestarts.WithLabelValues("TestIncPluginRestarts")))
+}
@@ -0,0 +1,118 @@
+package metrics
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/rs/zerolog/log"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+)
+
+// the labels of the job gauges
+var jobsGroupBy = []api.JobStatsProperty{api.JobStatsPropertyWorkflow, api.JobStatsPropertyState, api.JobStatsPropertyGroup}
+
+// JobCollector periodically refreshes the gauges counting the jobs per workflow, state and group.
+type JobCollector struct {
+	storage  persistence.Storage
+	interval time.Duration
+
+	once   sync.Once
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// mutex protects series
+	mutex sync.Mutex
+	// series contains the label values of the gauges set by the last refresh
+	series map[jobSeries]struct{}
+}
+
+// jobSeries are the label values of a job gauge.
+type jobSeries struct {
+	workflow, state, group string
+}
+
+// NewJobCollector creates a new collector. Call Start to begin refreshing the gauges.
+func NewJobCollector(storage persistence.Storage, interval time.Duration) *JobCollector {
+	return &JobCollector{storage: storage, interval: interval}
+}
+
+// Start refreshes the gauges in the background until Stop is called.
+// A non-positive interval disables the collector.
+func (c *JobCollector) Start() {
+	if c.interval <= 0 {
+		log.Debug().Msg("Job metrics are disabled")
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.wg.Go(func() {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			if err := c.Refresh(ctx); err != nil && ctx.Err() == nil {
+				log.Warn().Err(err).Msg("Failed to refresh job metrics")
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// Stop stops refreshing the gauges. It's safe to call this method multiple times.
+func (c *JobCollector) Stop() {
+	c.once.Do(func() {
+		if c.cancel != nil {
+			c.cancel()
+		}
+		c.wg.Wait()
+	})
+}
+
+// Refresh counts the jobs in the storage (a single query grouped by workflow, state
+// and group) and updates the gauges. Gauges of combinations which no longer exist are
+// removed, the others are updated in place, i.e. a scrape never sees partial results.
+func (c *JobCollector) Refresh(ctx context.Context) error {
+	start := time.Now()
+	stats, err := c.storage.JobStats(ctx, persistence.FilterParams{}, persistence.StatsParams{GroupBy: jobsGroupBy})
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	series := make(map[jobSeries]struct{}, len(stats.Content))
+	for _, entry := range stats.Content {
+		s := jobSeries{workflow: deref(entry.Workflow), state: deref(entry.State), group: deref(entry.Group)}
+		jobs.WithLabelValues(s.workflow, s.state, s.group).Set(float64(entry.Count))
+		series[s] = struct{}{}
+	}
+	for s := range c.series {
+		if _, ok := series[s]; !ok {
+			jobs.DeleteLabelValues(s.workflow, s.state, s.group)
+		}
+	}
+	c.series = series
+	log.Debug().Dur("duration", time.Since(start)).Int("series", len(stats.Content)).Msg("Refreshed job metrics")
+	return nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
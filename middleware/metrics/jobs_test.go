@@ -0,0 +1,95 @@
+package metrics
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobCollector_Refresh(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	stats := api.JobStats{Content: []api.JobStatsEntry{
+		{Workflow: &wf.Name, State: ptr("INSTALL"), Group: ptr("OPEN"), Count: 2},
+		{Workflow: &wf.Name, State: ptr("ACTIVATED"), Group: ptr("CLOSED"), Count: 1},
+	}}
+
+	dbMock := persistence.NewMockStorage(t)
+	dbMock.EXPECT().
+		JobStats(mock.Anything, persistence.FilterParams{}, persistence.StatsParams{GroupBy: jobsGroupBy}).
+		Return(&stats, nil)
+
+	collector := NewJobCollector(dbMock, time.Minute)
+	require.NoError(t, collector.Refresh(t.Context()))
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(jobs.WithLabelValues(wf.Name, "INSTALL", "OPEN")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(jobs.WithLabelValues(wf.Name, "ACTIVATED", "CLOSED")))
+}
+
+func TestJobCollector_RefreshRemovesStale(t *testing.T) {
+	wf := dau.DirectWorkflow()
+	dbMock := persistence.NewMockStorage(t)
+	dbMock.EXPECT().
+		JobStats(mock.Anything, mock.Anything, mock.Anything).
+		Return(&api.JobStats{Content: []api.JobStatsEntry{
+			{Workflow: &wf.Name, State: ptr("INSTALL"), Group: ptr("OPEN"), Count: 1},
+		}}, nil).Once()
+	dbMock.EXPECT().
+		JobStats(mock.Anything, mock.Anything, mock.Anything).
+		Return(&api.JobStats{Content: []api.JobStatsEntry{
+			{Workflow: &wf.Name, State: ptr("ACTIVATED"), Group: ptr("CLOSED"), Count: 1},
+		}}, nil).Once()
+
+	collector := NewJobCollector(dbMock, time.Minute)
+	require.NoError(t, collector.Refresh(t.Context()))
+	require.NoError(t, collector.Refresh(t.Context()))
+
+	assert.False(t, jobs.DeleteLabelValues(wf.Name, "INSTALL", "OPEN"), "stale series must be removed")
+	assert.Equal(t, 1.0, testutil.ToFloat64(jobs.WithLabelValues(wf.Name, "ACTIVATED", "CLOSED")))
+}
+
+func TestJobCollector_RefreshError(t *testing.T) {
+	dbMock := persistence.NewMockStorage(t)
+	dbMock.EXPECT().
+		JobStats(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("failure"))
+
+	collector := NewJobCollector(dbMock, time.Minute)
+	assert.Error(t, collector.Refresh(t.Context()))
+}
+
+func TestJobCollector_StartStop(t *testing.T) {
+	dbMock := persistence.NewMockStorage(t)
+	dbMock.EXPECT().
+		JobStats(mock.Anything, mock.Anything, mock.Anything).
+		Return(new(api.JobStats), nil).Maybe()
+
+	collector := NewJobCollector(dbMock, time.Millisecond)
+	collector.Start()
+	time.Sleep(10 * time.Millisecond)
+	collector.Stop()
+	collector.Stop()
+}
+
+func TestJobCollector_Disabled(t *testing.T) {
+	collector := NewJobCollector(nil, 0)
+	collector.Start()
+	collector.Stop()
+}
+
+func ptr(s string) *string { return &s }
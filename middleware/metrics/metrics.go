@@ -0,0 +1,120 @@
+package metrics
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
+)
+
+const namespace = "wfx"
+
+// Registry contains all metrics exported by wfx.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Total number of HTTP requests by server, operation and status code.",
+	}, []string{"server", "operation", "code"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by server, operation and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"server", "operation", "code"})
+
+	storageDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "storage",
+		Name:      "call_duration_seconds",
+		Help:      "Duration of storage calls by method and result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "result"})
+
+	pluginDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "plugin",
+		Name:      "roundtrip_duration_seconds",
+		Help:      "Round-trip time of plugin requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"plugin"})
+
+	pluginFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "plugin",
+		Name:      "failures_total",
+		Help:      "Number of plugin requests which failed, e.g. due to a timeout.",
+	}, []string{"plugin", "reason"})
+
+	pluginRestarts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "plugin",
+		Name:      "restarts_total",
+		Help:      "Number of plugin restarts after the plugin exited unexpectedly.",
+	}, []string{"plugin"})
+
+	jobs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "jobs",
+		Help:      "Number of jobs by workflow, state and group.",
+	}, []string{"workflow", "state", "group"})
+
+	sseSubscribers = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "sse",
+		Name:      "subscribers",
+		Help:      "Number of subscribers for job events.",
+	}, func() float64 { return float64(events.SubscriberCount()) })
+
+	droppedNotifications = prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "hooks",
+		Name:      "dropped_notifications_total",
+		Help:      "Number of job events which were not delivered because a notifier could not keep up.",
+	}, func() float64 { return float64(hooks.DroppedNotifications()) })
+
+	sseBacklog = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "sse",
+		Name:      "backlog_events",
+		Help:      "Number of job events waiting in the backlogs of all subscribers.",
+	}, func() float64 { return float64(events.BacklogSize()) })
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		storageDuration,
+		pluginDuration,
+		pluginFailures,
+		pluginRestarts,
+		jobs,
+		sseSubscribers,
+		sseBacklog,
+		droppedNotifications,
+	)
+}
+
+// Handler returns an http.Handler which exposes the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
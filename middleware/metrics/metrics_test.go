@@ -0,0 +1,37 @@
+package metrics
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	_ = events.AddSubscriber(t.Context(), time.Minute, events.FilterParams{}, nil)
+	t.Cleanup(events.ShutdownSubscribers)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	result := rec.Result()
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+
+	body, err := io.ReadAll(result.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "wfx_sse_subscribers 1")
+	assert.Contains(t, string(body), "wfx_sse_backlog_events 0")
+	assert.Contains(t, string(body), "go_goroutines")
+}
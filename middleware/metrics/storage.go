@@ -0,0 +1,137 @@
+package metrics
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"time"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+)
+
+// compile-time check to ensure we fulfill the interface
+var _ persistence.Storage = (*instrumentedStorage)(nil)
+
+// instrumentedStorage is a decorator which records the latency of every storage call.
+type instrumentedStorage struct {
+	storage persistence.Storage
+}
+
+// InstrumentStorage wraps the storage so that the duration of each call is recorded.
+func InstrumentStorage(storage persistence.Storage) persistence.Storage {
+	return instrumentedStorage{storage: storage}
+}
+
+func observeStorage(method string, start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	storageDuration.WithLabelValues(method, result).Observe(time.Since(start).Seconds())
+}
+
+func (s instrumentedStorage) Initialize(options string) error {
+	return s.storage.Initialize(options)
+}
+
+func (s instrumentedStorage) Shutdown() {
+	s.storage.Shutdown()
+}
+
+func (s instrumentedStorage) CheckHealth(ctx context.Context) error {
+	start := time.Now()
+	err := s.storage.CheckHealth(ctx)
+	observeStorage("CheckHealth", start, err)
+	return err
+}
+
+func (s instrumentedStorage) CreateJob(ctx context.Context, job *api.Job) (*api.Job, error) {
+	start := time.Now()
+	result, err := s.storage.CreateJob(ctx, job)
+	observeStorage("CreateJob", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) GetJob(ctx context.Context, jobID string, fetchParams persistence.FetchParams) (*api.Job, error) {
+	start := time.Now()
+	result, err := s.storage.GetJob(ctx, jobID, fetchParams)
+	observeStorage("GetJob", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) UpdateJob(ctx context.Context, job *api.Job, request persistence.JobUpdate) (*api.Job, error) {
+	start := time.Now()
+	result, err := s.storage.UpdateJob(ctx, job, request)
+	observeStorage("UpdateJob", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) DeleteJob(ctx context.Context, jobID string) error {
+	start := time.Now()
+	err := s.storage.DeleteJob(ctx, jobID)
+	observeStorage("DeleteJob", start, err)
+	return err
+}
+
+func (s instrumentedStorage) QueryJobs(ctx context.Context, filterParams persistence.FilterParams, sortParams persistence.SortParams, paginationParams persistence.PaginationParams) (*api.PaginatedJobList, error) {
+	start := time.Now()
+	result, err := s.storage.QueryJobs(ctx, filterParams, sortParams, paginationParams)
+	observeStorage("QueryJobs", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) JobStats(ctx context.Context, filterParams persistence.FilterParams, statsParams persistence.StatsParams) (*api.JobStats, error) {
+	start := time.Now()
+	result, err := s.storage.JobStats(ctx, filterParams, statsParams)
+	observeStorage("JobStats", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) WorkflowStats(ctx context.Context, name string, statsParams persistence.WorkflowStatsParams) (*api.WorkflowStats, error) {
+	start := time.Now()
+	result, err := s.storage.WorkflowStats(ctx, name, statsParams)
+	observeStorage("WorkflowStats", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) CreateWorkflow(ctx context.Context, workflow *api.Workflow) (*api.Workflow, error) {
+	start := time.Now()
+	result, err := s.storage.CreateWorkflow(ctx, workflow)
+	observeStorage("CreateWorkflow", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) GetWorkflow(ctx context.Context, name string) (*api.Workflow, error) {
+	start := time.Now()
+	result, err := s.storage.GetWorkflow(ctx, name)
+	observeStorage("GetWorkflow", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) UpdateWorkflow(ctx context.Context, workflow *api.Workflow, force bool) (*api.Workflow, error) {
+	start := time.Now()
+	result, err := s.storage.UpdateWorkflow(ctx, workflow, force)
+	observeStorage("UpdateWorkflow", start, err)
+	return result, err
+}
+
+func (s instrumentedStorage) DeleteWorkflow(ctx context.Context, name string) error {
+	start := time.Now()
+	err := s.storage.DeleteWorkflow(ctx, name)
+	observeStorage("DeleteWorkflow", start, err)
+	return err
+}
+
+func (s instrumentedStorage) QueryWorkflows(ctx context.Context, sortParams persistence.SortParams, paginationParams persistence.PaginationParams) (*api.PaginatedWorkflowList, error) {
+	start := time.Now()
+	result, err := s.storage.QueryWorkflows(ctx, sortParams, paginationParams)
+	observeStorage("QueryWorkflows", start, err)
+	return result, err
+}
@@ -0,0 +1,74 @@
+package metrics
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInstrumentStorage(t *testing.T) {
+	dbMock := persistence.NewMockStorage(t)
+	ctx := t.Context()
+	job := &api.Job{ID: "1"}
+	wf := &api.Workflow{Name: "wfx.test"}
+	errFail := errors.New("failure")
+
+	dbMock.EXPECT().Initialize("opts").Return(nil)
+	dbMock.EXPECT().Shutdown().Return()
+	dbMock.EXPECT().CheckHealth(ctx).Return(nil)
+	dbMock.EXPECT().CreateJob(ctx, job).Return(job, nil)
+	dbMock.EXPECT().GetJob(ctx, "1", persistence.FetchParams{}).Return(job, nil)
+	dbMock.EXPECT().UpdateJob(ctx, job, persistence.JobUpdate{}).Return(job, nil)
+	dbMock.EXPECT().DeleteJob(ctx, "1").Return(errFail)
+	dbMock.EXPECT().QueryJobs(ctx, mock.Anything, mock.Anything, mock.Anything).Return(new(api.PaginatedJobList), nil)
+	dbMock.EXPECT().JobStats(ctx, mock.Anything, mock.Anything).Return(new(api.JobStats), nil)
+	dbMock.EXPECT().CreateWorkflow(ctx, wf).Return(wf, nil)
+	dbMock.EXPECT().GetWorkflow(ctx, wf.Name).Return(wf, nil)
+	dbMock.EXPECT().UpdateWorkflow(ctx, wf, false).Return(wf, nil)
+	dbMock.EXPECT().DeleteWorkflow(ctx, wf.Name).Return(nil)
+	dbMock.EXPECT().QueryWorkflows(ctx, mock.Anything, mock.Anything).Return(new(api.PaginatedWorkflowList), nil)
+	dbMock.EXPECT().WorkflowStats(ctx, wf.Name, mock.Anything).Return(new(api.WorkflowStats), nil)
+
+	storage := InstrumentStorage(dbMock)
+	assert.NoError(t, storage.Initialize("opts"))
+	assert.NoError(t, storage.CheckHealth(ctx))
+	_, err := storage.CreateJob(ctx, job)
+	assert.NoError(t, err)
+	_, err = storage.GetJob(ctx, "1", persistence.FetchParams{})
+	assert.NoError(t, err)
+	_, err = storage.UpdateJob(ctx, job, persistence.JobUpdate{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, storage.DeleteJob(ctx, "1"), errFail)
+	_, err = storage.QueryJobs(ctx, persistence.FilterParams{}, persistence.SortParams{}, persistence.PaginationParams{})
+	assert.NoError(t, err)
+	_, err = storage.JobStats(ctx, persistence.FilterParams{}, persistence.StatsParams{})
+	assert.NoError(t, err)
+	_, err = storage.CreateWorkflow(ctx, wf)
+	assert.NoError(t, err)
+	_, err = storage.GetWorkflow(ctx, wf.Name)
+	assert.NoError(t, err)
+	_, err = storage.UpdateWorkflow(ctx, wf, false)
+	assert.NoError(t, err)
+	assert.NoError(t, storage.DeleteWorkflow(ctx, wf.Name))
+	_, err = storage.QueryWorkflows(ctx, persistence.SortParams{}, persistence.PaginationParams{})
+	assert.NoError(t, err)
+	_, err = storage.WorkflowStats(ctx, wf.Name, persistence.WorkflowStatsParams{})
+	assert.NoError(t, err)
+	storage.Shutdown()
+
+	// one series per method (Initialize and Shutdown are not instrumented)
+	assert.Equal(t, 13, testutil.CollectAndCount(storageDuration))
+}
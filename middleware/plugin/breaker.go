@@ -0,0 +1,89 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker is a circuit breaker which stops sending requests to a failing plugin.
+//
+// After threshold consecutive failures the circuit opens and requests are
+// rejected right away. Once the cooldown has elapsed, a single request is let
+// through (half-open); its outcome decides whether the circuit closes again.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mutex    sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown}
+}
+
+// Allow reports whether a request may be sent to the plugin and whether the
+// request probes a half-open circuit. The outcome of a probe must be reported
+// by Success, Failure or Cancel.
+func (b *breaker) Allow() (allowed bool, probe bool) {
+	if b.threshold <= 0 {
+		return true, false
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.failures < b.threshold {
+		return true, false
+	}
+	if b.probing || time.Since(b.openedAt) < b.cooldown {
+		return false, false
+	}
+	b.probing = true
+	return true, true
+}
+
+// Cancel abandons a probe whose caller gave up before its outcome was known,
+// i.e. the next request probes the plugin instead.
+func (b *breaker) Cancel() {
+	b.mutex.Lock()
+	b.probing = false
+	b.mutex.Unlock()
+}
+
+// Success records a successful request and closes the circuit.
+func (b *breaker) Success() {
+	b.mutex.Lock()
+	b.failures = 0
+	b.probing = false
+	b.mutex.Unlock()
+}
+
+// Failure records a failed request; this (re-)opens the circuit once the threshold is reached.
+func (b *breaker) Failure() {
+	b.mutex.Lock()
+	b.failures++
+	b.probing = false
+	if b.threshold > 0 && b.failures >= b.threshold {
+		b.openedAt = time.Now()
+	}
+	b.mutex.Unlock()
+}
+
+// Open reports whether the circuit is open (or half-open).
+func (b *breaker) Open() bool {
+	if b.threshold <= 0 {
+		return false
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.failures >= b.threshold
+}
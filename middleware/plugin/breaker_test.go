@@ -0,0 +1,81 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func allowed(b *breaker) bool {
+	ok, _ := b.Allow()
+	return ok
+}
+
+func TestBreaker(t *testing.T) {
+	b := newBreaker(2, time.Hour)
+	assert.True(t, allowed(b))
+	b.Failure()
+	assert.False(t, b.Open())
+	assert.True(t, allowed(b))
+	b.Failure()
+	assert.True(t, b.Open())
+	assert.False(t, allowed(b))
+}
+
+func TestBreaker_SuccessResetsFailures(t *testing.T) {
+	b := newBreaker(2, time.Hour)
+	b.Failure()
+	b.Success()
+	b.Failure()
+	assert.False(t, b.Open())
+	assert.True(t, allowed(b))
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	b := newBreaker(1, 0)
+	b.Failure()
+	assert.True(t, b.Open())
+
+	// cooldown elapsed, a single probe is allowed
+	assert.True(t, allowed(b))
+	assert.False(t, allowed(b))
+
+	b.Success()
+	assert.False(t, b.Open())
+	assert.True(t, allowed(b))
+}
+
+func TestBreaker_CancelProbe(t *testing.T) {
+	b := newBreaker(1, 0)
+	b.Failure()
+
+	ok, probe := b.Allow()
+	assert.True(t, ok)
+	assert.True(t, probe)
+	assert.False(t, allowed(b))
+
+	// the caller of the probe gave up, hence the next request probes the plugin
+	b.Cancel()
+	ok, probe = b.Allow()
+	assert.True(t, ok)
+	assert.True(t, probe)
+	assert.True(t, b.Open())
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	b := newBreaker(0, time.Hour)
+	for range 10 {
+		b.Failure()
+	}
+	assert.False(t, b.Open())
+	assert.True(t, allowed(b))
+}
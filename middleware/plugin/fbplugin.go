@@ -20,43 +20,82 @@ import (
 
 	"github.com/Southclaws/fault"
 	"github.com/rs/zerolog/log"
-	genPlugin "github.com/siemens/wfx/generated/plugin"
 	"github.com/siemens/wfx/middleware/plugin/ioutil"
 )
 
-// compile-time check to ensure we fulfill the interface
-var _ Plugin = (*FBPlugin)(nil)
+// compile-time check to ensure we fulfill the interfaces
+var (
+	_ Plugin          = (*FBPlugin)(nil)
+	_ PhaseSubscriber = (*FBPlugin)(nil)
+)
 
 // FBPlugin is a plugin which communicates using FlatBuffer messages.
 type FBPlugin struct {
-	path string
+	path   string
+	args   []string
+	env    []string
+	phases Phase
+
+	mutex sync.Mutex
+	// current is the process started by the last call to Start
+	current *fbRun
+}
 
-	responses      map[uint64]chan genPlugin.PluginResponseT
-	responsesMutex sync.Mutex
+// fbRun is a single execution of the plugin process. Each run has its own
+// state, hence the goroutines of a previous run cannot interfere with a
+// restarted plugin.
+type fbRun struct {
+	name    string
+	cmd     *exec.Cmd
+	pending *pendingResponses
+	// waited is set once the process has exited
+	waited atomic.Bool
+	// stopped is set once Stop has been called
+	stopped atomic.Bool
+}
 
-	cmd        *exec.Cmd
-	waited     atomic.Bool
-	stopCalled atomic.Bool
-	chErr      chan error
+// NewFBPlugin creates a new plugin instance subscribed to the given phases
+// (PhaseRequest if none are given). In order to start the plugin, call the
+// Start() function. The plugin can be started again after it has exited.
+func NewFBPlugin(path string, phases ...Phase) *FBPlugin {
+	p := &FBPlugin{path: path}
+	for _, phase := range phases {
+		p.phases |= phase
+	}
+	if p.phases == 0 {
+		p.phases = PhaseRequest
+	}
+	return p
 }
 
-// NewFBPlugin creates a new plugin instance. In order to start the plugin, call
-// the Start() function.
-func NewFBPlugin(path string) *FBPlugin {
-	return &FBPlugin{path: path}
+// WithArgs sets the command line arguments passed to the plugin.
+func (p *FBPlugin) WithArgs(args ...string) *FBPlugin {
+	p.args = args
+	return p
+}
+
+// WithEnv adds environment variables ("KEY=value") to the environment the plugin
+// inherits from wfx.
+func (p *FBPlugin) WithEnv(env ...string) *FBPlugin {
+	p.env = env
+	return p
 }
 
 func (p *FBPlugin) Name() string {
 	return p.path
 }
 
+func (p *FBPlugin) Phases() Phase {
+	return p.phases
+}
+
 func (p *FBPlugin) Start(chErr chan error) (chan Message, error) {
 	log.Info().Str("path", p.path).Msgf("Starting plugin %q", p.path)
-	cmd := createCmd(p.path)
-
 	// this ensures that a process group is created (needed to kill all child processes)
-	p.responses = make(map[uint64]chan genPlugin.PluginResponseT)
-	p.chErr = chErr
+	cmd := createCmd(p.path, p.args...)
+	if len(p.env) > 0 {
+		cmd.Env = append(os.Environ(), p.env...)
+	}
 
 	stdin, err := cmd.StdinPipe()
 	if err != nil {
@@ -78,42 +117,45 @@ func (p *FBPlugin) Start(chErr chan error) (chan Message, error) {
 	}
 	log.Debug().Str("path", cmd.Path).Msgf("Plugin %q started", cmd.Path)
 
+	r := &fbRun{name: p.Name(), cmd: cmd, pending: newPendingResponses()}
 	go func() { // our reaper
 		defer close(chErr)
 		_ = cmd.Wait()
 		log.Debug().Msg("Plugin subprocess has exited")
-		p.waited.Store(true)
-		if !p.stopCalled.Load() {
-			chErr <- fmt.Errorf("plugin '%s' stopped unexpectedly", p.Name())
+		r.waited.Store(true)
+		r.pending.Abort()
+		if !r.stopped.Load() {
+			chErr <- fmt.Errorf("plugin '%s' stopped unexpectedly", r.name)
 		}
 	}()
 
-	go p.sender(stdin, chMessage)
-	go p.receiver(stdout)
+	go r.sender(stdin, chMessage)
+	go r.receiver(stdout)
 	go p.forwardLogs(stderr)
 
-	p.cmd = cmd
+	p.mutex.Lock()
+	p.current = r
+	p.mutex.Unlock()
 
 	return chMessage, nil
 }
 
 func (p *FBPlugin) Stop() error {
 	log.Info().Str("path", p.path).Msgf("Stopping plugin %q", p.path)
-	alreadyStopped := p.stopCalled.Swap(true)
-	alreadyWaited := p.waited.Load()
-	if alreadyStopped || alreadyWaited || p.cmd == nil {
+	p.mutex.Lock()
+	r := p.current
+	p.mutex.Unlock()
+	if r == nil || r.stopped.Swap(true) || r.waited.Load() {
 		log.Debug().Str("path", p.path).Msgf("Plugin %q already stopped", p.path)
 		return nil
 	}
 
-	return fault.Wrap(p.terminateProcess())
+	return fault.Wrap(r.terminateProcess())
 }
 
-func (p *FBPlugin) sender(w io.Writer, chMessage <-chan Message) {
+func (r *fbRun) sender(w io.Writer, chMessage <-chan Message) {
 	for msg := range chMessage {
-		p.responsesMutex.Lock()
-		p.responses[msg.request.Cookie] = msg.response
-		p.responsesMutex.Unlock()
+		r.pending.Add(msg)
 
 		if err := ioutil.WriteRequest(w, msg.request); err != nil {
 			log.Error().Err(err).Msg("Failed to write message")
@@ -121,12 +163,12 @@ func (p *FBPlugin) sender(w io.Writer, chMessage <-chan Message) {
 		}
 		log.Debug().Uint64("cookie", msg.request.Cookie).Msgf("Request with cookie %d sent to plugin", msg.request.Cookie)
 	}
-	log.Info().Str("name", p.Name()).Msg("Plugin writer stopped")
+	log.Info().Str("name", r.name).Msg("Plugin writer stopped")
 }
 
-func (p *FBPlugin) receiver(r io.Reader) {
-	for !p.waited.Load() {
-		resp, err := ioutil.ReadResponse(r)
+func (r *fbRun) receiver(rd io.Reader) {
+	for !r.waited.Load() {
+		resp, err := ioutil.ReadResponse(rd)
 		if err != nil {
 			if errors.Is(err, os.ErrClosed) || errors.Is(err, io.EOF) {
 				break
@@ -137,19 +179,13 @@ func (p *FBPlugin) receiver(r io.Reader) {
 
 		cookie := resp.Cookie
 		log.Debug().Uint64("cookie", cookie).Msgf("Received plugin response for cookie %d", cookie)
-		p.responsesMutex.Lock()
-		chResp, ok := p.responses[cookie]
-		delete(p.responses, cookie)
-		p.responsesMutex.Unlock()
-		if !ok {
+		if !r.pending.Deliver(resp) {
 			log.Error().Uint64("cookie", cookie).Msgf("Received unexpected response from plugin for cookie %d", cookie)
-			_ = p.terminateProcess() // this results in wfx stopping gracefully because the plugin stops without Stop() being called
+			_ = r.terminateProcess() // the plugin stops without Stop() being called, hence it is restarted (or wfx stops gracefully)
 			break
 		}
-		chResp <- *resp
-		close(chResp) // there can only be one response
 	}
-	log.Info().Str("name", p.Name()).Msg("Plugin receiver stopped")
+	log.Info().Str("name", r.name).Msg("Plugin receiver stopped")
 }
 
 func (p *FBPlugin) forwardLogs(r io.Reader) {
@@ -9,7 +9,9 @@ package plugin
  */
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -79,7 +81,7 @@ func TestSendAndReceive(t *testing.T) {
 		},
 		Header: headers,
 	}
-	req := convertRequest(&httpReq, 1)
+	req := convertRequest(context.Background(), &httpReq, 1)
 	msg := Message{
 		request:  req,
 		response: make(chan plugin.PluginResponseT, 1),
@@ -101,6 +103,64 @@ func TestSendAndReceive(t *testing.T) {
 	}
 }
 
+func TestArgsAndEnv(t *testing.T) {
+	p := NewFBPlugin("sh").WithArgs("-c", `test "$FOO" = bar && exec cat`).WithEnv("FOO=bar")
+	chErr := make(chan error, 1)
+	chMessages, err := p.Start(chErr)
+	require.NoError(t, err)
+
+	req := convertRequest(context.Background(), httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil), 1)
+	msg := Message{request: req, response: make(chan plugin.PluginResponseT, 1)}
+	chMessages <- msg
+	// the plugin only echoes the request if it received the environment variable
+	resp, ok := <-msg.response
+	require.True(t, ok)
+	assert.Equal(t, req.Cookie, resp.Cookie)
+
+	close(chMessages)
+	_ = p.Stop()
+}
+
 func TestName(t *testing.T) {
 	assert.Equal(t, "true", NewFBPlugin("true").Name())
 }
+
+func TestRestart(t *testing.T) {
+	p := NewFBPlugin("true")
+	for range 2 {
+		chErr := make(chan error, 1)
+		chMessages, err := p.Start(chErr)
+		require.NoError(t, err)
+		assert.ErrorContains(t, <-chErr, "stopped unexpectedly")
+		close(chMessages)
+	}
+}
+
+func TestRestart_PreviousRunExitsLate(t *testing.T) {
+	p := NewFBPlugin("cat")
+	chErr1 := make(chan error, 1)
+	chMessages1, err := p.Start(chErr1)
+	require.NoError(t, err)
+	previous := p.current
+
+	chErr2 := make(chan error, 1)
+	chMessages2, err := p.Start(chErr2)
+	require.NoError(t, err)
+
+	// the previous process exits after the plugin has been started again
+	require.NoError(t, previous.terminateProcess())
+	assert.ErrorContains(t, <-chErr1, "stopped unexpectedly")
+	close(chMessages1)
+
+	req := convertRequest(context.Background(), httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil), 1)
+	msg := Message{request: req, response: make(chan plugin.PluginResponseT, 1)}
+	chMessages2 <- msg
+	resp, ok := <-msg.response
+	require.True(t, ok)
+	assert.Equal(t, req.Cookie, resp.Cookie)
+
+	close(chMessages2)
+	require.NoError(t, p.Stop())
+	_, ok = <-chErr2
+	assert.False(t, ok)
+}
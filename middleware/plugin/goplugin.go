@@ -0,0 +1,343 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/rs/zerolog/log"
+	"github.com/siemens/wfx/generated/api"
+	genPlugin "github.com/siemens/wfx/generated/plugin"
+	"github.com/siemens/wfx/generated/plugin/client"
+	"github.com/siemens/wfx/generated/plugin/event"
+)
+
+// compile-time check to ensure we fulfill the interfaces
+var (
+	_ Plugin          = (*GoPlugin)(nil)
+	_ PhaseSubscriber = (*GoPlugin)(nil)
+)
+
+// Handler processes plugin requests within the wfx process. It receives the
+// same information a FlatBuffers plugin receives via stdin, converted to Go
+// types, and returns a verdict created by Reply, Modify, ModifyResponse or Veto.
+// A nil verdict leaves the request (resp. response) unchanged.
+//
+// Returning an error is treated like an unavailable plugin, i.e. the failure
+// policy applies. Handlers are called concurrently. The context is canceled
+// once wfx stops waiting for the verdict (see Settings.Timeout) or the plugin
+// is stopped.
+type Handler interface {
+	Handle(ctx context.Context, req *Request) (*Verdict, error)
+}
+
+// HandlerFunc is an adapter to allow the use of ordinary functions as handlers.
+type HandlerFunc func(ctx context.Context, req *Request) (*Verdict, error)
+
+func (f HandlerFunc) Handle(ctx context.Context, req *Request) (*Verdict, error) {
+	return f(ctx, req)
+}
+
+// Request is passed to a Handler. Depending on the phase, either HTTP or Event is set.
+type Request struct {
+	// Phase is the phase in which the plugin is invoked.
+	Phase Phase
+	// HTTP is the request of the client (request and response phase).
+	HTTP *HTTPRequest
+	// Response is the response of wfx to the request (response phase).
+	Response *HTTPResponse
+	// Event is the job event (validate and notify phase).
+	Event *JobEvent
+}
+
+// HTTPRequest is a request of a client.
+type HTTPRequest struct {
+	// Method is derived from the operation, i.e. PATCH is reported as PUT and
+	// any other method which does not modify a resource as GET.
+	Method string
+	URL    *url.URL
+	Header http.Header
+	Body   []byte
+}
+
+// HTTPResponse is the response to a request.
+type HTTPResponse struct {
+	// StatusCode is the status code of the response; zero keeps the status code
+	// when the response is modified.
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// EventKind is the kind of a job event.
+type EventKind string
+
+const (
+	EventJobCreated        EventKind = "JOB_CREATED"
+	EventTransition        EventKind = "TRANSITION"
+	EventDefinitionChanged EventKind = "DEFINITION_CHANGED"
+	EventTagsChanged       EventKind = "TAGS_CHANGED"
+	EventJobDeleted        EventKind = "JOB_DELETED"
+)
+
+var eventKindsByFB = map[event.Kind]EventKind{
+	event.KindJobCreated:        EventJobCreated,
+	event.KindTransition:        EventTransition,
+	event.KindDefinitionChanged: EventDefinitionChanged,
+	event.KindTagsChanged:       EventTagsChanged,
+	event.KindJobDeleted:        EventJobDeleted,
+}
+
+// JobEvent is a change of a job.
+type JobEvent struct {
+	Kind     EventKind
+	Ctime    time.Time
+	JobID    string
+	ClientID string
+	Workflow string
+	// State is the state of the job after the change (resp. before the transition when validating).
+	State string
+	Tags  []string
+	// Definition is the JSON-encoded definition of the job (only for EventJobCreated and EventDefinitionChanged).
+	Definition json.RawMessage
+	// Transition is only set for events of kind EventTransition.
+	Transition *Transition
+}
+
+// Transition describes a single step of a job from one state to another.
+type Transition struct {
+	From  string
+	To    string
+	Actor api.EligibleEnum
+}
+
+// Verdict is the decision of a Handler. It is created by Reply, Modify,
+// ModifyResponse or Veto.
+type Verdict struct {
+	payload *genPlugin.PayloadT
+}
+
+// ReplyStatus determines the status code of a response sent by a plugin.
+type ReplyStatus uint8
+
+const (
+	// ReplyAccept responds with 200 OK.
+	ReplyAccept ReplyStatus = iota
+	// ReplyDeny responds with 403 Forbidden.
+	ReplyDeny
+	// ReplyUnavailable responds with 503 Service Unavailable.
+	ReplyUnavailable
+)
+
+var replyStatuses = map[ReplyStatus]client.ResponseStatus{
+	ReplyAccept:      client.ResponseStatusAccept,
+	ReplyDeny:        client.ResponseStatusDeny,
+	ReplyUnavailable: client.ResponseStatusUnavailable,
+}
+
+// GoPlugin is a plugin implemented in Go which runs within the wfx process.
+type GoPlugin struct {
+	name    string
+	handler Handler
+	phases  Phase
+
+	cancel context.CancelFunc
+}
+
+// NewGoPlugin creates a new plugin which passes requests to the handler. It is
+// subscribed to the given phases (PhaseRequest if none are given).
+func NewGoPlugin(name string, handler Handler, phases ...Phase) *GoPlugin {
+	p := &GoPlugin{name: name, handler: handler}
+	for _, phase := range phases {
+		p.phases |= phase
+	}
+	if p.phases == 0 {
+		p.phases = PhaseRequest
+	}
+	return p
+}
+
+func (p *GoPlugin) Name() string {
+	return p.name
+}
+
+func (p *GoPlugin) Phases() Phase {
+	return p.phases
+}
+
+func (p *GoPlugin) Start(chan error) (chan Message, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	chMessage := make(chan Message)
+	go func() {
+		for msg := range chMessage {
+			go p.handle(ctx, msg)
+		}
+	}()
+	return chMessage, nil
+}
+
+// Stop cancels the contexts passed to running handlers.
+func (p *GoPlugin) Stop() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	return nil
+}
+
+func (p *GoPlugin) handle(pluginCtx context.Context, msg Message) {
+	// closing the channel without a response signals a failure
+	defer close(msg.response)
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error().Str("plugin", p.name).Str("panic", fmt.Sprint(r)).Msg("Plugin handler panicked")
+		}
+	}()
+
+	ctx := msg.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// the handler is canceled once the sender gives up waiting or the plugin is stopped
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(pluginCtx, cancel)
+	defer stop()
+
+	req, err := newRequest(msg.request)
+	if err != nil {
+		log.Error().Err(err).Str("plugin", p.name).Msg("Failed to convert plugin request")
+		return
+	}
+	verdict, err := p.handler.Handle(ctx, req)
+	if err != nil {
+		log.Error().Err(err).Str("plugin", p.name).Msg("Plugin handler failed")
+		return
+	}
+	resp := genPlugin.PluginResponseT{Cookie: msg.request.Cookie}
+	if verdict != nil {
+		resp.Payload = verdict.payload
+	}
+	msg.response <- resp
+}
+
+// Reply returns a verdict which sends a response to the client instead of
+// processing the request.
+func Reply(status ReplyStatus, header http.Header, body []byte) *Verdict {
+	return &Verdict{payload: &genPlugin.PayloadT{
+		Type:  genPlugin.Payloadgenerated_plugin_client_Response,
+		Value: &client.ResponseT{Status: replyStatuses[status], Envelope: convertHeader(header), Content: body},
+	}}
+}
+
+// Modify returns a verdict which replaces the destination and headers of the request.
+func Modify(destination *url.URL, header http.Header) *Verdict {
+	return &Verdict{payload: &genPlugin.PayloadT{
+		Type:  genPlugin.Payloadgenerated_plugin_client_Request,
+		Value: &client.RequestT{Destination: destination.String(), Envelope: convertHeader(header)},
+	}}
+}
+
+// ModifyResponse returns a verdict which replaces the response in the response phase.
+func ModifyResponse(resp *HTTPResponse) *Verdict {
+	return &Verdict{payload: &genPlugin.PayloadT{
+		Type: genPlugin.Payloadgenerated_plugin_client_ServerResponse,
+		Value: &client.ServerResponseT{
+			Status:   int32(resp.StatusCode),
+			Envelope: convertHeader(resp.Header),
+			Content:  resp.Body,
+		},
+	}}
+}
+
+// Veto returns a verdict which rejects a transition in the validate phase.
+func Veto(reason string) *Verdict {
+	return &Verdict{payload: &genPlugin.PayloadT{
+		Type:  genPlugin.Payloadgenerated_plugin_event_Verdict,
+		Value: &event.VerdictT{Veto: true, Reason: reason},
+	}}
+}
+
+// newRequest converts the FlatBuffers request passed to the plugin.
+func newRequest(req *genPlugin.PluginRequestT) (*Request, error) {
+	result := &Request{Phase: PhaseRequest}
+	if ev := req.Event; ev != nil {
+		result.Phase = PhaseNotify
+		if ev.Validate {
+			result.Phase = PhaseValidate
+		}
+		result.Event = &JobEvent{
+			Kind:       eventKindsByFB[ev.Kind],
+			Ctime:      time.UnixMilli(ev.Ctime),
+			JobID:      ev.JobId,
+			ClientID:   ev.ClientId,
+			Workflow:   ev.Workflow,
+			State:      ev.State,
+			Tags:       ev.Tags,
+			Definition: ev.Definition,
+		}
+		if t := ev.Transition; t != nil {
+			result.Event.Transition = &Transition{From: t.From, To: t.To, Actor: api.CLIENT}
+			if t.Actor == event.ActorWfx {
+				result.Event.Transition.Actor = api.WFX
+			}
+		}
+		return result, nil
+	}
+	if r := req.Request; r != nil {
+		u, err := url.Parse(r.Destination)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		result.HTTP = &HTTPRequest{
+			Method: actionToHTTPMethod(r.Action),
+			URL:    u,
+			Header: toHeader(r.Envelope),
+			Body:   r.Content,
+		}
+	}
+	if resp := req.Response; resp != nil {
+		result.Phase = PhaseResponse
+		result.Response = &HTTPResponse{
+			StatusCode: int(resp.Status),
+			Header:     toHeader(resp.Envelope),
+			Body:       resp.Content,
+		}
+	}
+	return result, nil
+}
+
+func toHeader(envelope []*client.EnvelopeT) http.Header {
+	header := make(http.Header, len(envelope))
+	for _, h := range envelope {
+		for _, value := range h.Values {
+			header.Add(h.Name, value)
+		}
+	}
+	return header
+}
+
+func actionToHTTPMethod(action client.Action) string {
+	switch action {
+	case client.ActionCreate:
+		return http.MethodPost
+	case client.ActionUpdate:
+		return http.MethodPut
+	case client.ActionDelete:
+		return http.MethodDelete
+	default:
+		return http.MethodGet
+	}
+}
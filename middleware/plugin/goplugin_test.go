@@ -0,0 +1,172 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/generated/plugin"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveGoPlugin sends a GET request for target through a middleware running the plugin.
+func serveGoPlugin(t *testing.T, p *GoPlugin, settings Settings, target string) *httptest.ResponseRecorder {
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	return recorder
+}
+
+func TestGoPlugin_Reply(t *testing.T) {
+	p := NewGoPlugin("deny", HandlerFunc(func(_ context.Context, req *Request) (*Verdict, error) {
+		assert.Equal(t, PhaseRequest, req.Phase)
+		assert.Equal(t, http.MethodGet, req.HTTP.Method)
+		if strings.HasPrefix(req.HTTP.URL.Path, "/workflows") {
+			return Reply(ReplyDeny, http.Header{"X-Reason": {"test"}}, []byte("forbidden")), nil
+		}
+		return nil, nil
+	}))
+
+	recorder := serveGoPlugin(t, p, DefaultSettings(), "http://localhost/workflows")
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Equal(t, "forbidden", recorder.Body.String())
+	assert.Equal(t, "test", recorder.Header().Get("X-Reason"))
+
+	recorder = serveGoPlugin(t, p, DefaultSettings(), "http://localhost/jobs")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "/jobs", recorder.Body.String())
+}
+
+func TestGoPlugin_Modify(t *testing.T) {
+	p := NewGoPlugin("rewrite", HandlerFunc(func(_ context.Context, req *Request) (*Verdict, error) {
+		u := *req.HTTP.URL
+		u.Path = "/bar"
+		return Modify(&u, req.HTTP.Header), nil
+	}))
+	recorder := serveGoPlugin(t, p, DefaultSettings(), "http://localhost/foo")
+	assert.Equal(t, "/bar", recorder.Body.String())
+}
+
+func TestGoPlugin_ModifyResponse(t *testing.T) {
+	p := NewGoPlugin("redact", HandlerFunc(func(_ context.Context, req *Request) (*Verdict, error) {
+		assert.Equal(t, PhaseResponse, req.Phase)
+		assert.Equal(t, http.StatusOK, req.Response.StatusCode)
+		assert.Equal(t, "/foo", string(req.Response.Body))
+		return ModifyResponse(&HTTPResponse{StatusCode: http.StatusAccepted, Body: []byte("***")}), nil
+	}), PhaseResponse)
+	recorder := serveGoPlugin(t, p, DefaultSettings(), "http://localhost/foo")
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Equal(t, "***", recorder.Body.String())
+}
+
+func TestGoPlugin_Error(t *testing.T) {
+	p := NewGoPlugin("broken", HandlerFunc(func(context.Context, *Request) (*Verdict, error) {
+		return nil, errors.New("boom")
+	}))
+	recorder := serveGoPlugin(t, p, DefaultSettings(), "http://localhost/foo")
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	settings := DefaultSettings()
+	settings.FailurePolicy = FailOpen
+	recorder = serveGoPlugin(t, p, settings, "http://localhost/foo")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestGoPlugin_Panic(t *testing.T) {
+	p := NewGoPlugin("panic", HandlerFunc(func(context.Context, *Request) (*Verdict, error) {
+		panic("boom")
+	}))
+	recorder := serveGoPlugin(t, p, DefaultSettings(), "http://localhost/foo")
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
+
+func TestGoPlugin_Veto(t *testing.T) {
+	p := NewGoPlugin("veto", HandlerFunc(func(_ context.Context, req *Request) (*Verdict, error) {
+		assert.Equal(t, PhaseValidate, req.Phase)
+		assert.Equal(t, EventTransition, req.Event.Kind)
+		assert.Equal(t, "42", req.Event.JobID)
+		assert.Equal(t, "INSTALLING", req.Event.State)
+		assert.Equal(t, &Transition{From: "INSTALLING", To: "INSTALLED", Actor: api.CLIENT}, req.Event.Transition)
+		return Veto("outside maintenance window"), nil
+	}), PhaseValidate)
+	assert.Equal(t, PhaseValidate, p.Phases())
+	assert.Equal(t, "veto", p.Name())
+
+	mw, err := NewMiddleware(p, DefaultSettings())
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	err = mw.Validate(t.Context(), hooks.Event{
+		Kind:       hooks.KindTransition,
+		Job:        &api.Job{ID: "42", Status: &api.JobStatus{State: "INSTALLING"}},
+		Transition: &hooks.Transition{From: "INSTALLING", To: "INSTALLED", Actor: api.CLIENT},
+	})
+	assert.EqualError(t, err, "transition rejected by veto: outside maintenance window")
+}
+
+func TestGoPlugin_TimeoutCancelsHandler(t *testing.T) {
+	canceled := make(chan struct{})
+	p := NewGoPlugin("slow", HandlerFunc(func(ctx context.Context, _ *Request) (*Verdict, error) {
+		<-ctx.Done()
+		close(canceled)
+		return nil, ctx.Err()
+	}))
+	settings := DefaultSettings()
+	settings.Timeout = 50 * time.Millisecond
+
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	// the plugin is still running
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler was not canceled after the timeout")
+	}
+}
+
+func TestGoPlugin_StopCancelsHandlers(t *testing.T) {
+	started := make(chan struct{})
+	p := NewGoPlugin("slow", HandlerFunc(func(ctx context.Context, _ *Request) (*Verdict, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}))
+	chMessages, err := p.Start(make(chan error))
+	require.NoError(t, err)
+
+	msg := Message{request: &plugin.PluginRequestT{Cookie: 1}, response: make(chan plugin.PluginResponseT, 1)}
+	chMessages <- msg
+	<-started
+	require.NoError(t, p.Stop())
+	close(chMessages)
+
+	_, ok := <-msg.response
+	assert.False(t, ok)
+}
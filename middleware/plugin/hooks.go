@@ -0,0 +1,128 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/siemens/wfx/generated/api"
+	genPlugin "github.com/siemens/wfx/generated/plugin"
+	"github.com/siemens/wfx/generated/plugin/event"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
+	"github.com/siemens/wfx/middleware/logging"
+)
+
+// Middleware implements the following interfaces (compile-time check):
+var (
+	_ hooks.Validator = (*Middleware)(nil)
+	_ hooks.Notifier  = (*Middleware)(nil)
+)
+
+var eventKinds = map[hooks.Kind]event.Kind{
+	hooks.KindJobCreated:        event.KindJobCreated,
+	hooks.KindTransition:        event.KindTransition,
+	hooks.KindDefinitionChanged: event.KindDefinitionChanged,
+	hooks.KindTagsChanged:       event.KindTagsChanged,
+	hooks.KindJobDeleted:        event.KindJobDeleted,
+}
+
+// Name returns the name of the plugin.
+func (mw *Middleware) Name() string {
+	return mw.plugin.Name()
+}
+
+// Phases returns the phases the plugin subscribes to.
+func (mw *Middleware) Phases() Phase {
+	return phasesOf(mw.plugin)
+}
+
+// Validate passes the event to the plugin and waits for its verdict.
+func (mw *Middleware) Validate(ctx context.Context, ev hooks.Event) error {
+	log := logging.LoggerFromCtx(ctx).With().Str("plugin", mw.plugin.Name()).Logger()
+	ctx, span := mw.startSpan(ctx, PhaseValidate)
+	req := &genPlugin.PluginRequestT{
+		Cookie: mw.cookieCounter.Add(1),
+		Event:  convertEvent(ev, true),
+	}
+	resp, err := mw.roundtrip(ctx, req, log)
+	endSpan(span, err)
+	if err != nil {
+		if mw.tolerate(err, log) {
+			return nil
+		}
+		return fmt.Errorf("plugin %s: %w", mw.plugin.Name(), err)
+	}
+	if resp.Payload == nil {
+		return nil
+	}
+	switch resp.Payload.Type {
+	case genPlugin.Payloadgenerated_plugin_event_Verdict:
+		verdict := resp.Payload.Value.(*event.VerdictT)
+		if verdict.Veto {
+			// the plugin's path is none of the caller's business
+			return &hooks.VetoError{Hook: filepath.Base(mw.plugin.Name()), Reason: verdict.Reason}
+		}
+		return nil
+	default:
+		return fmt.Errorf("received unsupported payload type %d from plugin %s", resp.Payload.Type, mw.plugin.Name())
+	}
+}
+
+// Notify passes the event to the plugin and waits for its acknowledgement.
+func (mw *Middleware) Notify(ctx context.Context, ev hooks.Event) {
+	log := logging.LoggerFromCtx(ctx).With().Str("plugin", mw.plugin.Name()).Logger()
+	ctx, span := mw.startSpan(ctx, PhaseNotify)
+	req := &genPlugin.PluginRequestT{
+		Cookie: mw.cookieCounter.Add(1),
+		Event:  convertEvent(ev, false),
+	}
+	resp, err := mw.roundtrip(ctx, req, log)
+	endSpan(span, err)
+	if err != nil {
+		log.Error().Err(err).Str("kind", string(ev.Kind)).Msg("Failed to notify plugin")
+		return
+	}
+	if resp.Payload != nil {
+		log.Warn().Int("type", int(resp.Payload.Type)).Msg("Ignoring payload of plugin response to notification")
+	}
+}
+
+func convertEvent(ev hooks.Event, validate bool) *event.JobEventT {
+	result := &event.JobEventT{
+		Kind:     eventKinds[ev.Kind],
+		Ctime:    ev.Ctime.UnixMilli(),
+		Validate: validate,
+	}
+	if job := ev.Job; job != nil {
+		result.JobId = job.ID
+		result.ClientId = job.ClientID
+		if job.Workflow != nil {
+			result.Workflow = job.Workflow.Name
+		}
+		if job.Status != nil {
+			result.State = job.Status.State
+		}
+		if job.Tags != nil {
+			result.Tags = *job.Tags
+		}
+		if ev.Kind == hooks.KindJobCreated || ev.Kind == hooks.KindDefinitionChanged {
+			result.Definition, _ = json.Marshal(job.Definition)
+		}
+	}
+	if t := ev.Transition; t != nil {
+		result.Transition = &event.TransitionT{From: t.From, To: t.To, Actor: event.ActorClient}
+		if t.Actor == api.WFX {
+			result.Transition.Actor = event.ActorWfx
+		}
+	}
+	return result
+}
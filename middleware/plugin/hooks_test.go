@@ -0,0 +1,145 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/generated/plugin"
+	"github.com/siemens/wfx/generated/plugin/event"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startEventPlugin starts a plugin which answers every event with the given payload.
+func startEventPlugin(t *testing.T, payload *plugin.PayloadT) (*Middleware, <-chan *event.JobEventT) {
+	p := PhasedTestPlugin{TestPlugin: NewTestPlugin(), phases: PhaseValidate | PhaseNotify}
+	received := make(chan *event.JobEventT, 1)
+	go func() {
+		for msg := range p.chMessage {
+			received <- msg.request.Event
+			msg.response <- plugin.PluginResponseT{Cookie: msg.request.Cookie, Payload: payload}
+		}
+	}()
+	mw, err := NewMiddleware(p, DefaultSettings())
+	require.NoError(t, err)
+	t.Cleanup(mw.Stop)
+	return mw, received
+}
+
+func TestValidate_Veto(t *testing.T) {
+	mw, received := startEventPlugin(t, &plugin.PayloadT{
+		Type:  plugin.Payloadgenerated_plugin_event_Verdict,
+		Value: &event.VerdictT{Veto: true, Reason: "outside maintenance window"},
+	})
+
+	err := mw.Validate(t.Context(), hooks.Event{
+		Kind:       hooks.KindTransition,
+		Job:        &api.Job{ID: "42", Status: &api.JobStatus{State: "INSTALLING"}},
+		Transition: &hooks.Transition{From: "INSTALLING", To: "INSTALLED", Actor: api.CLIENT},
+	})
+	assert.EqualError(t, err, "transition rejected by TestPlugin: outside maintenance window")
+
+	ev := <-received
+	assert.True(t, ev.Validate)
+	assert.Equal(t, event.KindTransition, ev.Kind)
+	assert.Equal(t, "42", ev.JobId)
+}
+
+func TestValidate_Accept(t *testing.T) {
+	mw, _ := startEventPlugin(t, &plugin.PayloadT{
+		Type:  plugin.Payloadgenerated_plugin_event_Verdict,
+		Value: &event.VerdictT{},
+	})
+	assert.NoError(t, mw.Validate(t.Context(), hooks.Event{Kind: hooks.KindTransition}))
+}
+
+func TestValidate_EmptyPayload(t *testing.T) {
+	mw, _ := startEventPlugin(t, nil)
+	assert.NoError(t, mw.Validate(t.Context(), hooks.Event{Kind: hooks.KindTransition}))
+}
+
+func TestValidate_UnsupportedPayload(t *testing.T) {
+	mw, _ := startEventPlugin(t, &plugin.PayloadT{Type: plugin.Payload(42)})
+	err := mw.Validate(t.Context(), hooks.Event{Kind: hooks.KindTransition})
+	assert.ErrorContains(t, err, "unsupported payload type 42")
+	var vetoErr *hooks.VetoError
+	assert.NotErrorAs(t, err, &vetoErr)
+}
+
+func TestNotify(t *testing.T) {
+	mw, received := startEventPlugin(t, nil)
+	mw.Notify(t.Context(), hooks.Event{Kind: hooks.KindJobDeleted, Job: &api.Job{ID: "42"}})
+	ev := <-received
+	assert.False(t, ev.Validate)
+	assert.Equal(t, event.KindJobDeleted, ev.Kind)
+	assert.Equal(t, "42", ev.JobId)
+}
+
+func TestConvertEvent(t *testing.T) {
+	ctime := time.UnixMilli(1767225600000)
+	tags := []string{"foo", "bar"}
+	job := &api.Job{
+		ID:         "1",
+		ClientID:   "client",
+		Workflow:   &api.Workflow{Name: "wfx.workflow.test"},
+		Status:     &api.JobStatus{State: "ACTIVATE"},
+		Definition: map[string]any{"url": "http://localhost/update.bin"},
+		Tags:       &tags,
+	}
+
+	ev := convertEvent(hooks.Event{Kind: hooks.KindJobCreated, Ctime: ctime, Job: job}, false)
+	assert.Equal(t, event.KindJobCreated, ev.Kind)
+	assert.Equal(t, int64(1767225600000), ev.Ctime)
+	assert.Equal(t, "client", ev.ClientId)
+	assert.Equal(t, "wfx.workflow.test", ev.Workflow)
+	assert.Equal(t, "ACTIVATE", ev.State)
+	assert.Equal(t, tags, ev.Tags)
+	assert.Nil(t, ev.Transition)
+	var definition map[string]any
+	require.NoError(t, json.Unmarshal(ev.Definition, &definition))
+	assert.Equal(t, job.Definition, definition)
+
+	ev = convertEvent(hooks.Event{
+		Kind:       hooks.KindTransition,
+		Ctime:      ctime,
+		Job:        job,
+		Transition: &hooks.Transition{From: "INSTALLED", To: "ACTIVATE", Actor: api.WFX},
+	}, false)
+	assert.Equal(t, &event.TransitionT{From: "INSTALLED", To: "ACTIVATE", Actor: event.ActorWfx}, ev.Transition)
+	assert.Nil(t, ev.Definition)
+}
+
+func TestValidate_Unavailable(t *testing.T) {
+	p, _ := HangPlugin()
+	settings := DefaultSettings()
+	settings.Timeout = 10 * time.Millisecond
+	mw, err := NewMiddleware(PhasedTestPlugin{TestPlugin: p, phases: PhaseValidate}, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	err = mw.Validate(t.Context(), hooks.Event{Kind: hooks.KindTransition})
+	assert.ErrorIs(t, err, errTimeout)
+}
+
+func TestValidate_UnavailableFailOpen(t *testing.T) {
+	p, _ := HangPlugin()
+	settings := DefaultSettings()
+	settings.Timeout = 10 * time.Millisecond
+	settings.FailurePolicy = FailOpen
+	mw, err := NewMiddleware(PhasedTestPlugin{TestPlugin: p, phases: PhaseValidate}, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	assert.NoError(t, mw.Validate(t.Context(), hooks.Event{Kind: hooks.KindTransition}))
+}
@@ -11,6 +11,7 @@ package ioutil
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 
 	flatbuffers "github.com/google/flatbuffers/go"
@@ -52,6 +53,42 @@ func WriteResponse(w io.Writer, resp *plugin.PluginResponseT) error {
 	return writeHelper(w, resp)
 }
 
+// MarshalRequest serializes the request without a size prefix, e.g. to pass
+// it to a WebAssembly plugin.
+func MarshalRequest(req *plugin.PluginRequestT) []byte {
+	return marshal(req)
+}
+
+// MarshalResponse serializes the response without a size prefix.
+func MarshalResponse(resp *plugin.PluginResponseT) []byte {
+	return marshal(resp)
+}
+
+// UnmarshalRequest deserializes a request which was serialized by MarshalRequest.
+func UnmarshalRequest(buf []byte) (req *plugin.PluginRequestT, err error) {
+	defer recoverMalformed(&err)
+	return plugin.GetRootAsPluginRequest(buf, 0).UnPack(), nil
+}
+
+// UnmarshalResponse deserializes a response which was serialized by MarshalResponse.
+func UnmarshalResponse(buf []byte) (resp *plugin.PluginResponseT, err error) {
+	defer recoverMalformed(&err)
+	return plugin.GetRootAsPluginResponse(buf, 0).UnPack(), nil
+}
+
+// recoverMalformed turns the panic caused by accessing a malformed buffer into an error.
+func recoverMalformed(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("malformed message: %v", r)
+	}
+}
+
+func marshal(packer Packer) []byte {
+	builder := flatbuffers.NewBuilder(initialSize)
+	builder.Finish(packer.Pack(builder))
+	return builder.FinishedBytes()
+}
+
 func readPrefix(r io.Reader) (uint32, error) {
 	// see https://github.com/dvidelabs/flatcc/blob/master/doc/binary-format.md
 	buf := make([]byte, 4)
@@ -17,6 +17,7 @@ import (
 
 	"github.com/siemens/wfx/generated/plugin"
 	"github.com/siemens/wfx/generated/plugin/client"
+	"github.com/siemens/wfx/generated/plugin/event"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -72,6 +73,56 @@ func TestWriteAndReadResponse(t *testing.T) {
 	assert.EqualValues(t, expected, *actual)
 }
 
+func TestWriteAndReadEvent(t *testing.T) {
+	t.Parallel()
+
+	expected := plugin.PluginRequestT{
+		Cookie: 1,
+		Event: &event.JobEventT{
+			Kind:       event.KindTransition,
+			Ctime:      1767225600000,
+			JobId:      "1",
+			ClientId:   "client",
+			Workflow:   "wfx.workflow.test",
+			State:      "INSTALLING",
+			Transition: &event.TransitionT{From: "INSTALLING", To: "INSTALLED", Actor: event.ActorClient},
+			Definition: []byte(`{"url":"http://localhost/update.bin"}`),
+			Tags:       []string{"foo"},
+			Validate:   true,
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	err := WriteRequest(buf, &expected)
+	require.NoError(t, err)
+
+	actual, err := ReadRequest(buf)
+
+	require.NoError(t, err)
+	assert.EqualValues(t, expected, *actual)
+}
+
+func TestWriteAndReadVerdict(t *testing.T) {
+	t.Parallel()
+
+	expected := plugin.PluginResponseT{
+		Cookie: 1,
+		Payload: &plugin.PayloadT{
+			Type:  plugin.Payloadgenerated_plugin_event_Verdict,
+			Value: &event.VerdictT{Veto: true, Reason: "outside maintenance window"},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	err := WriteResponse(buf, &expected)
+	require.NoError(t, err)
+
+	actual, err := ReadResponse(buf)
+
+	require.NoError(t, err)
+	assert.EqualValues(t, expected, *actual)
+}
+
 func TestFaultyReader(t *testing.T) {
 	t.Parallel()
 
@@ -104,3 +155,50 @@ func TestIncompleteRead(t *testing.T) {
 	_, err := readBytes(buf)
 	assert.NotNil(t, err)
 }
+
+func TestMarshalAndUnmarshalRequest(t *testing.T) {
+	t.Parallel()
+
+	expected := plugin.PluginRequestT{
+		Cookie: 42,
+		Request: &client.RequestT{
+			Action:      client.ActionCreate,
+			Destination: "http://localhost/foo",
+			Envelope: []*client.EnvelopeT{
+				{Name: "Foo", Values: []string{"Bar"}},
+			},
+			Content: []byte("hello"),
+		},
+	}
+	actual, err := UnmarshalRequest(MarshalRequest(&expected))
+	require.NoError(t, err)
+	assert.EqualValues(t, expected, *actual)
+}
+
+func TestMarshalAndUnmarshalResponse(t *testing.T) {
+	t.Parallel()
+
+	expected := plugin.PluginResponseT{
+		Cookie: 42,
+		Payload: &plugin.PayloadT{
+			Type: plugin.Payloadgenerated_plugin_client_Response,
+			Value: &client.ResponseT{
+				Status: client.ResponseStatusDeny,
+				Envelope: []*client.EnvelopeT{
+					{Name: "Foo", Values: []string{"Bar"}},
+				},
+				Content: []byte("denied"),
+			},
+		},
+	}
+	actual, err := UnmarshalResponse(MarshalResponse(&expected))
+	require.NoError(t, err)
+	assert.EqualValues(t, expected, *actual)
+}
+
+func TestUnmarshalResponse_Malformed(t *testing.T) {
+	t.Parallel()
+
+	_, err := UnmarshalResponse([]byte{0xff, 0xff, 0xff, 0x7f})
+	assert.Error(t, err)
+}
@@ -9,153 +9,515 @@ package plugin
  */
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/Southclaws/fault"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	genPlugin "github.com/siemens/wfx/generated/plugin"
 	"github.com/siemens/wfx/generated/plugin/client"
 	"github.com/siemens/wfx/middleware/logging"
+	"github.com/siemens/wfx/middleware/metrics"
+	"github.com/siemens/wfx/middleware/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var (
+	errUnavailable = errors.New("plugin is unavailable")
+	errTimeout     = errors.New("plugin did not respond in time")
+	errCircuitOpen = errors.New("circuit breaker of plugin is open")
+	errStuck       = errors.New("plugin repeatedly did not respond in time")
+)
+
+// Middleware passes requests to a plugin. It supervises the plugin, i.e. it
+// restarts the plugin should it exit unexpectedly and applies the configured
+// failure policy if the plugin is unavailable.
 type Middleware struct {
 	plugin        Plugin
+	settings      Settings
+	breaker       *breaker
 	cookieCounter atomic.Uint64
-	chMessages    chan Message
-	chStop        chan struct{}
-	chErr         chan error
+	// timeouts is the number of consecutive requests the plugin did not respond to in time
+	timeouts atomic.Int32
+
+	mutex sync.RWMutex
+	// run is nil while the plugin is not running
+	run *run
+	// lastErr is the reason why the plugin is not running
+	lastErr error
+
+	stopOnce     sync.Once
+	chStop       chan struct{}
+	chSupervised chan struct{}
+	chErr        chan error
+	// chRestart asks the supervisor to restart a plugin which is stuck
+	chRestart chan struct{}
+}
+
+// run is a single execution of the plugin.
+type run struct {
+	chMessages chan Message
+	// chDone is closed as soon as the plugin exits
+	chDone  chan struct{}
+	senders sync.WaitGroup
 }
 
-func NewMiddleware(plugin Plugin, chErr chan error) (*Middleware, error) {
+// NewMiddleware starts the plugin and creates a middleware supervised according to the settings.
+func NewMiddleware(plugin Plugin, settings Settings) (*Middleware, error) {
 	log.Debug().Str("plugin", plugin.Name()).Msg("Creating plugin middleware")
-	chMessages, err := plugin.Start(chErr)
+	chPluginErr := make(chan error, 1)
+	chMessages, err := plugin.Start(chPluginErr)
 	if err != nil {
 		return nil, fault.Wrap(err)
 	}
-	return &Middleware{
-		plugin:     plugin,
-		chMessages: chMessages,
-		chStop:     make(chan struct{}),
-		chErr:      chErr,
-	}, nil
+	mw := &Middleware{
+		plugin:       plugin,
+		settings:     settings,
+		breaker:      newBreaker(settings.BreakerThreshold, settings.BreakerCooldown),
+		run:          newRun(chMessages),
+		chStop:       make(chan struct{}),
+		chSupervised: make(chan struct{}),
+		chErr:        make(chan error, 1),
+		chRestart:    make(chan struct{}, 1),
+	}
+	go mw.supervise(chPluginErr)
+	return mw, nil
+}
+
+func newRun(chMessages chan Message) *run {
+	return &run{chMessages: chMessages, chDone: make(chan struct{})}
 }
 
+// finish signals the end of the run. It waits until all pending senders have
+// given up before closing the message channel.
+func (r *run) finish() {
+	close(r.chDone)
+	r.senders.Wait()
+	close(r.chMessages)
+}
+
+// Stop stops the plugin. It is safe to call Stop more than once.
 func (mw *Middleware) Stop() {
-	close(mw.chStop)
-	close(mw.chMessages)
-	if err := mw.plugin.Stop(); err != nil {
-		log.Err(err).Str("path", mw.plugin.Name()).Msg("There was an error while stopping the plugin")
+	mw.stopOnce.Do(func() {
+		close(mw.chStop)
+		<-mw.chSupervised
+		mw.setRun(nil, errUnavailable)
+		if err := mw.plugin.Stop(); err != nil {
+			log.Err(err).Str("path", mw.plugin.Name()).Msg("There was an error while stopping the plugin")
+		}
+		close(mw.chErr)
+		log.Debug().Msg("Plugin stopped")
+	})
+}
+
+// CheckHealth returns an error if the plugin is not running or its circuit breaker is open.
+func (mw *Middleware) CheckHealth(context.Context) error {
+	mw.mutex.RLock()
+	running, lastErr := mw.run != nil, mw.lastErr
+	mw.mutex.RUnlock()
+	if !running {
+		return fmt.Errorf("%w: %w", errUnavailable, lastErr)
+	}
+	if mw.breaker.Open() {
+		return errCircuitOpen
+	}
+	return nil
+}
+
+// supervise waits for the plugin to exit (or to get stuck) and restarts it with exponential backoff.
+func (mw *Middleware) supervise(chPluginErr chan error) {
+	defer close(mw.chSupervised)
+
+	name := mw.plugin.Name()
+	backoff := mw.settings.RestartBackoff
+	started := time.Now()
+	for {
+		var err error
+		select {
+		case <-mw.chStop:
+			return
+		case err = <-chPluginErr:
+		case <-mw.chRestart:
+			log.Warn().Str("plugin", name).Int("timeouts", mw.settings.RestartTimeouts).Msg("Plugin is stuck, stopping it")
+			err = errStuck
+			mw.setRun(nil, err)
+			// the plugin does not report its exit after being stopped
+			if err := mw.plugin.Stop(); err != nil {
+				log.Err(err).Str("plugin", name).Msg("There was an error while stopping the plugin")
+			}
+		}
+		select {
+		case <-mw.chStop:
+			// the plugin exited because it was stopped
+			return
+		default:
+		}
+		if err == nil {
+			err = fmt.Errorf("plugin '%s' exited", name)
+		}
+		mw.setRun(nil, err)
+		mw.timeouts.Store(0)
+
+		if !mw.settings.Restart {
+			log.Error().Err(err).Str("plugin", name).Msg("Plugin exited unexpectedly")
+			mw.chErr <- err
+			return
+		}
+		// a plugin which has been running for a while is considered healthy again
+		if time.Since(started) > maxRestartBackoff {
+			backoff = mw.settings.RestartBackoff
+		}
+		for {
+			log.Warn().Err(err).Str("plugin", name).Dur("backoff", backoff).Msg("Restarting plugin")
+			select {
+			case <-mw.chStop:
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(max(2*backoff, time.Millisecond), maxRestartBackoff)
+
+			metrics.IncPluginRestarts(name)
+			chPluginErr = make(chan error, 1)
+			var chMessages chan Message
+			chMessages, err = mw.plugin.Start(chPluginErr)
+			if err == nil {
+				log.Info().Str("plugin", name).Msg("Plugin restarted")
+				mw.setRun(newRun(chMessages), nil)
+				started = time.Now()
+				break
+			}
+			mw.setRun(nil, err)
+		}
+	}
+}
+
+// setRun replaces the current run of the plugin.
+func (mw *Middleware) setRun(r *run, err error) {
+	mw.mutex.Lock()
+	previous := mw.run
+	mw.run, mw.lastErr = r, err
+	mw.mutex.Unlock()
+	if previous != nil {
+		previous.finish()
 	}
-	log.Debug().Msg("Plugin stopped")
 }
 
 func (mw *Middleware) Middleware() func(http.Handler) http.Handler {
+	phases := phasesOf(mw.plugin)
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			log := logging.LoggerFromCtx(r.Context()).With().Str("plugin", mw.plugin.Name()).Logger()
 
-			req := convertRequest(r, mw.cookieCounter.Add(1))
-			msg := Message{
-				request:  req,
-				response: make(chan genPlugin.PluginResponseT, 1),
+			if phases.Has(PhaseRequest) && !mw.handleRequest(w, r, log) {
+				return
+			}
+			if !phases.Has(PhaseResponse) {
+				log.Debug().Msg("Request may continue")
+				next.ServeHTTP(w, r)
+				return
 			}
+			mw.handleResponse(w, r, next, log)
+		})
+	}
+}
 
-			log.Debug().Msg("Sending request to plugin")
-			start := time.Now()
-			select {
-			case <-mw.chStop:
-				// avoid sending messages to a closed channel
-			case mw.chMessages <- msg:
-				// channel not closed, message was sent
+// handleRequest passes the request to the plugin. It returns false if the plugin
+// provided the response, i.e. the request must not be processed any further.
+func (mw *Middleware) handleRequest(w http.ResponseWriter, r *http.Request, log zerolog.Logger) bool {
+	ctx, span := mw.startSpan(r.Context(), PhaseRequest)
+	req := convertRequest(ctx, r, mw.cookieCounter.Add(1))
+	resp, err := mw.roundtrip(ctx, req, log)
+	endSpan(span, err)
+	if err != nil {
+		if mw.tolerate(err, log) {
+			return true
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return false
+	}
+
+	if resp.Payload == nil {
+		return true
+	}
+	switch resp.Payload.Type {
+	case genPlugin.Payloadgenerated_plugin_client_Response:
+		log.Debug().Msg("Sending response provided by plugin")
+		val := resp.Payload.Value.(*client.ResponseT)
+		for _, h := range val.Envelope {
+			for _, value := range h.Values {
+				w.Header().Add(h.Name, value)
 			}
+		}
+		switch val.Status {
+		case client.ResponseStatusAccept:
+			w.WriteHeader(http.StatusOK)
+		case client.ResponseStatusModified:
+			w.WriteHeader(http.StatusOK)
+		case client.ResponseStatusDeny:
+			w.WriteHeader(http.StatusForbidden)
+		case client.ResponseStatusUnavailable:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_, _ = w.Write(val.Content)
+		return false
+	case genPlugin.Payloadgenerated_plugin_client_Request:
+		log.Info().Msg("Request was modified by plugin")
+		// override http.Request with the response
+		val := resp.Payload.Value.(*client.RequestT)
 
-			log.Debug().Msg("Waiting for plugin response")
-			resp := <-msg.response
-			duration := time.Since(start)
-			log.Debug().Dur("duration", duration).Msg("Received plugin response")
-
-			if resp.Payload != nil {
-				switch resp.Payload.Type {
-				case genPlugin.Payloadgenerated_plugin_client_Response:
-					log.Debug().Msg("Sending response provided by plugin")
-					val := resp.Payload.Value.(*client.ResponseT)
-					for _, h := range val.Envelope {
-						for _, value := range h.Values {
-							w.Header().Add(h.Name, value)
-						}
-					}
-					switch val.Status {
-					case client.ResponseStatusAccept:
-						w.WriteHeader(http.StatusOK)
-					case client.ResponseStatusModified:
-						w.WriteHeader(http.StatusOK)
-					case client.ResponseStatusDeny:
-						w.WriteHeader(http.StatusForbidden)
-					case client.ResponseStatusUnavailable:
-						w.WriteHeader(http.StatusServiceUnavailable)
-					}
-					_, _ = w.Write(val.Content)
-					return
-				case genPlugin.Payloadgenerated_plugin_client_Request:
-					log.Info().Msg("Request was modified by plugin")
-					// override http.Request with the response
-					val := resp.Payload.Value.(*client.RequestT)
-
-					if parsedURL, err := url.Parse(val.Destination); err != nil {
-						log.Err(err).Str("destination", val.Destination).Msgf("Failed to parse destination %q", val.Destination)
-					} else {
-						r.URL = parsedURL
-					}
-
-					// delete existing headers
-					for k := range r.Header {
-						delete(r.Header, k)
-					}
-					if len(val.Envelope) > 0 {
-						if r.Header == nil {
-							r.Header = make(http.Header)
-						}
-						for _, h := range val.Envelope {
-							for _, value := range h.Values {
-								r.Header.Add(h.Name, value)
-							}
-						}
-					}
-				default:
-					// shouldn't happen, but it's possible; maybe a plugin version
-					// mismatch or just a poorly written plugin, in any case we
-					// don't want to continue.
-					log.Error().Int("type", int(resp.Payload.Type)).Msg("Received unsupported payload type from plugin")
-					w.WriteHeader(http.StatusInternalServerError)
-					return
+		if parsedURL, err := url.Parse(val.Destination); err != nil {
+			log.Err(err).Str("destination", val.Destination).Msgf("Failed to parse destination %q", val.Destination)
+		} else {
+			r.URL = parsedURL
+		}
+
+		// delete existing headers
+		for k := range r.Header {
+			delete(r.Header, k)
+		}
+		if len(val.Envelope) > 0 {
+			if r.Header == nil {
+				r.Header = make(http.Header)
+			}
+			for _, h := range val.Envelope {
+				for _, value := range h.Values {
+					r.Header.Add(h.Name, value)
 				}
 			}
-			log.Debug().Msg("Request may continue")
-			next.ServeHTTP(w, r)
-		})
+		}
+		return true
+	default:
+		// shouldn't happen, but it's possible; maybe a plugin version
+		// mismatch or just a poorly written plugin, in any case we
+		// don't want to continue.
+		log.Error().Int("type", int(resp.Payload.Type)).Msg("Received unsupported payload type from plugin")
+		w.WriteHeader(http.StatusInternalServerError)
+		return false
+	}
+}
+
+// handleResponse processes the request and passes the response to the plugin
+// before it is sent to the client.
+func (mw *Middleware) handleResponse(w http.ResponseWriter, r *http.Request, next http.Handler, log zerolog.Logger) {
+	// the request body is consumed by the handler, hence we have to peek beforehand
+	body, _ := logging.PeekBody(r)
+
+	buf := newResponseBuffer(w)
+	next.ServeHTTP(buf, r)
+	if buf.passthrough {
+		log.Debug().Msg("Streaming response bypasses the response phase")
+		return
+	}
+
+	ctx, span := mw.startSpan(r.Context(), PhaseResponse)
+	req := convertRequest(ctx, r, mw.cookieCounter.Add(1))
+	req.Request.Content = body
+	req.Response = &client.ServerResponseT{
+		Status:   int32(buf.StatusCode()),
+		Envelope: convertHeader(w.Header().Clone()),
+		Content:  bytes.Clone(buf.body.Bytes()),
+	}
+	resp, err := mw.roundtrip(ctx, req, log)
+	endSpan(span, err)
+	if err != nil {
+		if !mw.tolerate(err, log) {
+			// discard the response of the handler
+			header := w.Header()
+			for k := range header {
+				delete(header, k)
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		buf.commit()
+		return
+	}
+
+	if resp.Payload != nil {
+		switch resp.Payload.Type {
+		case genPlugin.Payloadgenerated_plugin_client_ServerResponse:
+			log.Info().Msg("Response was modified by plugin")
+			val := resp.Payload.Value.(*client.ServerResponseT)
+			header := w.Header()
+			for k := range header {
+				delete(header, k)
+			}
+			for _, h := range val.Envelope {
+				for _, value := range h.Values {
+					header.Add(h.Name, value)
+				}
+			}
+			// the body has possibly changed in size
+			header.Del("Content-Length")
+			if val.Status != 0 {
+				buf.statusCode = int(val.Status)
+			}
+			buf.body.Reset()
+			buf.body.Write(val.Content)
+		default:
+			log.Error().Int("type", int(resp.Payload.Type)).Msg("Received unsupported payload type from plugin")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+	buf.commit()
+}
+
+// tolerate applies the failure policy if the plugin could not process the
+// request. It returns true if the request may be processed anyway.
+func (mw *Middleware) tolerate(err error, log zerolog.Logger) bool {
+	if mw.settings.FailurePolicy == FailOpen {
+		log.Warn().Err(err).Msg("Plugin failed, continuing due to fail-open policy")
+		return true
+	}
+	log.Error().Err(err).Msg("Plugin failed, rejecting request")
+	return false
+}
+
+func (mw *Middleware) startSpan(ctx context.Context, phase Phase) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, "plugin "+mw.plugin.Name(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("wfx.plugin", mw.plugin.Name()),
+			attribute.String("wfx.plugin.phase", phase.String()),
+		))
+}
+
+// roundtrip sends the request to the plugin and waits for its response.
+func (mw *Middleware) roundtrip(ctx context.Context, req *genPlugin.PluginRequestT, log zerolog.Logger) (genPlugin.PluginResponseT, error) {
+	allowed, probe := mw.breaker.Allow()
+	if !allowed {
+		metrics.IncPluginFailures(mw.plugin.Name(), "circuit_open")
+		return genPlugin.PluginResponseT{}, errCircuitOpen
+	}
+
+	var chTimeout <-chan time.Time
+	if mw.settings.Timeout > 0 {
+		timer := time.NewTimer(mw.settings.Timeout)
+		defer timer.Stop()
+		chTimeout = timer.C
+	}
+
+	log.Debug().Msg("Sending request to plugin")
+	start := time.Now()
+	resp, err := mw.exchange(ctx, req, chTimeout)
+	duration := time.Since(start)
+	metrics.ObservePluginRoundtrip(mw.plugin.Name(), duration)
+	switch {
+	case err == nil:
+		mw.breaker.Success()
+		mw.timeouts.Store(0)
+		log.Debug().Dur("duration", duration).Msg("Received plugin response")
+	case ctx.Err() != nil:
+		// the caller gave up, this is not the plugin's fault
+		if probe {
+			mw.breaker.Cancel()
+		}
+	case errors.Is(err, errTimeout):
+		mw.breaker.Failure()
+		metrics.IncPluginFailures(mw.plugin.Name(), "timeout")
+		if mw.settings.Restart && mw.settings.RestartTimeouts > 0 && int(mw.timeouts.Add(1)) == mw.settings.RestartTimeouts {
+			select {
+			case mw.chRestart <- struct{}{}:
+			default: // a restart is already pending
+			}
+		}
+	default:
+		mw.breaker.Failure()
+		metrics.IncPluginFailures(mw.plugin.Name(), "unavailable")
 	}
+	return resp, err
 }
 
+func (mw *Middleware) exchange(ctx context.Context, req *genPlugin.PluginRequestT, chTimeout <-chan time.Time) (genPlugin.PluginResponseT, error) {
+	// once we give up waiting, the plugin forgets about the request
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	msg := Message{
+		ctx:      ctx,
+		request:  req,
+		response: make(chan genPlugin.PluginResponseT, 1),
+	}
+
+	mw.mutex.RLock()
+	current := mw.run
+	if current != nil {
+		current.senders.Add(1)
+	}
+	mw.mutex.RUnlock()
+	if current == nil {
+		return genPlugin.PluginResponseT{}, errUnavailable
+	}
+
+	var err error
+	select {
+	case current.chMessages <- msg:
+	case <-current.chDone:
+		err = errUnavailable
+	case <-chTimeout:
+		err = errTimeout
+	case <-ctx.Done():
+		err = fault.Wrap(ctx.Err())
+	}
+	current.senders.Done()
+	if err != nil {
+		return genPlugin.PluginResponseT{}, err
+	}
+
+	select {
+	case resp, ok := <-msg.response:
+		if !ok {
+			// the plugin exited before responding
+			return genPlugin.PluginResponseT{}, errUnavailable
+		}
+		return resp, nil
+	case <-chTimeout:
+		return genPlugin.PluginResponseT{}, errTimeout
+	case <-ctx.Done():
+		return genPlugin.PluginResponseT{}, fault.Wrap(ctx.Err())
+	}
+}
+
+// Errors returns a channel which receives an error if the plugin exited
+// unexpectedly and restarting is disabled. It is closed when the middleware is stopped.
 func (mw *Middleware) Errors() <-chan error {
 	return mw.chErr
 }
 
-func convertRequest(r *http.Request, cookie uint64) *genPlugin.PluginRequestT {
-	envelope := make([]*client.EnvelopeT, 0, len(r.Header))
-	for name, values := range r.Header {
-		header := client.EnvelopeT{Name: name, Values: values}
-		envelope = append(envelope, &header)
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 	}
+	span.End()
+}
+
+func convertRequest(ctx context.Context, r *http.Request, cookie uint64) *genPlugin.PluginRequestT {
+	// forward the trace context (if any) to the plugin without modifying the original request
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
 
 	req := genPlugin.PluginRequestT{
 		Cookie: cookie,
 		Request: &client.RequestT{
 			Action:      httpMethodToAction(r.Method),
 			Destination: r.URL.String(),
-			Envelope:    envelope,
+			Envelope:    convertHeader(header),
 		},
 	}
 
@@ -166,6 +528,14 @@ func convertRequest(r *http.Request, cookie uint64) *genPlugin.PluginRequestT {
 	return &req
 }
 
+func convertHeader(header http.Header) []*client.EnvelopeT {
+	envelope := make([]*client.EnvelopeT, 0, len(header))
+	for name, values := range header {
+		envelope = append(envelope, &client.EnvelopeT{Name: name, Values: values})
+	}
+	return envelope
+}
+
 func httpMethodToAction(method string) client.Action {
 	switch method {
 	case http.MethodPost:
@@ -9,16 +9,22 @@ package plugin
  */
 
 import (
+	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/siemens/wfx/generated/plugin"
 	"github.com/siemens/wfx/generated/plugin/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type StartFailPlugin struct{}
@@ -31,7 +37,7 @@ func (p StartFailPlugin) Stop() error { return nil }
 
 func TestNewMiddleware_StartFails(t *testing.T) {
 	p := StartFailPlugin{}
-	mw, err := NewMiddleware(p, make(chan error))
+	mw, err := NewMiddleware(p, DefaultSettings())
 	assert.Error(t, err)
 	assert.Nil(t, mw)
 }
@@ -67,7 +73,7 @@ func TestNewMiddleware_ModifyRequest(t *testing.T) {
 		}
 	}()
 
-	mw, err := NewMiddleware(p, make(chan error))
+	mw, err := NewMiddleware(p, DefaultSettings())
 	require.Nil(t, err)
 	defer mw.Stop()
 
@@ -105,7 +111,7 @@ func TestNewMiddleware_SendResponse(t *testing.T) {
 		}
 	}()
 
-	mw, err := NewMiddleware(p, make(chan error))
+	mw, err := NewMiddleware(p, DefaultSettings())
 	require.Nil(t, err)
 	defer mw.Stop()
 
@@ -143,7 +149,7 @@ func TestNewMiddleware_Unavailable(t *testing.T) {
 		}
 	}()
 
-	mw, err := NewMiddleware(p, make(chan error))
+	mw, err := NewMiddleware(p, DefaultSettings())
 	require.Nil(t, err)
 	defer mw.Stop()
 
@@ -191,7 +197,7 @@ func TestNewMiddleware_InvalidResponse(t *testing.T) {
 		}
 	}()
 
-	mw, err := NewMiddleware(p, make(chan error))
+	mw, err := NewMiddleware(p, DefaultSettings())
 	require.Nil(t, err)
 	defer mw.Stop()
 
@@ -207,3 +213,434 @@ func TestNewMiddleware_InvalidResponse(t *testing.T) {
 	handler.ServeHTTP(recorder, httpReq)
 	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
 }
+
+func TestConvertRequest_TraceContext(t *testing.T) {
+	traceID := trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	spanID := trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	httpReq := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{Host: "localhost", Path: "/foo"},
+	}
+	req := convertRequest(ctx, httpReq, 1)
+
+	var traceparent []string
+	for _, h := range req.Request.Envelope {
+		if h.Name == "Traceparent" {
+			traceparent = h.Values
+		}
+	}
+	assert.Equal(t, []string{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}, traceparent)
+	// original request must not be modified
+	assert.Nil(t, httpReq.Header)
+}
+
+type PhasedTestPlugin struct {
+	*TestPlugin
+	phases Phase
+}
+
+func (p PhasedTestPlugin) Phases() Phase { return p.phases }
+
+func TestNewMiddleware_ModifyResponse(t *testing.T) {
+	p := PhasedTestPlugin{TestPlugin: NewTestPlugin(), phases: PhaseResponse}
+	requests := make(chan *plugin.PluginRequestT, 1)
+	go func() {
+		for msg := range p.chMessage {
+			requests <- msg.request
+			msg.response <- plugin.PluginResponseT{
+				Cookie: msg.request.Cookie,
+				Payload: &plugin.PayloadT{
+					Type: plugin.Payloadgenerated_plugin_client_ServerResponse,
+					Value: &client.ServerResponseT{
+						Status: http.StatusAccepted,
+						Envelope: []*client.EnvelopeT{
+							{Name: "X-Redacted", Values: []string{"definition"}},
+						},
+						Content: []byte(`{"definition":"***"}`),
+					},
+				},
+			}
+		}
+	}()
+
+	mw, err := NewMiddleware(p, DefaultSettings())
+	require.Nil(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "hello", string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"definition":"secret"}`))
+	}))
+	recorder := httptest.NewRecorder()
+	httpReq := httptest.NewRequest(http.MethodGet, "http://localhost/foo", strings.NewReader("hello"))
+	handler.ServeHTTP(recorder, httpReq)
+
+	req := <-requests
+	assert.Equal(t, "hello", string(req.Request.Content))
+	require.NotNil(t, req.Response)
+	assert.Equal(t, int32(http.StatusOK), req.Response.Status)
+	assert.Equal(t, `{"definition":"secret"}`, string(req.Response.Content))
+	assert.Contains(t, req.Response.Envelope, &client.EnvelopeT{Name: "Content-Type", Values: []string{"application/json"}})
+
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Equal(t, `{"definition":"***"}`, recorder.Body.String())
+	assert.Equal(t, "definition", recorder.Header().Get("X-Redacted"))
+	assert.Empty(t, recorder.Header().Get("Content-Type"))
+}
+
+func TestNewMiddleware_ResponseUnchanged(t *testing.T) {
+	p := PhasedTestPlugin{TestPlugin: NewTestPlugin(), phases: PhaseRequest | PhaseResponse}
+	var count atomic.Int32
+	go func() {
+		for msg := range p.chMessage {
+			count.Add(1)
+			msg.response <- plugin.PluginResponseT{Cookie: msg.request.Cookie}
+		}
+	}()
+
+	mw, err := NewMiddleware(p, DefaultSettings())
+	require.Nil(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("not found"))
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "not found", recorder.Body.String())
+	assert.Equal(t, int32(2), count.Load())
+}
+
+func TestNewMiddleware_ResponseStreaming(t *testing.T) {
+	p := PhasedTestPlugin{TestPlugin: NewTestPlugin(), phases: PhaseResponse}
+	go func() {
+		for msg := range p.chMessage {
+			assert.Fail(t, "streaming responses must bypass the response phase")
+			msg.response <- plugin.PluginResponseT{Cookie: msg.request.Cookie}
+		}
+	}()
+
+	mw, err := NewMiddleware(p, DefaultSettings())
+	require.Nil(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("event 1\n"))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("event 2\n"))
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, recorder.Flushed)
+	assert.Equal(t, "event 1\nevent 2\n", recorder.Body.String())
+}
+
+func TestNewMiddleware_ResponseInvalidPayload(t *testing.T) {
+	p := PhasedTestPlugin{TestPlugin: NewTestPlugin(), phases: PhaseResponse}
+	go func() {
+		for msg := range p.chMessage {
+			msg.response <- plugin.PluginResponseT{
+				Cookie: msg.request.Cookie,
+				Payload: &plugin.PayloadT{
+					Type:  plugin.Payloadgenerated_plugin_client_Request,
+					Value: &client.RequestT{},
+				},
+			}
+		}
+	}()
+
+	mw, err := NewMiddleware(p, DefaultSettings())
+	require.Nil(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("secret"))
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+}
+
+// CrashPlugin is a plugin which can be made to exit unexpectedly.
+type CrashPlugin struct {
+	starts atomic.Int32
+	// receives the error channel of each start
+	chErrs chan chan error
+}
+
+func NewCrashPlugin() *CrashPlugin { return &CrashPlugin{chErrs: make(chan chan error, 2)} }
+
+func (p *CrashPlugin) Name() string { return "CrashPlugin" }
+
+func (p *CrashPlugin) Start(chErr chan error) (chan Message, error) {
+	p.starts.Add(1)
+	chMessage := make(chan Message)
+	go func() {
+		for msg := range chMessage {
+			msg.response <- plugin.PluginResponseT{Cookie: msg.request.Cookie}
+		}
+	}()
+	p.chErrs <- chErr
+	return chMessage, nil
+}
+
+func (p *CrashPlugin) Stop() error { return nil }
+
+// HangPlugin is a plugin which receives requests but never responds.
+func HangPlugin() (*TestPlugin, *atomic.Int32) {
+	p := NewTestPlugin()
+	var count atomic.Int32
+	go func() {
+		for range p.chMessage {
+			count.Add(1)
+		}
+	}()
+	return p, &count
+}
+
+func TestNewMiddleware_Restart(t *testing.T) {
+	p := NewCrashPlugin()
+	settings := DefaultSettings()
+	settings.RestartBackoff = time.Millisecond
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+	assert.NoError(t, mw.CheckHealth(t.Context()))
+
+	chErr := <-p.chErrs
+	chErr <- errors.New("crashed")
+	<-p.chErrs
+	assert.Equal(t, int32(2), p.starts.Load())
+	assert.Eventually(t, func() bool { return mw.CheckHealth(t.Context()) == nil }, time.Second, time.Millisecond)
+
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestNewMiddleware_NoRestart(t *testing.T) {
+	p := NewCrashPlugin()
+	settings := DefaultSettings()
+	settings.Restart = false
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	chErr := <-p.chErrs
+	chErr <- errors.New("crashed")
+	assert.EqualError(t, <-mw.Errors(), "crashed")
+	assert.ErrorContains(t, mw.CheckHealth(t.Context()), "plugin is unavailable: crashed")
+	assert.Equal(t, int32(1), p.starts.Load())
+
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		assert.Fail(t, "request must not be processed")
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
+
+func TestNewMiddleware_Timeout(t *testing.T) {
+	p, count := HangPlugin()
+	settings := DefaultSettings()
+	settings.Timeout = 10 * time.Millisecond
+	settings.BreakerThreshold = 1
+	settings.BreakerCooldown = time.Hour
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		assert.Fail(t, "request must not be processed")
+	}))
+	for range 2 {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	}
+	// the second request is rejected by the circuit breaker
+	assert.Equal(t, int32(1), count.Load())
+	assert.ErrorIs(t, mw.CheckHealth(t.Context()), errCircuitOpen)
+}
+
+func TestNewMiddleware_FailOpen(t *testing.T) {
+	p, _ := HangPlugin()
+	settings := DefaultSettings()
+	settings.Timeout = 10 * time.Millisecond
+	settings.FailurePolicy = FailOpen
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "ok", recorder.Body.String())
+}
+
+func TestNewMiddleware_ResponseTimeout(t *testing.T) {
+	p, _ := HangPlugin()
+	settings := DefaultSettings()
+	settings.Timeout = 10 * time.Millisecond
+	mw, err := NewMiddleware(PhasedTestPlugin{TestPlugin: p, phases: PhaseResponse}, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("secret"))
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+	assert.Empty(t, recorder.Header().Get("Content-Type"))
+}
+
+func TestNewMiddleware_CanceledRequest(t *testing.T) {
+	p, _ := HangPlugin()
+	settings := DefaultSettings()
+	settings.Timeout = 0
+	settings.BreakerThreshold = 1
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/foo", nil))
+	// the client gave up, this does not count as a plugin failure
+	assert.NoError(t, mw.CheckHealth(t.Context()))
+}
+
+func TestNewMiddleware_CanceledProbe(t *testing.T) {
+	p, count := HangPlugin()
+	settings := DefaultSettings()
+	settings.Timeout = 50 * time.Millisecond
+	settings.BreakerThreshold = 1
+	settings.BreakerCooldown = 0
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	// the plugin does not respond, which opens the circuit
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+	assert.ErrorIs(t, mw.CheckHealth(t.Context()), errCircuitOpen)
+
+	// the client of the probe gives up
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Millisecond)
+	defer cancel()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/foo", nil))
+	assert.Equal(t, int32(2), count.Load())
+
+	// hence the next request probes the plugin again
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+	assert.Equal(t, int32(3), count.Load())
+}
+
+// StuckPlugin is a plugin which accepts requests like a real plugin but never responds.
+type StuckPlugin struct {
+	starts  atomic.Int32
+	stops   atomic.Int32
+	pending *pendingResponses
+}
+
+func (p *StuckPlugin) Name() string { return "StuckPlugin" }
+
+func (p *StuckPlugin) Start(chan error) (chan Message, error) {
+	p.starts.Add(1)
+	chMessage := make(chan Message)
+	go func() {
+		for msg := range chMessage {
+			p.pending.Add(msg)
+		}
+	}()
+	return chMessage, nil
+}
+
+func (p *StuckPlugin) Stop() error {
+	p.stops.Add(1)
+	return nil
+}
+
+func TestNewMiddleware_StuckPlugin(t *testing.T) {
+	p := &StuckPlugin{pending: newPendingResponses()}
+	settings := DefaultSettings()
+	settings.Timeout = 10 * time.Millisecond
+	settings.RestartBackoff = time.Millisecond
+	settings.RestartTimeouts = 2
+	settings.BreakerThreshold = 0
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		assert.Fail(t, "request must not be processed")
+	}))
+	for range settings.RestartTimeouts {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	}
+
+	// the requests which timed out are discarded
+	assert.Eventually(t, func() bool {
+		p.pending.mutex.Lock()
+		defer p.pending.mutex.Unlock()
+		return len(p.pending.responses) == 0
+	}, time.Second, time.Millisecond)
+	// the plugin is restarted
+	assert.Eventually(t, func() bool { return p.starts.Load() == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, int32(1), p.stops.Load())
+	assert.Eventually(t, func() bool { return mw.CheckHealth(t.Context()) == nil }, time.Second, time.Millisecond)
+}
+
+func TestNewMiddleware_StuckPluginNoRestart(t *testing.T) {
+	p := &StuckPlugin{pending: newPendingResponses()}
+	settings := DefaultSettings()
+	settings.Timeout = 10 * time.Millisecond
+	settings.Restart = false
+	settings.RestartTimeouts = 1
+	settings.BreakerThreshold = 0
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	for range 2 {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	}
+	assert.Equal(t, int32(1), p.starts.Load())
+	assert.Zero(t, p.stops.Load())
+	assert.NoError(t, mw.CheckHealth(t.Context()))
+}
+
+func TestMiddleware_StopTwice(t *testing.T) {
+	p := NewCrashPlugin()
+	mw, err := NewMiddleware(p, DefaultSettings())
+	require.NoError(t, err)
+	mw.Stop()
+	assert.NotPanics(t, mw.Stop)
+}
@@ -0,0 +1,108 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"sync"
+
+	genPlugin "github.com/siemens/wfx/generated/plugin"
+)
+
+// maxAbandoned is the number of abandoned requests which are remembered in
+// order to tolerate late responses.
+const maxAbandoned = 1024
+
+// pendingResponses keeps track of the requests sent to a plugin which have not been answered yet.
+type pendingResponses struct {
+	mutex     sync.Mutex
+	responses map[uint64]pendingResponse
+	// abandoned contains the cookies of requests whose sender gave up waiting, oldest first
+	abandoned []uint64
+}
+
+type pendingResponse struct {
+	chResp chan genPlugin.PluginResponseT
+	// stop unregisters the removal of the request once its context is done
+	stop func() bool
+}
+
+func newPendingResponses() *pendingResponses {
+	return &pendingResponses{responses: make(map[uint64]pendingResponse)}
+}
+
+// Add registers the channel which receives the response for the message. The
+// request is removed as soon as the sender gives up waiting for the response.
+func (p *pendingResponses) Add(msg Message) {
+	cookie := msg.request.Cookie
+	entry := pendingResponse{chResp: msg.response, stop: func() bool { return false }}
+	if msg.ctx != nil {
+		entry.stop = context.AfterFunc(msg.ctx, func() { p.Remove(cookie) })
+	}
+	p.mutex.Lock()
+	p.responses[cookie] = entry
+	p.mutex.Unlock()
+}
+
+// Remove discards the pending request for the cookie, e.g. because the sender
+// timed out. A late response for the cookie is silently dropped by Deliver.
+func (p *pendingResponses) Remove(cookie uint64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if _, ok := p.responses[cookie]; !ok {
+		return
+	}
+	delete(p.responses, cookie)
+	if len(p.abandoned) == maxAbandoned {
+		p.abandoned = p.abandoned[1:]
+	}
+	p.abandoned = append(p.abandoned, cookie)
+}
+
+// Deliver passes the response to the waiting caller. It returns false if there
+// is no pending (or abandoned) request for the response's cookie.
+func (p *pendingResponses) Deliver(resp *genPlugin.PluginResponseT) bool {
+	p.mutex.Lock()
+	entry, ok := p.responses[resp.Cookie]
+	delete(p.responses, resp.Cookie)
+	if !ok {
+		abandoned := p.forget(resp.Cookie)
+		p.mutex.Unlock()
+		return abandoned
+	}
+	p.mutex.Unlock()
+	entry.stop()
+	entry.chResp <- *resp
+	close(entry.chResp) // there can only be one response
+	return true
+}
+
+// forget removes the cookie from the abandoned requests. It reports whether the cookie was found.
+func (p *pendingResponses) forget(cookie uint64) bool {
+	for i, abandoned := range p.abandoned {
+		if abandoned == cookie {
+			p.abandoned = append(p.abandoned[:i], p.abandoned[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Abort closes the response channels of all pending requests, signaling to the
+// waiting callers that there won't be a response.
+func (p *pendingResponses) Abort() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for cookie, entry := range p.responses {
+		entry.stop()
+		close(entry.chResp)
+		delete(p.responses, cookie)
+	}
+	p.abandoned = nil
+}
@@ -0,0 +1,65 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	genPlugin "github.com/siemens/wfx/generated/plugin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingResponses_Deliver(t *testing.T) {
+	pending := newPendingResponses()
+	chResp := make(chan genPlugin.PluginResponseT, 1)
+	pending.Add(Message{request: &genPlugin.PluginRequestT{Cookie: 1}, response: chResp})
+
+	assert.True(t, pending.Deliver(&genPlugin.PluginResponseT{Cookie: 1}))
+	resp, ok := <-chResp
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), resp.Cookie)
+	_, ok = <-chResp
+	assert.False(t, ok)
+
+	// there can only be one response
+	assert.False(t, pending.Deliver(&genPlugin.PluginResponseT{Cookie: 1}))
+}
+
+func TestPendingResponses_Abort(t *testing.T) {
+	pending := newPendingResponses()
+	chResp := make(chan genPlugin.PluginResponseT, 1)
+	pending.Add(Message{request: &genPlugin.PluginRequestT{Cookie: 1}, response: chResp})
+	pending.Abort()
+
+	_, ok := <-chResp
+	assert.False(t, ok)
+	assert.Empty(t, pending.responses)
+}
+
+func TestPendingResponses_Abandoned(t *testing.T) {
+	pending := newPendingResponses()
+	ctx, cancel := context.WithCancel(t.Context())
+	chResp := make(chan genPlugin.PluginResponseT, 1)
+	pending.Add(Message{ctx: ctx, request: &genPlugin.PluginRequestT{Cookie: 1}, response: chResp})
+
+	// the sender gives up waiting
+	cancel()
+	assert.Eventually(t, func() bool {
+		pending.mutex.Lock()
+		defer pending.mutex.Unlock()
+		return len(pending.responses) == 0
+	}, time.Second, time.Millisecond)
+
+	// a late response is dropped
+	assert.True(t, pending.Deliver(&genPlugin.PluginResponseT{Cookie: 1}))
+	assert.Empty(t, chResp)
+	assert.False(t, pending.Deliver(&genPlugin.PluginResponseT{Cookie: 1}))
+}
@@ -9,6 +9,12 @@ package plugin
  */
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
 	generated "github.com/siemens/wfx/generated/plugin"
 )
 
@@ -30,8 +36,94 @@ type Plugin interface {
 }
 
 type Message struct {
+	// ctx is done as soon as the sender no longer waits for the response
+	ctx context.Context
 	// Channel for the messages to be sent to the plugin
 	request *generated.PluginRequestT
 	// Channel to receive the plugin responses
 	response chan generated.PluginResponseT
 }
+
+// Phase denotes a point during request processing at which a plugin is invoked.
+// Phases can be combined using bitwise OR.
+type Phase uint8
+
+const (
+	// PhaseRequest invokes the plugin before the request is processed by wfx.
+	PhaseRequest Phase = 1 << iota
+	// PhaseResponse invokes the plugin after the request was processed by wfx,
+	// but before the response is sent to the client.
+	PhaseResponse
+	// PhaseValidate invokes the plugin before a job transition is persisted,
+	// allowing the plugin to veto the transition.
+	PhaseValidate
+	// PhaseNotify invokes the plugin after a job has been changed.
+	PhaseNotify
+)
+
+var phaseNames = []struct {
+	phase Phase
+	name  string
+}{
+	{phase: PhaseRequest, name: "request"},
+	{phase: PhaseResponse, name: "response"},
+	{phase: PhaseValidate, name: "validate"},
+	{phase: PhaseNotify, name: "notify"},
+}
+
+// PhaseSubscriber is implemented by plugins which declare the phases they subscribe to.
+// Plugins not implementing this interface are only invoked in the request phase.
+type PhaseSubscriber interface {
+	Phases() Phase
+}
+
+// Has reports whether all phases in other are contained in p.
+func (p Phase) Has(other Phase) bool {
+	return p&other == other
+}
+
+func (p Phase) String() string {
+	names := make([]string, 0, len(phaseNames))
+	for _, entry := range phaseNames {
+		if p.Has(entry.phase) {
+			names = append(names, entry.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// ParsePhases parses a list of phase names (e.g. "request,response") separated by commas or whitespace.
+func ParsePhases(s string) (Phase, error) {
+	var result Phase
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+	for _, field := range fields {
+		phase, err := parsePhase(field)
+		if err != nil {
+			return 0, err
+		}
+		result |= phase
+	}
+	if result == 0 {
+		return 0, errors.New("no plugin phase specified")
+	}
+	return result, nil
+}
+
+func parsePhase(name string) (Phase, error) {
+	for _, entry := range phaseNames {
+		if strings.EqualFold(entry.name, name) {
+			return entry.phase, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown plugin phase %q", name)
+}
+
+// phasesOf returns the phases the plugin subscribes to.
+func phasesOf(plugin Plugin) Phase {
+	if subscriber, ok := plugin.(PhaseSubscriber); ok {
+		if phases := subscriber.Phases(); phases != 0 {
+			return phases
+		}
+	}
+	return PhaseRequest
+}
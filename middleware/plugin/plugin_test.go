@@ -0,0 +1,45 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePhases(t *testing.T) {
+	phases, err := ParsePhases("request")
+	require.NoError(t, err)
+	assert.Equal(t, PhaseRequest, phases)
+
+	phases, err = ParsePhases(" Response,\nrequest\n")
+	require.NoError(t, err)
+	assert.Equal(t, PhaseRequest|PhaseResponse, phases)
+	assert.Equal(t, "request,response", phases.String())
+
+	phases, err = ParsePhases("validate notify")
+	require.NoError(t, err)
+	assert.Equal(t, PhaseValidate|PhaseNotify, phases)
+}
+
+func TestParsePhases_Invalid(t *testing.T) {
+	_, err := ParsePhases("request,foo")
+	assert.ErrorContains(t, err, `unknown plugin phase "foo"`)
+
+	_, err = ParsePhases(" ,")
+	assert.Error(t, err)
+}
+
+func TestPhasesOf(t *testing.T) {
+	assert.Equal(t, PhaseRequest, phasesOf(NewTestPlugin()))
+	assert.Equal(t, PhaseRequest, phasesOf(NewFBPlugin("true")))
+	assert.Equal(t, PhaseResponse, phasesOf(NewFBPlugin("true", PhaseResponse)))
+}
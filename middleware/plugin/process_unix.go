@@ -22,14 +22,14 @@ import (
 
 var gracefulTimeout = 15 * time.Second
 
-func createCmd(path string) *exec.Cmd {
-	cmd := exec.Command(path)
+func createCmd(path string, args ...string) *exec.Cmd {
+	cmd := exec.Command(path, args...)
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
 	return cmd
 }
 
-func (p *FBPlugin) terminateProcess() error {
-	pid := p.cmd.Process.Pid
+func (r *fbRun) terminateProcess() error {
+	pid := r.cmd.Process.Pid
 	if err := syscall.Kill(-pid, 0); err == nil {
 		pid = -pid // this is the pid of the process group
 	} else {
@@ -48,7 +48,7 @@ func (p *FBPlugin) terminateProcess() error {
 	done := make(chan bool)
 	go func() {
 		for i := 0; i < 100; i++ {
-			if p.waited.Load() {
+			if r.waited.Load() {
 				done <- true
 				break
 			}
@@ -36,13 +36,14 @@ done`)
 	gracefulTimeout = time.Microsecond
 
 	cmd := exec.Command(fname)
-	p := FBPlugin{cmd: cmd}
+	r := &fbRun{cmd: cmd}
+	p := FBPlugin{current: r}
 	err := cmd.Start()
 	require.NoError(t, err)
-	require.NotEqual(t, 0, p.cmd.Process.Pid)
+	require.NotEqual(t, 0, r.cmd.Process.Pid)
 
 	var g sync.WaitGroup
-	awaitKillTestHelper(&p, &g)
+	awaitKillTestHelper(r, &g)
 
 	err = p.Stop()
 	assert.NoError(t, err)
@@ -65,23 +66,24 @@ done`)
 	gracefulTimeout = time.Millisecond
 
 	cmd := exec.Command(fname)
-	p := FBPlugin{cmd: cmd}
+	r := &fbRun{cmd: cmd}
+	p := FBPlugin{current: r}
 	err := cmd.Start()
 	require.NoError(t, err)
-	require.NotEqual(t, 0, p.cmd.Process.Pid)
+	require.NotEqual(t, 0, r.cmd.Process.Pid)
 
 	var g sync.WaitGroup
-	awaitKillTestHelper(&p, &g)
+	awaitKillTestHelper(r, &g)
 
 	err = p.Stop()
 	assert.NoError(t, err)
 }
 
-func awaitKillTestHelper(p *FBPlugin, g *sync.WaitGroup) {
+func awaitKillTestHelper(r *fbRun, g *sync.WaitGroup) {
 	g.Add(1)
 	go func() {
 		defer g.Done()
-		_ = p.cmd.Wait()
-		p.waited.Store(true)
+		_ = r.cmd.Wait()
+		r.waited.Store(true)
 	}()
 }
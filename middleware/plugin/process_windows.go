@@ -17,13 +17,13 @@ import (
 	"github.com/Southclaws/fault"
 )
 
-func createCmd(path string) *exec.Cmd {
-	cmd := exec.Command(path)
+func createCmd(path string, args ...string) *exec.Cmd {
+	cmd := exec.Command(path, args...)
 	return cmd
 }
 
-func (p *FBPlugin) terminateProcess() error {
-	pid := p.cmd.Process.Pid
+func (r *fbRun) terminateProcess() error {
+	pid := r.cmd.Process.Pid
 	proc, err := os.FindProcess(pid)
 	if err != nil {
 		return fault.Wrap(err)
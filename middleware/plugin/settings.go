@@ -0,0 +1,106 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FailurePolicy determines how a request is handled if the plugin is unavailable,
+// i.e. it crashed, did not respond in time or its circuit breaker is open.
+type FailurePolicy uint8
+
+const (
+	// FailClosed rejects the request with 503 Service Unavailable.
+	FailClosed FailurePolicy = iota
+	// FailOpen processes the request as if the plugin was not installed.
+	FailOpen
+)
+
+var failurePolicyNames = []string{"closed", "open"}
+
+func (p FailurePolicy) String() string {
+	if int(p) < len(failurePolicyNames) {
+		return failurePolicyNames[p]
+	}
+	return fmt.Sprintf("FailurePolicy(%d)", p)
+}
+
+// ParseFailurePolicy parses the name of a failure policy, i.e. "closed" or "open".
+func ParseFailurePolicy(s string) (FailurePolicy, error) {
+	for i, name := range failurePolicyNames {
+		if strings.EqualFold(name, strings.TrimSpace(s)) {
+			return FailurePolicy(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown plugin failure policy %q", s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *FailurePolicy) UnmarshalText(text []byte) error {
+	policy, err := ParseFailurePolicy(string(text))
+	if err != nil {
+		return err
+	}
+	*p = policy
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p FailurePolicy) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// maxRestartBackoff caps the delay between two restarts of a crashing plugin.
+const maxRestartBackoff = time.Minute
+
+// Settings control how a plugin is supervised.
+type Settings struct {
+	// Timeout is the maximum time to wait for a plugin response; zero disables the timeout.
+	Timeout time.Duration `yaml:"timeout"`
+	// FailurePolicy determines how requests are handled if the plugin is unavailable.
+	FailurePolicy FailurePolicy `yaml:"failurePolicy"`
+	// Restart restarts the plugin if it exits unexpectedly; otherwise wfx shuts down.
+	Restart bool `yaml:"restart"`
+	// RestartBackoff is the delay before the first restart attempt. It is doubled
+	// for each consecutive attempt up to one minute.
+	RestartBackoff time.Duration `yaml:"restartBackoff"`
+	// RestartTimeouts is the number of consecutive timeouts after which a plugin
+	// is considered stuck and restarted (requires Restart); zero disables it.
+	RestartTimeouts int `yaml:"restartTimeouts"`
+	// BreakerThreshold is the number of consecutive failures after which the
+	// circuit breaker opens; zero disables the circuit breaker.
+	BreakerThreshold int `yaml:"breakerThreshold"`
+	// BreakerCooldown is the time after which an open circuit breaker lets a
+	// single request pass to probe whether the plugin has recovered.
+	BreakerCooldown time.Duration `yaml:"breakerCooldown"`
+	// Connections is the number of connections to a plugin reachable via a unix
+	// domain socket; requests are distributed among the connections.
+	Connections int `yaml:"connections"`
+	// MemoryLimit is the maximum memory (in MiB) available to a single invocation
+	// of a WebAssembly plugin; zero applies the limit of the runtime (4 GiB).
+	MemoryLimit int `yaml:"memoryLimit"`
+}
+
+// DefaultSettings returns the settings used for plugins unless configured otherwise.
+func DefaultSettings() Settings {
+	return Settings{
+		Timeout:          10 * time.Second,
+		FailurePolicy:    FailClosed,
+		Restart:          true,
+		RestartBackoff:   time.Second,
+		RestartTimeouts:  3,
+		BreakerThreshold: 5,
+		BreakerCooldown:  30 * time.Second,
+		Connections:      4,
+		MemoryLimit:      64,
+	}
+}
@@ -0,0 +1,42 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFailurePolicy(t *testing.T) {
+	policy, err := ParseFailurePolicy("closed")
+	require.NoError(t, err)
+	assert.Equal(t, FailClosed, policy)
+
+	policy, err = ParseFailurePolicy(" Open ")
+	require.NoError(t, err)
+	assert.Equal(t, FailOpen, policy)
+
+	_, err = ParseFailurePolicy("foo")
+	assert.ErrorContains(t, err, `unknown plugin failure policy "foo"`)
+}
+
+func TestFailurePolicy_Text(t *testing.T) {
+	var policy FailurePolicy
+	require.NoError(t, policy.UnmarshalText([]byte("open")))
+	assert.Equal(t, FailOpen, policy)
+
+	text, err := policy.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "open", string(text))
+
+	assert.Error(t, policy.UnmarshalText([]byte("foo")))
+	assert.Equal(t, "FailurePolicy(42)", FailurePolicy(42).String())
+}
@@ -0,0 +1,159 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Southclaws/fault"
+	"github.com/rs/zerolog/log"
+	"github.com/siemens/wfx/middleware/plugin/ioutil"
+)
+
+// compile-time check to ensure we fulfill the interfaces
+var (
+	_ Plugin          = (*SocketPlugin)(nil)
+	_ PhaseSubscriber = (*SocketPlugin)(nil)
+)
+
+// SocketPlugin is a plugin which runs as an independent service (e.g. a sidecar)
+// listening on a unix domain socket. wfx maintains a pool of connections to the
+// plugin; each connection carries the same FlatBuffer messages an FBPlugin
+// exchanges via stdin/stdout. Requests are distributed among the connections,
+// allowing the plugin to process several requests concurrently.
+type SocketPlugin struct {
+	path        string
+	connections int
+	phases      Phase
+
+	mutex      sync.Mutex
+	conns      []net.Conn
+	stopCalled atomic.Bool
+}
+
+// NewSocketPlugin creates a new plugin instance which opens the given number of
+// connections (at least one) to the unix domain socket at path. In order to
+// connect to the plugin, call the Start() function.
+func NewSocketPlugin(path string, connections int, phases ...Phase) *SocketPlugin {
+	p := &SocketPlugin{
+		path:        path,
+		connections: max(connections, 1),
+	}
+	for _, phase := range phases {
+		p.phases |= phase
+	}
+	if p.phases == 0 {
+		p.phases = PhaseRequest
+	}
+	return p
+}
+
+func (p *SocketPlugin) Name() string {
+	return p.path
+}
+
+func (p *SocketPlugin) Phases() Phase {
+	return p.phases
+}
+
+// Start connects to the plugin. If any connection is lost, all connections are
+// closed and an error is sent to chErr.
+func (p *SocketPlugin) Start(chErr chan error) (chan Message, error) {
+	log.Info().Str("path", p.path).Int("connections", p.connections).Msgf("Connecting to plugin %q", p.path)
+	p.stopCalled.Store(false)
+
+	conns := make([]net.Conn, 0, p.connections)
+	for range p.connections {
+		conn, err := net.Dial("unix", p.path)
+		if err != nil {
+			for _, conn := range conns {
+				_ = conn.Close()
+			}
+			return nil, fault.Wrap(err)
+		}
+		conns = append(conns, conn)
+	}
+	p.mutex.Lock()
+	p.conns = conns
+	p.mutex.Unlock()
+
+	// each connection attempt has its own requests, a late writer of a previous attempt must not abort them
+	pending := newPendingResponses()
+	var once sync.Once
+	disconnect := func(err error) {
+		once.Do(func() {
+			defer close(chErr)
+			for _, conn := range conns {
+				_ = conn.Close()
+			}
+			pending.Abort()
+			if !p.stopCalled.Load() {
+				log.Error().Err(err).Str("path", p.path).Msg("Lost connection to plugin")
+				chErr <- fmt.Errorf("connection to plugin '%s' lost: %w", p.Name(), err)
+			}
+		})
+	}
+
+	chMessage := make(chan Message)
+	for _, conn := range conns {
+		go p.sender(conn, chMessage, pending, disconnect)
+		go p.receiver(conn, pending, disconnect)
+	}
+	return chMessage, nil
+}
+
+// Stop closes all connections to the plugin. The plugin itself keeps running.
+func (p *SocketPlugin) Stop() error {
+	log.Info().Str("path", p.path).Msgf("Disconnecting from plugin %q", p.path)
+	p.stopCalled.Store(true)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, conn := range p.conns {
+		_ = conn.Close()
+	}
+	p.conns = nil
+	return nil
+}
+
+// sender writes the messages to the connection. All senders read from the same
+// channel, thus the requests are distributed among the connections.
+func (p *SocketPlugin) sender(conn net.Conn, chMessage <-chan Message, pending *pendingResponses, disconnect func(error)) {
+	for msg := range chMessage {
+		pending.Add(msg)
+		if err := ioutil.WriteRequest(conn, msg.request); err != nil {
+			disconnect(err)
+			// the request was registered after the connection was closed
+			pending.Abort()
+			break
+		}
+		log.Debug().Uint64("cookie", msg.request.Cookie).Msgf("Request with cookie %d sent to plugin", msg.request.Cookie)
+	}
+	log.Debug().Str("name", p.Name()).Msg("Plugin connection writer stopped")
+}
+
+func (p *SocketPlugin) receiver(conn net.Conn, pending *pendingResponses, disconnect func(error)) {
+	for {
+		resp, err := ioutil.ReadResponse(conn)
+		if err != nil {
+			// a partial message cannot be recovered from, the stream is out of sync
+			disconnect(err)
+			break
+		}
+		cookie := resp.Cookie
+		log.Debug().Uint64("cookie", cookie).Msgf("Received plugin response for cookie %d", cookie)
+		if !pending.Deliver(resp) {
+			disconnect(fmt.Errorf("received unexpected response for cookie %d", cookie))
+			break
+		}
+	}
+	log.Debug().Str("name", p.Name()).Msg("Plugin connection reader stopped")
+}
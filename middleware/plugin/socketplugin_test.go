@@ -0,0 +1,228 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/generated/plugin"
+	"github.com/siemens/wfx/middleware/plugin/ioutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// socketServer is an in-process plugin listening on a unix domain socket.
+type socketServer struct {
+	path     string
+	listener net.Listener
+	accepted atomic.Int32
+	handle   func(req *plugin.PluginRequestT) *plugin.PluginResponseT
+
+	mutex sync.Mutex
+	conns []net.Conn
+	wg    sync.WaitGroup
+}
+
+// startSocketServer starts a plugin which answers each request using handle.
+func startSocketServer(t *testing.T, handle func(req *plugin.PluginRequestT) *plugin.PluginResponseT) *socketServer {
+	// keep the path short, unix domain socket paths are limited to ~100 characters
+	dir, err := os.MkdirTemp("", "wfx")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "plugin.sock")
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	srv := &socketServer{path: path, listener: listener, handle: handle}
+	srv.wg.Go(srv.serve)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func (srv *socketServer) serve() {
+	for {
+		conn, err := srv.listener.Accept()
+		if err != nil {
+			return
+		}
+		srv.accepted.Add(1)
+		srv.mutex.Lock()
+		srv.conns = append(srv.conns, conn)
+		srv.mutex.Unlock()
+		srv.wg.Go(func() {
+			for {
+				req, err := ioutil.ReadRequest(conn)
+				if err != nil {
+					return
+				}
+				go func() {
+					if resp := srv.handle(req); resp != nil {
+						_ = ioutil.WriteResponse(conn, resp)
+					}
+				}()
+			}
+		})
+	}
+}
+
+// Disconnect closes all connections but keeps listening.
+func (srv *socketServer) Disconnect() {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	for _, conn := range srv.conns {
+		_ = conn.Close()
+	}
+	srv.conns = nil
+}
+
+func (srv *socketServer) Close() {
+	_ = srv.listener.Close()
+	srv.Disconnect()
+	srv.wg.Wait()
+}
+
+func echo(req *plugin.PluginRequestT) *plugin.PluginResponseT {
+	return &plugin.PluginResponseT{Cookie: req.Cookie}
+}
+
+func sendRequest(chMessages chan Message, cookie uint64) (plugin.PluginResponseT, bool) {
+	req := convertRequest(context.Background(), httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil), cookie)
+	msg := Message{request: req, response: make(chan plugin.PluginResponseT, 1)}
+	chMessages <- msg
+	resp, ok := <-msg.response
+	return resp, ok
+}
+
+func TestSocketPlugin_SendAndReceive(t *testing.T) {
+	srv := startSocketServer(t, echo)
+	p := NewSocketPlugin(srv.path, 3)
+	chErr := make(chan error, 1)
+	chMessages, err := p.Start(chErr)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Go(func() {
+			resp, ok := sendRequest(chMessages, uint64(i))
+			assert.True(t, ok)
+			assert.Equal(t, uint64(i), resp.Cookie)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int32(3), srv.accepted.Load())
+
+	require.NoError(t, p.Stop())
+	close(chMessages)
+	// no error is reported if the plugin is stopped
+	_, ok := <-chErr
+	assert.False(t, ok)
+}
+
+func TestSocketPlugin_Concurrency(t *testing.T) {
+	// the plugin answers only once two requests are being processed at the same time
+	var inflight sync.WaitGroup
+	inflight.Add(2)
+	srv := startSocketServer(t, func(req *plugin.PluginRequestT) *plugin.PluginResponseT {
+		inflight.Done()
+		inflight.Wait()
+		return echo(req)
+	})
+	p := NewSocketPlugin(srv.path, 2)
+	chMessages, err := p.Start(make(chan error, 1))
+	require.NoError(t, err)
+	defer close(chMessages)
+	defer func() { _ = p.Stop() }()
+
+	var wg sync.WaitGroup
+	for i := range 2 {
+		wg.Go(func() {
+			_, ok := sendRequest(chMessages, uint64(i))
+			assert.True(t, ok)
+		})
+	}
+	wg.Wait()
+}
+
+func TestSocketPlugin_StartFails(t *testing.T) {
+	p := NewSocketPlugin(filepath.Join(t.TempDir(), "does-not-exist.sock"), 1)
+	_, err := p.Start(make(chan error, 1))
+	assert.Error(t, err)
+}
+
+func TestSocketPlugin_ConnectionLost(t *testing.T) {
+	received := make(chan struct{})
+	srv := startSocketServer(t, func(*plugin.PluginRequestT) *plugin.PluginResponseT {
+		close(received)
+		return nil // never respond
+	})
+	p := NewSocketPlugin(srv.path, 2)
+	chErr := make(chan error, 1)
+	chMessages, err := p.Start(chErr)
+	require.NoError(t, err)
+	defer close(chMessages)
+
+	go func() {
+		<-received
+		srv.Disconnect()
+	}()
+	// the pending request is aborted
+	_, ok := sendRequest(chMessages, 1)
+	assert.False(t, ok)
+	assert.ErrorContains(t, <-chErr, "connection to plugin")
+}
+
+func TestSocketPlugin_UnexpectedCookie(t *testing.T) {
+	srv := startSocketServer(t, func(req *plugin.PluginRequestT) *plugin.PluginResponseT {
+		return &plugin.PluginResponseT{Cookie: req.Cookie + 1}
+	})
+	p := NewSocketPlugin(srv.path, 1)
+	chErr := make(chan error, 1)
+	chMessages, err := p.Start(chErr)
+	require.NoError(t, err)
+	defer close(chMessages)
+
+	_, ok := sendRequest(chMessages, 1)
+	assert.False(t, ok)
+	assert.ErrorContains(t, <-chErr, "received unexpected response for cookie 2")
+}
+
+func TestSocketPlugin_Reconnect(t *testing.T) {
+	srv := startSocketServer(t, echo)
+	p := NewSocketPlugin(srv.path, 1)
+	settings := DefaultSettings()
+	settings.RestartBackoff = time.Millisecond
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	assert.Eventually(t, func() bool { return srv.accepted.Load() == 1 }, time.Second, time.Millisecond)
+	srv.Disconnect()
+	assert.Eventually(t, func() bool { return srv.accepted.Load() == 2 }, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool { return mw.CheckHealth(t.Context()) == nil }, time.Second, time.Millisecond)
+
+	handler := mw.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestSocketPlugin_Phases(t *testing.T) {
+	assert.Equal(t, PhaseRequest, NewSocketPlugin("foo", 1).Phases())
+	assert.Equal(t, PhaseValidate|PhaseNotify, NewSocketPlugin("foo", 1, PhaseValidate, PhaseNotify).Phases())
+	assert.Equal(t, "foo", NewSocketPlugin("foo", 0).Name())
+}
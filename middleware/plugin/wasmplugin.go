@@ -0,0 +1,260 @@
+//go:build !no_wasm
+
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/rs/zerolog/log"
+	genPlugin "github.com/siemens/wfx/generated/plugin"
+	"github.com/siemens/wfx/middleware/plugin/ioutil"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// compile-time check to ensure we fulfill the interfaces
+var (
+	_ Plugin          = (*WasmPlugin)(nil)
+	_ PhaseSubscriber = (*WasmPlugin)(nil)
+)
+
+const (
+	// wasmAlloc is the function exported by the module which allocates a buffer for the request:
+	// wfx_alloc(size: i32) -> i32 (pointer)
+	wasmAlloc = "wfx_alloc"
+	// wasmHandle is the function exported by the module which processes the request stored in
+	// the buffer: wfx_handle(ptr: i32, size: i32) -> i64 (pointer << 32 | size of the response)
+	wasmHandle = "wfx_handle"
+	// wasmPageSize is the size of a WebAssembly memory page
+	wasmPageSize = 64 * 1024
+	// wasmMaxPages is the maximum number of memory pages of a 32-bit module
+	wasmMaxPages = 65536
+)
+
+// WasmPlugin is a plugin compiled to WebAssembly which runs within the wfx
+// process. Each request is processed by a fresh instance of the module, i.e.
+// invocations neither share memory nor state.
+type WasmPlugin struct {
+	path        string
+	args        []string
+	env         []string
+	phases      Phase
+	memoryLimit int
+
+	// cancel stops the current run
+	cancel context.CancelFunc
+}
+
+// wasmRun is a single execution of the plugin, i.e. from Start to Stop.
+type wasmRun struct {
+	ctx      context.Context
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+}
+
+// NewWasmPlugin creates a new plugin for the WebAssembly module (.wasm) subscribed
+// to the given phases (PhaseRequest if none are given). Each invocation may use
+// up to memoryLimit MiB of memory; zero applies the limit of the runtime.
+func NewWasmPlugin(path string, memoryLimit int, phases ...Phase) *WasmPlugin {
+	p := &WasmPlugin{path: path, memoryLimit: memoryLimit}
+	for _, phase := range phases {
+		p.phases |= phase
+	}
+	if p.phases == 0 {
+		p.phases = PhaseRequest
+	}
+	return p
+}
+
+// WithArgs sets the command line arguments passed to the module (WASI).
+func (p *WasmPlugin) WithArgs(args ...string) *WasmPlugin {
+	p.args = args
+	return p
+}
+
+// WithEnv sets the environment variables ("KEY=value") passed to the module (WASI).
+// Unlike executable plugins, the module does not inherit the environment of wfx.
+func (p *WasmPlugin) WithEnv(env ...string) *WasmPlugin {
+	p.env = env
+	return p
+}
+
+func (p *WasmPlugin) Name() string {
+	return p.path
+}
+
+func (p *WasmPlugin) Phases() Phase {
+	return p.phases
+}
+
+// Start compiles the module. The module is instantiated for each message.
+func (p *WasmPlugin) Start(chan error) (chan Message, error) {
+	log.Info().Str("path", p.path).Msgf("Starting plugin %q", p.path)
+	wasm, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cfg := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if p.memoryLimit > 0 {
+		cfg = cfg.WithMemoryLimitPages(uint32(min(p.memoryLimit*1024*1024/wasmPageSize, wasmMaxPages)))
+	}
+	r := &wasmRun{ctx: ctx, runtime: wazero.NewRuntimeWithConfig(ctx, cfg)}
+	if err := r.init(wasm); err != nil {
+		_ = r.runtime.Close(context.Background())
+		cancel()
+		return nil, fmt.Errorf("plugin '%s': %w", p.Name(), err)
+	}
+	p.cancel = func() {
+		cancel()
+		_ = r.runtime.Close(context.Background())
+	}
+
+	chMessage := make(chan Message)
+	go func() {
+		for msg := range chMessage {
+			go p.handle(r, msg)
+		}
+	}()
+	return chMessage, nil
+}
+
+// Stop aborts running invocations and releases the compiled module.
+func (p *WasmPlugin) Stop() error {
+	log.Info().Str("path", p.path).Msgf("Stopping plugin %q", p.path)
+	if p.cancel != nil {
+		p.cancel()
+	}
+	return nil
+}
+
+func (r *wasmRun) init(wasm []byte) error {
+	if _, err := wasi_snapshot_preview1.Instantiate(r.ctx, r.runtime); err != nil {
+		return fault.Wrap(err)
+	}
+	compiled, err := r.runtime.CompileModule(r.ctx, wasm)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	functions := compiled.ExportedFunctions()
+	for _, name := range []string{wasmAlloc, wasmHandle} {
+		if _, ok := functions[name]; !ok {
+			return fmt.Errorf("module does not export function '%s'", name)
+		}
+	}
+	if len(compiled.ExportedMemories()) == 0 {
+		return errors.New("module does not export its memory")
+	}
+	r.compiled = compiled
+	return nil
+}
+
+func (p *WasmPlugin) handle(r *wasmRun, msg Message) {
+	// closing the channel without a response signals a failure
+	defer close(msg.response)
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Error().Str("plugin", p.Name()).Str("panic", fmt.Sprint(rec)).Msg("Plugin invocation panicked")
+		}
+	}()
+
+	ctx := msg.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// the invocation is aborted once the sender gives up waiting or the plugin is stopped
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(r.ctx, cancel)
+	defer stop()
+
+	resp, err := p.invoke(ctx, r, msg.request)
+	if err != nil {
+		log.Error().Err(err).Str("plugin", p.Name()).Uint64("cookie", msg.request.Cookie).Msg("Plugin invocation failed")
+		return
+	}
+	msg.response <- *resp
+}
+
+// invoke passes the request to a new instance of the module.
+func (p *WasmPlugin) invoke(ctx context.Context, r *wasmRun, req *genPlugin.PluginRequestT) (*genPlugin.PluginResponseT, error) {
+	logs := &logWriter{plugin: p.Name()}
+	cfg := wazero.NewModuleConfig().
+		WithName(""). // allows concurrent instances
+		WithStartFunctions("_initialize").
+		WithArgs(append([]string{p.path}, p.args...)...).
+		WithStdout(logs).
+		WithStderr(logs).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithRandSource(rand.Reader)
+	for _, kv := range p.env {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			cfg = cfg.WithEnv(k, v)
+		}
+	}
+	mod, err := r.runtime.InstantiateModule(ctx, r.compiled, cfg)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	defer mod.Close(context.Background())
+
+	data := ioutil.MarshalRequest(req)
+	results, err := mod.ExportedFunction(wasmAlloc).Call(ctx, uint64(len(data)))
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	ptr := uint32(results[0])
+	if !mod.Memory().Write(ptr, data) {
+		return nil, fmt.Errorf("buffer allocated by %s is out of range", wasmAlloc)
+	}
+
+	results, err = mod.ExportedFunction(wasmHandle).Call(ctx, uint64(ptr), uint64(len(data)))
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	ptr, size := uint32(results[0]>>32), uint32(results[0])
+	if size == 0 {
+		// no payload, i.e. the request is left unchanged
+		return &genPlugin.PluginResponseT{Cookie: req.Cookie}, nil
+	}
+	buf, ok := mod.Memory().Read(ptr, size)
+	if !ok {
+		return nil, fmt.Errorf("response returned by %s is out of range", wasmHandle)
+	}
+	// the memory is released when the module is closed
+	resp, err := ioutil.UnmarshalResponse(bytes.Clone(buf))
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	resp.Cookie = req.Cookie
+	return resp, nil
+}
+
+// logWriter forwards the output of a module to the log.
+type logWriter struct {
+	plugin string
+}
+
+func (w *logWriter) Write(data []byte) (int, error) {
+	for line := range strings.SplitSeq(strings.TrimRight(string(data), "\n"), "\n") {
+		log.Debug().Str("path", w.plugin).Str("msg", line).Msg("Plugin log message")
+	}
+	return len(data), nil
+}
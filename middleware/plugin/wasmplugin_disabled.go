@@ -0,0 +1,58 @@
+//go:build no_wasm
+
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+)
+
+// WasmPlugin is a plugin compiled to WebAssembly. This binary was built without
+// WebAssembly support, hence the plugin cannot be started.
+type WasmPlugin struct {
+	path   string
+	phases Phase
+}
+
+// NewWasmPlugin creates a plugin which fails to start since this binary was built without WebAssembly support.
+func NewWasmPlugin(path string, _ int, phases ...Phase) *WasmPlugin {
+	p := &WasmPlugin{path: path}
+	for _, phase := range phases {
+		p.phases |= phase
+	}
+	if p.phases == 0 {
+		p.phases = PhaseRequest
+	}
+	return p
+}
+
+func (p *WasmPlugin) WithArgs(...string) *WasmPlugin {
+	return p
+}
+
+func (p *WasmPlugin) WithEnv(...string) *WasmPlugin {
+	return p
+}
+
+func (p *WasmPlugin) Name() string {
+	return p.path
+}
+
+func (p *WasmPlugin) Phases() Phase {
+	return p.phases
+}
+
+func (p *WasmPlugin) Start(chan error) (chan Message, error) {
+	return nil, errors.New("this binary was built without WebAssembly support")
+}
+
+func (p *WasmPlugin) Stop() error {
+	return nil
+}
@@ -0,0 +1,170 @@
+//go:build !no_wasm
+
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/generated/plugin"
+	"github.com/siemens/wfx/generated/plugin/client"
+	"github.com/siemens/wfx/middleware/plugin/ioutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// wasmTestModule describes a minimal WebAssembly module implementing the plugin ABI.
+type wasmTestModule struct {
+	// pages is the initial size of the memory
+	pages uint32
+	// response is returned by wfx_handle for every request
+	response []byte
+	// loop lets wfx_handle loop forever
+	loop bool
+}
+
+// responseOffset is the address at which the response is stored in the memory of the test module
+const responseOffset = 1024
+
+// build assembles the binary representation of the module.
+func (m wasmTestModule) build() []byte {
+	uleb := func(v uint64) []byte { return binary.AppendUvarint(nil, v) }
+	sleb := func(v int64) []byte {
+		var out []byte
+		for {
+			b := byte(v & 0x7f)
+			v >>= 7
+			if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+				return append(out, b)
+			}
+			out = append(out, b|0x80)
+		}
+	}
+	concat := func(parts ...[]byte) []byte {
+		var out []byte
+		for _, part := range parts {
+			out = append(out, part...)
+		}
+		return out
+	}
+	sized := func(data []byte) []byte { return concat(uleb(uint64(len(data))), data) }
+	section := func(id byte, content ...[]byte) []byte { return concat([]byte{id}, sized(concat(content...))) }
+
+	handle := []byte{}
+	if m.loop {
+		handle = []byte{0x03, 0x40, 0x0c, 0x00, 0x0b} // loop br 0 end
+	}
+	result := int64(responseOffset)<<32 | int64(len(m.response))
+	handle = concat(handle, []byte{0x42}, sleb(result), []byte{0x0b}) // i64.const result end
+
+	return concat(
+		[]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}, // magic, version
+		// types: (i32) -> i32, (i32, i32) -> i64
+		section(0x01, uleb(2), []byte{0x60, 0x01, 0x7f, 0x01, 0x7f}, []byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e}),
+		// functions: wfx_alloc, wfx_handle
+		section(0x03, uleb(2), []byte{0x00, 0x01}),
+		// memory without maximum
+		section(0x05, uleb(1), []byte{0x00}, uleb(uint64(m.pages))),
+		section(0x07, uleb(3),
+			sized([]byte("memory")), []byte{0x02, 0x00},
+			sized([]byte(wasmAlloc)), []byte{0x00, 0x00},
+			sized([]byte(wasmHandle)), []byte{0x00, 0x01}),
+		section(0x0a, uleb(2),
+			// the request is written to the second page
+			sized(concat([]byte{0x00, 0x41}, sleb(wasmPageSize), []byte{0x0b})),
+			sized(concat([]byte{0x00}, handle))),
+		section(0x0b, uleb(1),
+			[]byte{0x00, 0x41}, sleb(responseOffset), []byte{0x0b}, sized(m.response)),
+	)
+}
+
+func writeWasmModule(t *testing.T, m wasmTestModule) string {
+	fname := path.Join(t.TempDir(), "plugin.wasm")
+	require.NoError(t, os.WriteFile(fname, m.build(), 0o644))
+	return fname
+}
+
+func serveWasmPlugin(t *testing.T, p *WasmPlugin, settings Settings, target string) *httptest.ResponseRecorder {
+	mw, err := NewMiddleware(p, settings)
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	handler := mw.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	return recorder
+}
+
+func TestWasmPlugin_Reply(t *testing.T) {
+	response := ioutil.MarshalResponse(&plugin.PluginResponseT{
+		Payload: &plugin.PayloadT{
+			Type:  plugin.Payloadgenerated_plugin_client_Response,
+			Value: &client.ResponseT{Status: client.ResponseStatusDeny, Content: []byte("forbidden")},
+		},
+	})
+	fname := writeWasmModule(t, wasmTestModule{pages: 2, response: response})
+
+	recorder := serveWasmPlugin(t, NewWasmPlugin(fname, 1), DefaultSettings(), "http://localhost/workflows")
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Equal(t, "forbidden", recorder.Body.String())
+}
+
+func TestWasmPlugin_NoPayload(t *testing.T) {
+	fname := writeWasmModule(t, wasmTestModule{pages: 2})
+
+	recorder := serveWasmPlugin(t, NewWasmPlugin(fname, 1), DefaultSettings(), "http://localhost/jobs")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "/jobs", recorder.Body.String())
+}
+
+func TestWasmPlugin_Timeout(t *testing.T) {
+	fname := writeWasmModule(t, wasmTestModule{pages: 2, loop: true})
+	settings := DefaultSettings()
+	settings.Timeout = 100 * time.Millisecond
+
+	// the invocation is aborted once the middleware gives up (checked by goleak)
+	recorder := serveWasmPlugin(t, NewWasmPlugin(fname, 1), settings, "http://localhost/jobs")
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
+
+func TestWasmPlugin_MemoryLimit(t *testing.T) {
+	// 32 pages = 2 MiB
+	fname := writeWasmModule(t, wasmTestModule{pages: 32})
+
+	p := NewWasmPlugin(fname, 1)
+	_, err := p.Start(make(chan error))
+	require.Error(t, err)
+
+	p = NewWasmPlugin(fname, 2)
+	chMessages, err := p.Start(make(chan error))
+	require.NoError(t, err)
+	close(chMessages)
+	require.NoError(t, p.Stop())
+}
+
+func TestWasmPlugin_InvalidModule(t *testing.T) {
+	fname := path.Join(t.TempDir(), "plugin.wasm")
+	require.NoError(t, os.WriteFile(fname, []byte("#!/bin/sh"), 0o644))
+
+	_, err := NewWasmPlugin(fname, 0).Start(make(chan error))
+	assert.Error(t, err)
+}
+
+func TestWasmPlugin_StopWithoutStart(t *testing.T) {
+	assert.NoError(t, NewWasmPlugin("plugin.wasm", 0).Stop())
+}
@@ -0,0 +1,116 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/Southclaws/fault"
+)
+
+// responseBuffer holds back the response of a handler so that it can be passed
+// to plugins subscribed to the response phase before it is sent to the client.
+//
+// Streaming responses (i.e. handlers which flush or hijack the connection, such
+// as server-sent events) cannot be held back; in this case the buffered data is
+// sent to the client and the response bypasses the response phase.
+type responseBuffer struct {
+	w           http.ResponseWriter
+	statusCode  int
+	body        bytes.Buffer
+	passthrough bool
+}
+
+// responseBuffer implements the following interfaces (compile-time check):
+var (
+	_ http.Flusher  = (*responseBuffer)(nil)
+	_ http.Hijacker = (*responseBuffer)(nil)
+)
+
+func newResponseBuffer(w http.ResponseWriter) *responseBuffer {
+	return &responseBuffer{w: w}
+}
+
+// Header returns the header map of the underlying writer; it is not sent
+// before the response is committed.
+func (b *responseBuffer) Header() http.Header {
+	return b.w.Header()
+}
+
+func (b *responseBuffer) WriteHeader(statusCode int) {
+	if b.passthrough {
+		b.w.WriteHeader(statusCode)
+		return
+	}
+	if b.statusCode == 0 {
+		b.statusCode = statusCode
+	}
+}
+
+func (b *responseBuffer) Write(data []byte) (int, error) {
+	if b.passthrough {
+		n, err := b.w.Write(data)
+		return n, fault.Wrap(err)
+	}
+	n, err := b.body.Write(data)
+	return n, fault.Wrap(err)
+}
+
+// StatusCode returns the status code set by the handler. If the handler did not
+// call WriteHeader explicitly, http.StatusOK is assumed.
+func (b *responseBuffer) StatusCode() int {
+	if b.statusCode == 0 {
+		return http.StatusOK
+	}
+	return b.statusCode
+}
+
+// Flush sends the buffered response to the client and switches to passthrough mode.
+func (b *responseBuffer) Flush() {
+	b.commit()
+	if flusher, ok := b.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack switches to passthrough mode and lets the handler take over the connection.
+func (b *responseBuffer) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := b.w.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("hijacker interface not supported")
+	}
+	b.passthrough = true
+	conn, bw, err := hj.Hijack()
+	return conn, bw, fault.Wrap(err)
+}
+
+// Unwrap returns the underlying writer; this is used by http.ResponseController.
+func (b *responseBuffer) Unwrap() http.ResponseWriter {
+	return b.w
+}
+
+// commit sends the buffered response to the client. Subsequent writes are passed
+// through to the underlying writer.
+func (b *responseBuffer) commit() {
+	if b.passthrough {
+		return
+	}
+	b.passthrough = true
+	if b.statusCode != 0 || b.body.Len() > 0 {
+		b.w.WriteHeader(b.StatusCode())
+	}
+	if b.body.Len() > 0 {
+		_, _ = b.w.Write(b.body.Bytes())
+	}
+	b.body.Reset()
+}
@@ -0,0 +1,62 @@
+package plugin
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseBuffer(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	buf := newResponseBuffer(recorder)
+	buf.WriteHeader(http.StatusCreated)
+	buf.WriteHeader(http.StatusOK)
+	_, _ = buf.Write([]byte("hello"))
+
+	assert.Equal(t, http.StatusCreated, buf.StatusCode())
+	assert.Empty(t, recorder.Body.String())
+	assert.False(t, recorder.Flushed)
+
+	buf.commit()
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Equal(t, "hello", recorder.Body.String())
+
+	_, _ = buf.Write([]byte(" world"))
+	assert.Equal(t, "hello world", recorder.Body.String())
+}
+
+func TestResponseBuffer_DefaultStatus(t *testing.T) {
+	buf := newResponseBuffer(httptest.NewRecorder())
+	assert.Equal(t, http.StatusOK, buf.StatusCode())
+}
+
+type hijackRecorder struct{ *httptest.ResponseRecorder }
+
+func (hijackRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) { return nil, nil, nil }
+
+func TestResponseBuffer_Hijack(t *testing.T) {
+	buf := newResponseBuffer(hijackRecorder{httptest.NewRecorder()})
+	_, _, err := buf.Hijack()
+	require.NoError(t, err)
+	assert.True(t, buf.passthrough)
+}
+
+func TestResponseBuffer_HijackNotSupported(t *testing.T) {
+	buf := newResponseBuffer(httptest.NewRecorder())
+	_, _, err := buf.Hijack()
+	assert.Error(t, err)
+	assert.False(t, buf.passthrough)
+}
@@ -9,10 +9,11 @@ package sse
  */
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -44,36 +45,33 @@ func NewResponder(ctx context.Context, idleDuration time.Duration, subscriber *e
 
 func (responder Responder) VisitGetJobsEventsResponse(w http.ResponseWriter) error {
 	log := logging.LoggerFromCtx(responder.ctx).With().Str("subscriberID", responder.subscriber.ID()).Logger()
-
-	// Check if the ResponseWriter supports hijacking
-	hj, ok := w.(http.Hijacker)
-	if !ok { // e.g. if HTTP/2 is being used
-		return fmt.Errorf("http.Hijacker interface not supported")
-	}
-
-	// use raw connection to prevent it being closed by http.Server's idle cleanup routines
-	conn, bufrw, err := hj.Hijack()
-	if err != nil {
-		return fmt.Errorf("failed to hijack connection: %w", err)
-	}
 	defer func() {
 		events.RemoveSubscriber(responder.subscriber)
 		log.Info().Msg("Closing connection to event subscriber")
-		_ = conn.Close()
 	}()
 
-	_, _ = fmt.Fprintf(bufrw, "HTTP/1.1 %d\r\n", http.StatusOK)
-	_, _ = bufrw.WriteString("Content-Type: text/event-stream\r\n")
-	_, _ = bufrw.WriteString("Cache-Control: no-cache\r\n")
-	_, _ = bufrw.WriteString("Connection: keep-alive\r\n")
-	_, _ = bufrw.WriteString("Access-Control-Allow-Origin: *\r\n")
-	_, _ = bufrw.WriteString("X-Accel-Buffering: no\r\n") // notify reverse proxy to disable buffering
+	// The stream is long-lived, hence the read and write timeouts of the server
+	// must not apply. Instead, the write deadline is extended whenever data is
+	// sent, so that unresponsive clients are still disconnected. This works for
+	// HTTP/1.1 and HTTP/2 alike, unlike hijacking the connection.
+	rc := http.NewResponseController(w)
+	if err := rc.SetReadDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Err(err).Msg("Failed to clear read deadline")
+		return fault.Wrap(err)
+	}
+	if err := responder.extendWriteDeadline(rc); err != nil {
+		log.Err(err).Msg("Failed to set write deadline")
+		return fault.Wrap(err)
+	}
 
-	// finish header section
-	_, _ = bufrw.WriteString("\r\n")
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no") // notify reverse proxy to disable buffering
+	w.WriteHeader(http.StatusOK)
 
-	if err := bufrw.Flush(); err != nil {
-		log.Err(err).Msg("Failed to send event to client")
+	if err := responder.flush(rc); err != nil {
+		log.Err(err).Msg("Failed to send header to client")
 		return fault.Wrap(err)
 	}
 
@@ -90,7 +88,7 @@ Loop:
 				log.Debug().Msg("Channel closed")
 				break Loop
 			}
-			if err := sendEvent(id, &ev, bufrw); err != nil {
+			if err := responder.sendEvent(id, &ev, w, rc); err != nil {
 				log.Err(err).Msg("Failed to send event")
 				return fault.Wrap(err)
 			}
@@ -102,7 +100,7 @@ Loop:
 				if !ok {
 					break
 				}
-				if err := sendEvent(id, ev, bufrw); err != nil {
+				if err := responder.sendEvent(id, ev, w, rc); err != nil {
 					log.Err(err).Msg("Failed to send event from backlog")
 					return fault.Wrap(err)
 				}
@@ -112,12 +110,14 @@ Loop:
 				continue Loop
 			}
 			log.Debug().Msg("Sending keep-alive to client")
-			_, err = bufrw.WriteString(": keepalive\n\n")
-			if err != nil {
+			if err := responder.extendWriteDeadline(rc); err != nil {
+				return fault.Wrap(err)
+			}
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
 				log.Err(err).Msg("Error writing keep-alive")
 				return fault.Wrap(err)
 			}
-			if err := bufrw.Flush(); err != nil {
+			if err := responder.flush(rc); err != nil {
 				log.Err(err).Msg("Failed to send keep-alive to client")
 				return fault.Wrap(err)
 			}
@@ -130,21 +130,45 @@ Loop:
 	return nil
 }
 
-func sendEvent(id uint64, ev *events.JobEvent, bufrw *bufio.ReadWriter) error {
+func (responder Responder) sendEvent(id uint64, ev *events.JobEvent, w http.ResponseWriter, rc *http.ResponseController) error {
 	b, _ := json.Marshal(ev)
 	log.Debug().RawJSON("event", b).Msg("Sending event to client")
 
+	if err := responder.extendWriteDeadline(rc); err != nil {
+		return fault.Wrap(err)
+	}
 	// must end with two newlines as required by the SSE spec:
-	_, err := fmt.Fprintf(bufrw, "data: %s\nid: %d\n\n", b, id)
+	_, err := fmt.Fprintf(w, "data: %s\nid: %d\n\n", b, id)
 	if err != nil {
 		log.Err(err).Msg("Cannot write to buffer")
 		return fault.Wrap(err)
 	}
 
-	if err := bufrw.Flush(); err != nil {
+	if err := responder.flush(rc); err != nil {
 		log.Err(err).Msg("Failed to send event to client")
 		return fault.Wrap(err)
 	}
 
 	return nil
 }
+
+// extendWriteDeadline allows the client two idle durations to receive the next
+// write. Since a keep-alive is sent after each idle duration, the deadline is
+// extended before it expires unless the client stops reading.
+func (responder Responder) extendWriteDeadline(rc *http.ResponseController) error {
+	err := rc.SetWriteDeadline(time.Now().Add(2 * responder.idleDuration))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fault.Wrap(err)
+	}
+	return nil
+}
+
+func (responder Responder) flush(rc *http.ResponseController) error {
+	if err := rc.Flush(); err != nil {
+		if errors.Is(err, http.ErrNotSupported) {
+			return errors.New("http.Flusher interface not supported")
+		}
+		return fault.Wrap(err)
+	}
+	return nil
+}
@@ -9,11 +9,13 @@ package sse
  */
 
 import (
-	"context"
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"strings"
-	"sync"
 	"testing"
 	"time"
 
@@ -24,57 +26,92 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestResponder(t *testing.T) {
-	ctx, cancel := context.WithCancel(t.Context())
-	t.Cleanup(cancel)
-	sub := events.AddSubscriber(ctx, time.Minute, events.FilterParams{}, []string{})
-
-	events.PublishEvent(ctx, events.JobEvent{
-		Action: events.ActionUpdateStatus,
-		Job: &api.Job{
-			ID: "1",
-			Status: &api.JobStatus{
-				ClientID: "foo",
-				Message:  "hello world",
-				State:    "INSTALLING",
-			},
-		},
-		Tags: []string{},
-	})
-
-	rw := NewMockResponseRecorder(t)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		responder := NewResponder(ctx, time.Minute, sub)
-		if err := responder.VisitGetJobsEventsResponse(rw); err != nil {
-			t.Log("Received error from VisitGetJobsEventsResponse:", err)
-			t.Fail()
-		}
-	}()
-
-	expected := `{"ctime":"0001-01-01T00:00:00.000Z","action":"UPDATE_STATUS","job":{"id":"1","status":{"clientId":"foo","message":"hello world","state":"INSTALLING"}},"tags":[]}`
-
-	var resp string
-	for range 100 {
-		resp = rw.Response()
-		time.Sleep(10 * time.Millisecond)
-		if strings.Contains(resp, "data:") {
-			break
-		}
+// newTestServer serves the job events of sub; chErr receives the result of the responder.
+func newTestServer(t *testing.T, idleDuration time.Duration, sub *events.Subscriber, http2 bool) (*httptest.Server, chan error) {
+	chErr := make(chan error, 1)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chErr <- NewResponder(r.Context(), idleDuration, sub).VisitGetJobsEventsResponse(w)
+	}))
+	// long-lived streams must not be affected by the write timeout of the server
+	srv.Config.WriteTimeout = 100 * time.Millisecond
+	srv.Config.ReadTimeout = 100 * time.Millisecond
+	if http2 {
+		srv.EnableHTTP2 = true
+		srv.StartTLS()
+	} else {
+		srv.Start()
 	}
+	t.Cleanup(srv.Close)
+	return srv, chErr
+}
 
-	obj, err := extractAndParseData(resp)
+func get(t *testing.T, srv *httptest.Server) *http.Response {
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err := srv.Client().Do(req)
 	require.NoError(t, err)
-	objJson, _ := json.Marshal(obj)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
 
-	assert.JSONEq(t, expected, string(objJson))
-	assert.Contains(t, resp, "id: 1")
-	assert.Contains(t, resp, "\n\n")
+// readUntil reads the stream line by line until a line has the given prefix.
+func readUntil(t *testing.T, r *bufio.Reader, prefix string) string {
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		if strings.HasPrefix(line, prefix) {
+			return line
+		}
+	}
+}
 
-	cancel()
-	wg.Wait()
+func TestResponder(t *testing.T) {
+	for _, http2 := range []bool{false, true} {
+		t.Run(fmt.Sprintf("http2=%v", http2), func(t *testing.T) {
+			sub := events.AddSubscriber(t.Context(), time.Minute, events.FilterParams{}, []string{})
+			srv, chErr := newTestServer(t, time.Minute, sub, http2)
+
+			events.PublishEvent(t.Context(), events.JobEvent{
+				Action: events.ActionUpdateStatus,
+				Job: &api.Job{
+					ID: "1",
+					Status: &api.JobStatus{
+						ClientID: "foo",
+						Message:  "hello world",
+						State:    "INSTALLING",
+					},
+				},
+				Tags: []string{},
+			})
+
+			resp := get(t, srv)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			if http2 {
+				assert.Equal(t, 2, resp.ProtoMajor)
+			}
+			assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+			assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+
+			expected := `{"ctime":"0001-01-01T00:00:00.000Z","action":"UPDATE_STATUS","job":{"id":"1","status":{"clientId":"foo","message":"hello world","state":"INSTALLING"}},"tags":[]}`
+
+			r := bufio.NewReader(resp.Body)
+			obj, err := extractAndParseData(readUntil(t, r, "data: "))
+			require.NoError(t, err)
+			objJson, _ := json.Marshal(obj)
+			assert.JSONEq(t, expected, string(objJson))
+			assert.Equal(t, "id: 1\n", readUntil(t, r, "id: "))
+
+			// outlive the server's read and write timeouts
+			time.Sleep(200 * time.Millisecond)
+			events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionDelete, Job: &api.Job{ID: "1"}})
+			_ = readUntil(t, r, "data: ")
+			assert.Equal(t, "id: 2\n", readUntil(t, r, "id: "))
+
+			_ = resp.Body.Close()
+			assert.NoError(t, <-chErr)
+			assert.Equal(t, 0, events.SubscriberCount())
+		})
+	}
 }
 
 func extractAndParseData(response string) (map[string]any, error) {
@@ -91,73 +128,45 @@ func extractAndParseData(response string) (map[string]any, error) {
 }
 
 func TestResponder_Shutdown(t *testing.T) {
-	ctx, cancel := context.WithCancel(t.Context())
-	t.Cleanup(cancel)
-
-	events.AddSubscriber(ctx, time.Minute, events.FilterParams{}, []string{})
-	sub := events.AddSubscriber(ctx, time.Minute, events.FilterParams{}, []string{})
-
-	events.PublishEvent(ctx, events.JobEvent{
-		Action: events.ActionUpdateStatus,
-		Job: &api.Job{
-			ID: "1",
-			Status: &api.JobStatus{
-				ClientID: "foo",
-				Message:  "hello world",
-				State:    "INSTALLING",
-			},
-		},
-		Tags: []string{},
-	})
-
-	rw := NewMockResponseRecorder(t)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		responder := NewResponder(ctx, time.Minute, sub)
-		if err := responder.VisitGetJobsEventsResponse(rw); err != nil {
-			t.Log("Received error from VisitGetJobsEventsResponse:", err)
-			t.Fail()
-		}
-	}()
+	events.AddSubscriber(t.Context(), time.Minute, events.FilterParams{}, []string{})
+	sub := events.AddSubscriber(t.Context(), time.Minute, events.FilterParams{}, []string{})
+	srv, chErr := newTestServer(t, time.Minute, sub, false)
+
+	resp := get(t, srv)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 	events.ShutdownSubscribers()
 	assert.Equal(t, 0, events.SubscriberCount())
 
-	// This should trigger `responder` to clean itself up and call
-	// events.RemoveSubscriber(sub).
-	// It shouldn't try to close(sub.ch) since it will have been closed as part
-	// of events.ShutdownSubscribers()
-	cancel()
-	wg.Wait()
+	// the responder cleans itself up, it shouldn't try to close(sub.ch) since
+	// it has been closed by events.ShutdownSubscribers()
+	assert.NoError(t, <-chErr)
+	_, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
 }
 
 func TestResponder_IdlePing(t *testing.T) {
-	ctx, cancel := context.WithCancel(t.Context())
-	t.Cleanup(cancel)
-	sub := events.AddSubscriber(ctx, time.Minute, events.FilterParams{}, []string{})
-
-	rw := NewMockResponseRecorder(t)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		responder := NewResponder(ctx, time.Microsecond, sub)
-		_ = responder.VisitGetJobsEventsResponse(rw)
-	}()
-
-	expected := ": keepalive"
-	var resp string
-	for range 100 {
-		resp = rw.Response()
-		time.Sleep(10 * time.Millisecond)
-		if strings.Contains(resp, expected) {
-			break
-		}
+	for _, http2 := range []bool{false, true} {
+		t.Run(fmt.Sprintf("http2=%v", http2), func(t *testing.T) {
+			sub := events.AddSubscriber(t.Context(), time.Minute, events.FilterParams{}, []string{})
+			srv, chErr := newTestServer(t, 20*time.Millisecond, sub, http2)
+
+			resp := get(t, srv)
+			r := bufio.NewReader(resp.Body)
+			// keep-alives are sent beyond the server's write timeout
+			for range 10 {
+				assert.Equal(t, ": keepalive\n", readUntil(t, r, ":"))
+			}
+
+			_ = resp.Body.Close()
+			assert.NoError(t, <-chErr)
+		})
 	}
-	assert.Contains(t, resp, expected)
+}
 
-	cancel()
-	wg.Wait()
+func TestResponder_FlushNotSupported(t *testing.T) {
+	sub := events.AddSubscriber(t.Context(), time.Minute, events.FilterParams{}, []string{})
+	err := NewResponder(t.Context(), time.Minute, sub).VisitGetJobsEventsResponse(struct{ http.ResponseWriter }{httptest.NewRecorder()})
+	assert.ErrorContains(t, err, "http.Flusher interface not supported")
+	assert.Equal(t, 0, events.SubscriberCount())
 }
@@ -0,0 +1,55 @@
+package tracing
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"net/http"
+
+	"github.com/siemens/wfx/middleware/logging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracingMiddleware creates a middleware which starts a server span for
+// every request. An incoming W3C `traceparent` header is honored, i.e. the span
+// becomes a child of the caller's span.
+func NewTracingMiddleware(server string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			name := r.Pattern
+			if name == "" {
+				name = r.Method
+			}
+			ctx, span := Tracer().Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("wfx.server", server),
+					semconv.HTTPRequestMethodKey.String(r.Method),
+					semconv.HTTPRoute(r.Pattern),
+					semconv.URLPath(r.URL.Path),
+				))
+			defer span.End()
+
+			writer := logging.NewResponseWriter(w)
+			next.ServeHTTP(writer, r.WithContext(ctx))
+
+			code := writer.StatusCode()
+			span.SetAttributes(semconv.HTTPResponseStatusCode(code))
+			if code >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(code))
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package tracing
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// compile-time check to ensure we fulfill the interface
+var _ persistence.Storage = (*tracedStorage)(nil)
+
+// tracedStorage is a decorator which creates a span for every storage call.
+type tracedStorage struct {
+	storage persistence.Storage
+}
+
+// InstrumentStorage wraps the storage so that each call is recorded as a span.
+func InstrumentStorage(storage persistence.Storage) persistence.Storage {
+	return tracedStorage{storage: storage}
+}
+
+func startSpan(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, semconv.DBOperationName(method))
+	return Tracer().Start(ctx, "storage."+method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (s tracedStorage) Initialize(options string) error {
+	return s.storage.Initialize(options)
+}
+
+func (s tracedStorage) Shutdown() {
+	s.storage.Shutdown()
+}
+
+func (s tracedStorage) CheckHealth(ctx context.Context) error {
+	ctx, span := startSpan(ctx, "CheckHealth")
+	err := s.storage.CheckHealth(ctx)
+	endSpan(span, err)
+	return err
+}
+
+func (s tracedStorage) CreateJob(ctx context.Context, job *api.Job) (*api.Job, error) {
+	ctx, span := startSpan(ctx, "CreateJob", attribute.String("wfx.client_id", job.ClientID))
+	result, err := s.storage.CreateJob(ctx, job)
+	if result != nil {
+		span.SetAttributes(attribute.String("wfx.job_id", result.ID))
+	}
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) GetJob(ctx context.Context, jobID string, fetchParams persistence.FetchParams) (*api.Job, error) {
+	ctx, span := startSpan(ctx, "GetJob", attribute.String("wfx.job_id", jobID))
+	result, err := s.storage.GetJob(ctx, jobID, fetchParams)
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) UpdateJob(ctx context.Context, job *api.Job, request persistence.JobUpdate) (*api.Job, error) {
+	ctx, span := startSpan(ctx, "UpdateJob", attribute.String("wfx.job_id", job.ID))
+	if request.Status != nil {
+		span.SetAttributes(attribute.String("wfx.state", request.Status.State))
+	}
+	result, err := s.storage.UpdateJob(ctx, job, request)
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) DeleteJob(ctx context.Context, jobID string) error {
+	ctx, span := startSpan(ctx, "DeleteJob", attribute.String("wfx.job_id", jobID))
+	err := s.storage.DeleteJob(ctx, jobID)
+	endSpan(span, err)
+	return err
+}
+
+func (s tracedStorage) QueryJobs(ctx context.Context, filterParams persistence.FilterParams, sortParams persistence.SortParams, paginationParams persistence.PaginationParams) (*api.PaginatedJobList, error) {
+	ctx, span := startSpan(ctx, "QueryJobs")
+	result, err := s.storage.QueryJobs(ctx, filterParams, sortParams, paginationParams)
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) JobStats(ctx context.Context, filterParams persistence.FilterParams, statsParams persistence.StatsParams) (*api.JobStats, error) {
+	ctx, span := startSpan(ctx, "JobStats")
+	result, err := s.storage.JobStats(ctx, filterParams, statsParams)
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) CreateWorkflow(ctx context.Context, workflow *api.Workflow) (*api.Workflow, error) {
+	ctx, span := startSpan(ctx, "CreateWorkflow", attribute.String("wfx.workflow", workflow.Name))
+	result, err := s.storage.CreateWorkflow(ctx, workflow)
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) GetWorkflow(ctx context.Context, name string) (*api.Workflow, error) {
+	ctx, span := startSpan(ctx, "GetWorkflow", attribute.String("wfx.workflow", name))
+	result, err := s.storage.GetWorkflow(ctx, name)
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) UpdateWorkflow(ctx context.Context, workflow *api.Workflow, force bool) (*api.Workflow, error) {
+	ctx, span := startSpan(ctx, "UpdateWorkflow", attribute.String("wfx.workflow", workflow.Name))
+	result, err := s.storage.UpdateWorkflow(ctx, workflow, force)
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) DeleteWorkflow(ctx context.Context, name string) error {
+	ctx, span := startSpan(ctx, "DeleteWorkflow", attribute.String("wfx.workflow", name))
+	err := s.storage.DeleteWorkflow(ctx, name)
+	endSpan(span, err)
+	return err
+}
+
+func (s tracedStorage) WorkflowStats(ctx context.Context, name string, statsParams persistence.WorkflowStatsParams) (*api.WorkflowStats, error) {
+	ctx, span := startSpan(ctx, "WorkflowStats", attribute.String("wfx.workflow", name))
+	result, err := s.storage.WorkflowStats(ctx, name, statsParams)
+	endSpan(span, err)
+	return result, err
+}
+
+func (s tracedStorage) QueryWorkflows(ctx context.Context, sortParams persistence.SortParams, paginationParams persistence.PaginationParams) (*api.PaginatedWorkflowList, error) {
+	ctx, span := startSpan(ctx, "QueryWorkflows")
+	result, err := s.storage.QueryWorkflows(ctx, sortParams, paginationParams)
+	endSpan(span, err)
+	return result, err
+}
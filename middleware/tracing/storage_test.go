@@ -0,0 +1,103 @@
+package tracing
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func TestInstrumentStorage(t *testing.T) {
+	recorder := newRecorder(t)
+
+	dbMock := persistence.NewMockStorage(t)
+	ctx := t.Context()
+	job := &api.Job{ID: "1", ClientID: "foo"}
+	wf := &api.Workflow{Name: "wfx.test"}
+	errFail := errors.New("failure")
+
+	dbMock.EXPECT().Initialize("opts").Return(nil)
+	dbMock.EXPECT().Shutdown().Return()
+	dbMock.EXPECT().CheckHealth(mock.Anything).Return(nil)
+	dbMock.EXPECT().CreateJob(mock.Anything, job).Return(job, nil)
+	dbMock.EXPECT().GetJob(mock.Anything, "1", persistence.FetchParams{}).Return(job, nil)
+	dbMock.EXPECT().UpdateJob(mock.Anything, job, mock.Anything).Return(job, nil)
+	dbMock.EXPECT().DeleteJob(mock.Anything, "1").Return(errFail)
+	dbMock.EXPECT().QueryJobs(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(api.PaginatedJobList), nil)
+	dbMock.EXPECT().JobStats(mock.Anything, mock.Anything, mock.Anything).Return(new(api.JobStats), nil)
+	dbMock.EXPECT().CreateWorkflow(mock.Anything, wf).Return(wf, nil)
+	dbMock.EXPECT().GetWorkflow(mock.Anything, wf.Name).Return(wf, nil)
+	dbMock.EXPECT().UpdateWorkflow(mock.Anything, wf, false).Return(wf, nil)
+	dbMock.EXPECT().DeleteWorkflow(mock.Anything, wf.Name).Return(nil)
+	dbMock.EXPECT().QueryWorkflows(mock.Anything, mock.Anything, mock.Anything).Return(new(api.PaginatedWorkflowList), nil)
+	dbMock.EXPECT().WorkflowStats(mock.Anything, wf.Name, mock.Anything).Return(new(api.WorkflowStats), nil)
+
+	storage := InstrumentStorage(dbMock)
+	assert.NoError(t, storage.Initialize("opts"))
+	assert.NoError(t, storage.CheckHealth(ctx))
+	_, err := storage.CreateJob(ctx, job)
+	assert.NoError(t, err)
+	_, err = storage.GetJob(ctx, "1", persistence.FetchParams{})
+	assert.NoError(t, err)
+	_, err = storage.UpdateJob(ctx, job, persistence.JobUpdate{Status: &api.JobStatus{State: "INSTALLING"}})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, storage.DeleteJob(ctx, "1"), errFail)
+	_, err = storage.QueryJobs(ctx, persistence.FilterParams{}, persistence.SortParams{}, persistence.PaginationParams{})
+	assert.NoError(t, err)
+	_, err = storage.JobStats(ctx, persistence.FilterParams{}, persistence.StatsParams{})
+	assert.NoError(t, err)
+	_, err = storage.CreateWorkflow(ctx, wf)
+	assert.NoError(t, err)
+	_, err = storage.GetWorkflow(ctx, wf.Name)
+	assert.NoError(t, err)
+	_, err = storage.UpdateWorkflow(ctx, wf, false)
+	assert.NoError(t, err)
+	assert.NoError(t, storage.DeleteWorkflow(ctx, wf.Name))
+	_, err = storage.QueryWorkflows(ctx, persistence.SortParams{}, persistence.PaginationParams{})
+	assert.NoError(t, err)
+	_, err = storage.WorkflowStats(ctx, wf.Name, persistence.WorkflowStatsParams{})
+	assert.NoError(t, err)
+	storage.Shutdown()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 13)
+
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.Name())
+	}
+	assert.Equal(t, []string{
+		"storage.CheckHealth",
+		"storage.CreateJob",
+		"storage.GetJob",
+		"storage.UpdateJob",
+		"storage.DeleteJob",
+		"storage.QueryJobs",
+		"storage.JobStats",
+		"storage.CreateWorkflow",
+		"storage.GetWorkflow",
+		"storage.UpdateWorkflow",
+		"storage.DeleteWorkflow",
+		"storage.QueryWorkflows",
+		"storage.WorkflowStats",
+	}, names)
+
+	assert.Contains(t, spans[1].Attributes(), attribute.String("wfx.job_id", "1"))
+	assert.Contains(t, spans[3].Attributes(), attribute.String("wfx.state", "INSTALLING"))
+	assert.Equal(t, codes.Error, spans[4].Status().Code)
+	assert.Len(t, spans[4].Events(), 1)
+}
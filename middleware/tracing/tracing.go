@@ -0,0 +1,79 @@
+package tracing
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/Southclaws/fault"
+	"github.com/rs/zerolog/log"
+	"github.com/siemens/wfx/cmd/wfx/metadata"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/siemens/wfx"
+
+// ShutdownFunc flushes pending spans and releases the resources of the tracer provider.
+type ShutdownFunc func(ctx context.Context) error
+
+func init() {
+	// the propagator is needed even if tracing is disabled, so that incoming
+	// trace contexts are forwarded (e.g. to plugins)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Setup installs a global tracer provider which exports spans via OTLP/HTTP
+// to the given endpoint URL, e.g. "http://localhost:4318". If the endpoint is
+// empty, tracing is disabled and a no-op shutdown function is returned.
+func Setup(ctx context.Context, endpoint string) (ShutdownFunc, error) {
+	if endpoint == "" {
+		log.Debug().Msg("Tracing is disabled")
+		return func(context.Context) error { return nil }, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fault.Newf("invalid tracing endpoint %q: scheme must be http or https", endpoint)
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName("wfx"),
+		semconv.ServiceVersion(metadata.Version))
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	log.Info().Str("endpoint", endpoint).Msgf("Exporting traces to %q", endpoint)
+
+	return func(ctx context.Context) error {
+		return fault.Wrap(provider.Shutdown(ctx))
+	}, nil
+}
+
+// Tracer returns the tracer used for all wfx spans.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
@@ -0,0 +1,79 @@
+package tracing
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSetup_Disabled(t *testing.T) {
+	shutdown, err := Setup(t.Context(), "")
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(t.Context()))
+}
+
+func TestSetup_InvalidEndpoint(t *testing.T) {
+	_, err := Setup(t.Context(), "localhost:4318")
+	assert.Error(t, err)
+}
+
+func TestSetup_ExportToCollector(t *testing.T) {
+	var mu sync.Mutex
+	var spanNames []string
+	// minimal local OTLP/HTTP collector
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+		var req coltracepb.ExportTraceServiceRequest
+		if !assert.NoError(t, proto.Unmarshal(body, &req)) {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, rs := range req.GetResourceSpans() {
+			for _, ss := range rs.GetScopeSpans() {
+				for _, span := range ss.GetSpans() {
+					spanNames = append(spanNames, span.GetName())
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	shutdown, err := Setup(t.Context(), collector.URL)
+	require.NoError(t, err)
+
+	_, span := Tracer().Start(t.Context(), "test span")
+	span.End()
+
+	// flushes all pending spans
+	require.NoError(t, shutdown(t.Context()))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"test span"}, spanNames)
+}
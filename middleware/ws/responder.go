@@ -0,0 +1,233 @@
+package ws
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/coder/websocket"
+	"github.com/rs/zerolog"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/middleware/logging"
+)
+
+// Responder streams job events to a client over a WebSocket connection.
+// Unlike the SSE responder, the client can change its subscription at any
+// time by sending a command (see api.JobEventsCommand), and liveness is checked
+// using ping/pong frames.
+//
+// Accepting a WebSocket connection requires the request, hence the Responder
+// must be served by UpgradeMiddleware.
+type Responder struct {
+	// ctx is the context used to manage the lifecycle of the stream.
+	ctx context.Context
+	// pingInterval specifies the interval in which the client is pinged. A
+	// client which does not answer within the interval is disconnected.
+	pingInterval time.Duration
+	// originPatterns are the origins (host patterns) which are accepted in
+	// addition to the host of the request.
+	originPatterns []string
+	// subscriber receives the events to be sent to the client.
+	subscriber *events.Subscriber
+}
+
+// message is sent to the client. It corresponds to api.JobEventsMessage, but
+// events are encoded exactly like the server-sent events.
+type message struct {
+	Type         api.JobEventsMessageType   `json:"type"`
+	Event        *events.JobEvent           `json:"event,omitempty"`
+	Subscription *api.JobEventsSubscription `json:"subscription,omitempty"`
+	Error        string                     `json:"error,omitempty"`
+}
+
+// NewResponder creates a responder for the subscriber. Browsers may only connect
+// from the same origin or from an origin matching one of the originPatterns
+// (see path.Match), e.g. "*.example.com".
+func NewResponder(ctx context.Context, pingInterval time.Duration, originPatterns []string, subscriber *events.Subscriber) Responder {
+	return Responder{ctx: ctx, pingInterval: pingInterval, originPatterns: originPatterns, subscriber: subscriber}
+}
+
+func (responder Responder) VisitGetJobsEventsWsResponse(http.ResponseWriter) error {
+	events.RemoveSubscriber(responder.subscriber)
+	return errors.New("accepting a WebSocket connection requires the request, use ws.UpgradeMiddleware")
+}
+
+func (responder Responder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := logging.LoggerFromCtx(responder.ctx).With().Str("subscriberID", responder.subscriber.ID()).Logger()
+	defer events.RemoveSubscriber(responder.subscriber)
+
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		// CORS does not apply to WebSockets, i.e. without this check any website could connect on behalf of the user
+		OriginPatterns: responder.originPatterns,
+	})
+	if err != nil {
+		// Accept has already replied to the client
+		log.Err(err).Msg("Failed to accept WebSocket connection")
+		return
+	}
+	defer func() {
+		log.Info().Msg("Closing connection to event subscriber")
+		_ = conn.CloseNow()
+	}()
+
+	ctx, cancel := context.WithCancel(responder.ctx)
+	defer cancel()
+
+	if err := responder.send(ctx, conn, subscriptionMessage(responder.subscriber.Subscription())); err != nil {
+		log.Err(err).Msg("Failed to send subscription to client")
+		return
+	}
+
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	wg.Go(func() {
+		// reading is also required to process pong and close frames
+		defer cancel()
+		if err := responder.readCommands(ctx, conn, log); err != nil {
+			log.Debug().Err(err).Msg("Stopped reading commands")
+		}
+	})
+
+	pingTicker := time.NewTicker(responder.pingInterval)
+	defer pingTicker.Stop()
+
+	for {
+		log.Debug().Msg("Waiting for next event")
+		select {
+		case ev, ok := <-responder.subscriber.Events:
+			if !ok {
+				log.Debug().Msg("Channel closed")
+				_ = conn.Close(websocket.StatusGoingAway, "subscription closed")
+				return
+			}
+			if err := responder.send(ctx, conn, message{Type: api.JobEventsMessageTypeEvent, Event: &ev}); err != nil {
+				log.Err(err).Msg("Failed to send event")
+				return
+			}
+		case <-pingTicker.C:
+			for { // drain backlog
+				ev, ok := responder.subscriber.Backlog.Deq()
+				if !ok {
+					break
+				}
+				if err := responder.send(ctx, conn, message{Type: api.JobEventsMessageTypeEvent, Event: ev}); err != nil {
+					log.Err(err).Msg("Failed to send event from backlog")
+					return
+				}
+			}
+			log.Debug().Msg("Sending ping to client")
+			pingCtx, pingCancel := context.WithTimeout(ctx, responder.pingInterval)
+			err := conn.Ping(pingCtx)
+			pingCancel()
+			if err != nil {
+				log.Warn().Err(err).Msg("Client did not answer ping")
+				return
+			}
+		case <-ctx.Done():
+			// this typically happens when the client closes the connection
+			log.Debug().Msg("Client disconnected")
+			return
+		}
+	}
+}
+
+// readCommands applies the commands of the client to the subscription until the connection is closed.
+func (responder Responder) readCommands(ctx context.Context, conn *websocket.Conn, log zerolog.Logger) error {
+	for {
+		_, data, err := conn.Read(ctx)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+		var cmd api.JobEventsCommand
+		if err := json.Unmarshal(data, &cmd); err != nil {
+			if err := responder.send(ctx, conn, errorMessage(fmt.Errorf("invalid command: %w", err))); err != nil {
+				return err
+			}
+			continue
+		}
+
+		subscription := events.Subscription{All: cmd.All != nil && *cmd.All}
+		if cmd.JobIds != nil {
+			subscription.JobIDs = *cmd.JobIds
+		}
+		if cmd.ClientIDs != nil {
+			subscription.ClientIDs = *cmd.ClientIDs
+		}
+		if cmd.Workflows != nil {
+			subscription.Workflows = *cmd.Workflows
+		}
+
+		log.Info().
+			Str("command", string(cmd.Type)).
+			Bool("all", subscription.All).
+			Strs("jobIDs", subscription.JobIDs).
+			Strs("clientIDs", subscription.ClientIDs).
+			Strs("workflows", subscription.Workflows).
+			Msg("Updating subscription")
+		var msg message
+		switch cmd.Type {
+		case api.Subscribe:
+			msg = subscriptionMessage(responder.subscriber.Subscribe(subscription))
+		case api.Unsubscribe:
+			msg = subscriptionMessage(responder.subscriber.Unsubscribe(subscription))
+		default:
+			msg = errorMessage(fmt.Errorf("invalid command type %q", cmd.Type))
+		}
+		if err := responder.send(ctx, conn, msg); err != nil {
+			return err
+		}
+	}
+}
+
+// send writes the message; it gives up if the client does not read it within the ping interval.
+func (responder Responder) send(ctx context.Context, conn *websocket.Conn, msg message) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, responder.pingInterval)
+	defer cancel()
+	if err := conn.Write(ctx, websocket.MessageText, b); err != nil {
+		return fault.Wrap(err)
+	}
+	return nil
+}
+
+func subscriptionMessage(subscription events.Subscription) message {
+	nonNil := func(s []string) *[]string {
+		if s == nil {
+			s = []string{}
+		}
+		return &s
+	}
+	return message{
+		Type: api.JobEventsMessageTypeSubscription,
+		Subscription: &api.JobEventsSubscription{
+			All:       &subscription.All,
+			JobIds:    nonNil(subscription.JobIDs),
+			ClientIDs: nonNil(subscription.ClientIDs),
+			Workflows: nonNil(subscription.Workflows),
+		},
+	}
+}
+
+func errorMessage(err error) message {
+	return message{Type: api.JobEventsMessageTypeError, Error: err.Error()}
+}
@@ -0,0 +1,216 @@
+package ws
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, pingInterval time.Duration, filter events.FilterParams) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub := events.AddSubscriber(r.Context(), time.Minute, filter, []string{})
+		NewResponder(r.Context(), pingInterval, nil, sub).ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func dial(t *testing.T, url string) *websocket.Conn {
+	conn, _, err := websocket.Dial(t.Context(), url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.CloseNow() })
+	return conn
+}
+
+func read(t *testing.T, conn *websocket.Conn) api.JobEventsMessage {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	var msg api.JobEventsMessage
+	require.NoError(t, wsjson.Read(ctx, conn, &msg))
+	return msg
+}
+
+func TestResponder(t *testing.T) {
+	conn := dial(t, newTestServer(t, time.Minute, events.FilterParams{JobIDs: []string{"1"}}))
+
+	msg := read(t, conn)
+	assert.Equal(t, api.JobEventsMessageTypeSubscription, msg.Type)
+	all := false
+	assert.Equal(t, api.JobEventsSubscription{All: &all, JobIds: &[]string{"1"}, ClientIDs: &[]string{}, Workflows: &[]string{}}, *msg.Subscription)
+
+	job1 := api.Job{ID: "1", ClientID: "foo", Status: &api.JobStatus{State: "INSTALLING"}}
+	job2 := api.Job{ID: "2", ClientID: "bar", Status: &api.JobStatus{State: "INSTALLED"}}
+	events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionUpdateStatus, Job: &job2})
+	events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionUpdateStatus, Job: &job1})
+
+	msg = read(t, conn)
+	assert.Equal(t, api.JobEventsMessageTypeEvent, msg.Type)
+	require.NotNil(t, msg.Event)
+	assert.Equal(t, api.JobEventAction("UPDATE_STATUS"), msg.Event.Action)
+	assert.Equal(t, "1", msg.Event.Job.ID)
+	assert.Equal(t, "INSTALLING", msg.Event.Job.Status.State)
+
+	// change subscription on the fly
+	require.NoError(t, wsjson.Write(t.Context(), conn, api.JobEventsCommand{Type: api.Subscribe, ClientIDs: &[]string{"bar"}}))
+	msg = read(t, conn)
+	assert.Equal(t, api.JobEventsMessageTypeSubscription, msg.Type)
+	assert.Equal(t, []string{"bar"}, *msg.Subscription.ClientIDs)
+
+	require.NoError(t, wsjson.Write(t.Context(), conn, api.JobEventsCommand{Type: api.Unsubscribe, JobIds: &[]string{"1"}}))
+	msg = read(t, conn)
+	assert.Equal(t, api.JobEventsMessageTypeSubscription, msg.Type)
+	assert.Empty(t, *msg.Subscription.JobIds)
+
+	events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionUpdateStatus, Job: &job1})
+	events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionUpdateStatus, Job: &job2})
+	msg = read(t, conn)
+	assert.Equal(t, api.JobEventsMessageTypeEvent, msg.Type)
+	assert.Equal(t, "2", msg.Event.Job.ID)
+
+	require.NoError(t, conn.Close(websocket.StatusNormalClosure, ""))
+	assert.Eventually(t, func() bool { return events.SubscriberCount() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestResponder_UnsubscribeAll(t *testing.T) {
+	conn := dial(t, newTestServer(t, time.Minute, events.FilterParams{}))
+	msg := read(t, conn)
+	assert.True(t, *msg.Subscription.All)
+
+	all := true
+	require.NoError(t, wsjson.Write(t.Context(), conn, api.JobEventsCommand{Type: api.Subscribe, JobIds: &[]string{"1"}}))
+	msg = read(t, conn)
+	assert.True(t, *msg.Subscription.All)
+	require.NoError(t, wsjson.Write(t.Context(), conn, api.JobEventsCommand{Type: api.Unsubscribe, All: &all}))
+	msg = read(t, conn)
+	assert.False(t, *msg.Subscription.All)
+	assert.Equal(t, []string{"1"}, *msg.Subscription.JobIds)
+
+	job1 := api.Job{ID: "1", ClientID: "foo", Status: &api.JobStatus{State: "INSTALLING"}}
+	job2 := api.Job{ID: "2", ClientID: "bar", Status: &api.JobStatus{State: "INSTALLED"}}
+	events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionUpdateStatus, Job: &job2})
+	events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionUpdateStatus, Job: &job1})
+	msg = read(t, conn)
+	assert.Equal(t, "1", msg.Event.Job.ID)
+
+	// removing the last job must not subscribe to all events
+	require.NoError(t, wsjson.Write(t.Context(), conn, api.JobEventsCommand{Type: api.Unsubscribe, JobIds: &[]string{"1"}}))
+	msg = read(t, conn)
+	assert.False(t, *msg.Subscription.All)
+	assert.Empty(t, *msg.Subscription.JobIds)
+
+	events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionUpdateStatus, Job: &job1})
+	events.PublishEvent(t.Context(), events.JobEvent{Action: events.ActionUpdateStatus, Job: &job2})
+	// the reply to the command is the next message, i.e. no event was sent
+	require.NoError(t, wsjson.Write(t.Context(), conn, api.JobEventsCommand{Type: api.Subscribe, All: &all}))
+	msg = read(t, conn)
+	assert.Equal(t, api.JobEventsMessageTypeSubscription, msg.Type)
+	assert.True(t, *msg.Subscription.All)
+
+	require.NoError(t, conn.Close(websocket.StatusNormalClosure, ""))
+	assert.Eventually(t, func() bool { return events.SubscriberCount() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestResponder_InvalidCommand(t *testing.T) {
+	conn := dial(t, newTestServer(t, time.Minute, events.FilterParams{}))
+	_ = read(t, conn)
+
+	require.NoError(t, conn.Write(t.Context(), websocket.MessageText, []byte("hello")))
+	msg := read(t, conn)
+	assert.Equal(t, api.JobEventsMessageTypeError, msg.Type)
+	assert.Contains(t, *msg.Error, "invalid command")
+
+	require.NoError(t, wsjson.Write(t.Context(), conn, api.JobEventsCommand{Type: "foo"}))
+	msg = read(t, conn)
+	assert.Equal(t, api.JobEventsMessageTypeError, msg.Type)
+	assert.Equal(t, `invalid command type "foo"`, *msg.Error)
+
+	require.NoError(t, conn.Close(websocket.StatusNormalClosure, ""))
+	assert.Eventually(t, func() bool { return events.SubscriberCount() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestResponder_Shutdown(t *testing.T) {
+	conn := dial(t, newTestServer(t, time.Minute, events.FilterParams{}))
+	_ = read(t, conn)
+
+	events.ShutdownSubscribers()
+	_, _, err := conn.Read(t.Context())
+	assert.Equal(t, websocket.StatusGoingAway, websocket.CloseStatus(err))
+}
+
+func TestResponder_PingTimeout(t *testing.T) {
+	conn := dial(t, newTestServer(t, 50*time.Millisecond, events.FilterParams{}))
+	_ = read(t, conn)
+
+	// the client does not read, hence it does not answer pings
+	assert.Eventually(t, func() bool { return events.SubscriberCount() == 0 }, 5*time.Second, 10*time.Millisecond)
+	_ = conn.CloseNow()
+}
+
+func TestResponder_Origin(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub := events.AddSubscriber(r.Context(), time.Minute, events.FilterParams{}, nil)
+		NewResponder(r.Context(), time.Minute, []string{"*.example.com"}, sub).ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	for origin, allowed := range map[string]bool{
+		"":                        true, // not a browser
+		srv.URL:                   true, // same origin
+		"https://app.example.com": true,
+		"https://evil.com":        false,
+	} {
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+		conn, resp, err := websocket.Dial(t.Context(), url, &websocket.DialOptions{HTTPHeader: header})
+		if allowed {
+			require.NoError(t, err, origin)
+			_ = conn.CloseNow()
+			continue
+		}
+		require.Error(t, err, origin)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, origin)
+	}
+	assert.Eventually(t, func() bool { return events.SubscriberCount() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestResponder_NotWebSocket(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub := events.AddSubscriber(r.Context(), time.Minute, events.FilterParams{}, nil)
+		NewResponder(r.Context(), time.Minute, nil, sub).ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUpgradeRequired, resp.StatusCode)
+	assert.Equal(t, 0, events.SubscriberCount())
+}
+
+func TestVisitGetJobsEventsWsResponse(t *testing.T) {
+	sub := events.AddSubscriber(t.Context(), time.Minute, events.FilterParams{}, nil)
+	err := NewResponder(t.Context(), time.Minute, nil, sub).VisitGetJobsEventsWsResponse(httptest.NewRecorder())
+	assert.ErrorContains(t, err, "UpgradeMiddleware")
+	assert.Equal(t, 0, events.SubscriberCount())
+}
@@ -0,0 +1,31 @@
+package ws
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+// UpgradeMiddleware serves responses which implement http.Handler, e.g. the
+// Responder, with access to the request. This is necessary to take over the
+// connection, since the strict handler only passes the http.ResponseWriter to
+// the responses.
+func UpgradeMiddleware(f api.StrictHandlerFunc, _ string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+		response, err := f(ctx, w, r, request)
+		if handler, ok := response.(http.Handler); ok && err == nil {
+			handler.ServeHTTP(w, r)
+			return nil, nil
+		}
+		return response, err
+	}
+}
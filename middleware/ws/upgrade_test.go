@@ -0,0 +1,47 @@
+package ws
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradeMiddleware(t *testing.T) {
+	handler := UpgradeMiddleware(func(context.Context, http.ResponseWriter, *http.Request, any) (any, error) {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Path", r.URL.Path)
+			w.WriteHeader(http.StatusTeapot)
+		}), nil
+	}, "GetJobsEventsWs")
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/jobs/events/ws", nil)
+	response, err := handler(req.Context(), rec, req, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, response)
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+	assert.Equal(t, "/jobs/events/ws", rec.Header().Get("X-Path"))
+}
+
+func TestUpgradeMiddleware_Passthrough(t *testing.T) {
+	handler := UpgradeMiddleware(func(context.Context, http.ResponseWriter, *http.Request, any) (any, error) {
+		return "foo", assert.AnError
+	}, "GetJobs")
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	response, err := handler(req.Context(), rec, req, nil)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, "foo", response)
+}
@@ -542,6 +542,80 @@ func (_c *MockStorage_Initialize_Call) RunAndReturn(run func(options string) err
 	return _c
 }
 
+// JobStats provides a mock function for the type MockStorage
+func (_mock *MockStorage) JobStats(ctx context.Context, filterParams FilterParams, statsParams StatsParams) (*api.JobStats, error) {
+	ret := _mock.Called(ctx, filterParams, statsParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobStats")
+	}
+
+	var r0 *api.JobStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FilterParams, StatsParams) (*api.JobStats, error)); ok {
+		return returnFunc(ctx, filterParams, statsParams)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FilterParams, StatsParams) *api.JobStats); ok {
+		r0 = returnFunc(ctx, filterParams, statsParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.JobStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, FilterParams, StatsParams) error); ok {
+		r1 = returnFunc(ctx, filterParams, statsParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStorage_JobStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobStats'
+type MockStorage_JobStats_Call struct {
+	*mock.Call
+}
+
+// JobStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filterParams FilterParams
+//   - statsParams StatsParams
+func (_e *MockStorage_Expecter) JobStats(ctx any, filterParams any, statsParams any) *MockStorage_JobStats_Call {
+	return &MockStorage_JobStats_Call{Call: _e.mock.On("JobStats", ctx, filterParams, statsParams)}
+}
+
+func (_c *MockStorage_JobStats_Call) Run(run func(ctx context.Context, filterParams FilterParams, statsParams StatsParams)) *MockStorage_JobStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 FilterParams
+		if args[1] != nil {
+			arg1 = args[1].(FilterParams)
+		}
+		var arg2 StatsParams
+		if args[2] != nil {
+			arg2 = args[2].(StatsParams)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorage_JobStats_Call) Return(jobStats *api.JobStats, err error) *MockStorage_JobStats_Call {
+	_c.Call.Return(jobStats, err)
+	return _c
+}
+
+func (_c *MockStorage_JobStats_Call) RunAndReturn(run func(ctx context.Context, filterParams FilterParams, statsParams StatsParams) (*api.JobStats, error)) *MockStorage_JobStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QueryJobs provides a mock function for the type MockStorage
 func (_mock *MockStorage) QueryJobs(ctx context.Context, filterParams FilterParams, sortParams SortParams, paginationParams PaginationParams) (*api.PaginatedJobList, error) {
 	ret := _mock.Called(ctx, filterParams, sortParams, paginationParams)
@@ -802,3 +876,151 @@ func (_c *MockStorage_UpdateJob_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateWorkflow provides a mock function for the type MockStorage
+func (_mock *MockStorage) UpdateWorkflow(ctx context.Context, workflow *api.Workflow, force bool) (*api.Workflow, error) {
+	ret := _mock.Called(ctx, workflow, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorkflow")
+	}
+
+	var r0 *api.Workflow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *api.Workflow, bool) (*api.Workflow, error)); ok {
+		return returnFunc(ctx, workflow, force)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *api.Workflow, bool) *api.Workflow); ok {
+		r0 = returnFunc(ctx, workflow, force)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Workflow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *api.Workflow, bool) error); ok {
+		r1 = returnFunc(ctx, workflow, force)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStorage_UpdateWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkflow'
+type MockStorage_UpdateWorkflow_Call struct {
+	*mock.Call
+}
+
+// UpdateWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflow *api.Workflow
+//   - force bool
+func (_e *MockStorage_Expecter) UpdateWorkflow(ctx any, workflow any, force any) *MockStorage_UpdateWorkflow_Call {
+	return &MockStorage_UpdateWorkflow_Call{Call: _e.mock.On("UpdateWorkflow", ctx, workflow, force)}
+}
+
+func (_c *MockStorage_UpdateWorkflow_Call) Run(run func(ctx context.Context, workflow *api.Workflow, force bool)) *MockStorage_UpdateWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *api.Workflow
+		if args[1] != nil {
+			arg1 = args[1].(*api.Workflow)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorage_UpdateWorkflow_Call) Return(workflow1 *api.Workflow, err error) *MockStorage_UpdateWorkflow_Call {
+	_c.Call.Return(workflow1, err)
+	return _c
+}
+
+func (_c *MockStorage_UpdateWorkflow_Call) RunAndReturn(run func(ctx context.Context, workflow *api.Workflow, force bool) (*api.Workflow, error)) *MockStorage_UpdateWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowStats provides a mock function for the type MockStorage
+func (_mock *MockStorage) WorkflowStats(ctx context.Context, name string, statsParams WorkflowStatsParams) (*api.WorkflowStats, error) {
+	ret := _mock.Called(ctx, name, statsParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowStats")
+	}
+
+	var r0 *api.WorkflowStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, WorkflowStatsParams) (*api.WorkflowStats, error)); ok {
+		return returnFunc(ctx, name, statsParams)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, WorkflowStatsParams) *api.WorkflowStats); ok {
+		r0 = returnFunc(ctx, name, statsParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.WorkflowStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, WorkflowStatsParams) error); ok {
+		r1 = returnFunc(ctx, name, statsParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStorage_WorkflowStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowStats'
+type MockStorage_WorkflowStats_Call struct {
+	*mock.Call
+}
+
+// WorkflowStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - statsParams WorkflowStatsParams
+func (_e *MockStorage_Expecter) WorkflowStats(ctx any, name any, statsParams any) *MockStorage_WorkflowStats_Call {
+	return &MockStorage_WorkflowStats_Call{Call: _e.mock.On("WorkflowStats", ctx, name, statsParams)}
+}
+
+func (_c *MockStorage_WorkflowStats_Call) Run(run func(ctx context.Context, name string, statsParams WorkflowStatsParams)) *MockStorage_WorkflowStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 WorkflowStatsParams
+		if args[2] != nil {
+			arg2 = args[2].(WorkflowStatsParams)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorage_WorkflowStats_Call) Return(workflowStats *api.WorkflowStats, err error) *MockStorage_WorkflowStats_Call {
+	_c.Call.Return(workflowStats, err)
+	return _c
+}
+
+func (_c *MockStorage_WorkflowStats_Call) RunAndReturn(run func(ctx context.Context, name string, statsParams WorkflowStatsParams) (*api.WorkflowStats, error)) *MockStorage_WorkflowStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
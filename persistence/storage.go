@@ -10,6 +10,7 @@ package persistence
 
 import (
 	"context"
+	"time"
 
 	"github.com/siemens/wfx/generated/api"
 )
@@ -42,6 +43,9 @@ type Storage interface {
 	// QueryJobs retrieves jobs that satisfy the filterParams, sortParams, and paginationParams.
 	QueryJobs(ctx context.Context, filterParams FilterParams, sortParams SortParams, paginationParams PaginationParams) (*api.PaginatedJobList, error)
 
+	// JobStats counts the jobs that satisfy the filterParams, grouped as specified by statsParams.
+	JobStats(ctx context.Context, filterParams FilterParams, statsParams StatsParams) (*api.JobStats, error)
+
 	// CreateWorkflow adds a new workflow to the storage.
 	CreateWorkflow(ctx context.Context, workflow *api.Workflow) (*api.Workflow, error)
 
@@ -49,9 +53,19 @@ type Storage interface {
 	// If an issue occurs during the fetch operation, the method returns an error.
 	GetWorkflow(ctx context.Context, name string) (*api.Workflow, error)
 
+	// UpdateWorkflow replaces the definition (states, transitions, groups and description) of an existing workflow
+	// identified by its name. If the workflow does not exist, an error tagged with ftag.NotFound is returned.
+	// Unless force is set, the update is refused with an error tagged with errkind.Conflict if any job of the
+	// workflow is in a non-final state; the check and the update are performed atomically.
+	UpdateWorkflow(ctx context.Context, workflow *api.Workflow, force bool) (*api.Workflow, error)
+
 	// DeleteWorkflow removes an existing workflow identified by name from the storage.
 	DeleteWorkflow(ctx context.Context, name string) error
 
+	// WorkflowStats analyzes the history of the jobs using the workflow identified by name.
+	// If the workflow does not exist, an error tagged with ftag.NotFound is returned.
+	WorkflowStats(ctx context.Context, name string, statsParams WorkflowStatsParams) (*api.WorkflowStats, error)
+
 	// QueryWorkflows retrieves all workflows from the storage respecting the paginationParams.
 	QueryWorkflows(ctx context.Context, sortParams SortParams, paginationParams PaginationParams) (*api.PaginatedWorkflowList, error)
 }
@@ -118,3 +132,31 @@ type SortParams struct {
 	// When set to false, the jobs are sorted in ascending order.
 	Desc bool
 }
+
+// StatsParams specify how jobs are aggregated.
+type StatsParams struct {
+	// GroupBy lists the properties by which the jobs are grouped.
+	// If empty, all matching jobs are counted.
+	GroupBy []api.JobStatsProperty
+	// Bucket, if not nil, additionally groups the jobs into time intervals.
+	Bucket *TimeBucket
+}
+
+// TimeBucket groups jobs into fixed-length time intervals based on one of their timestamps.
+// Intervals are aligned to the UNIX epoch.
+type TimeBucket struct {
+	// Field is the timestamp to use, i.e. either the creation or the modification time.
+	Field api.JobStatsTimeField
+	// Interval is the length of a time interval. It is truncated to whole seconds.
+	Interval time.Duration
+}
+
+// WorkflowStatsParams restrict the jobs and transitions taken into account by WorkflowStats.
+type WorkflowStatsParams struct {
+	// Tags, if not empty, only considers jobs having at least one of the tags.
+	Tags []string
+	// Since, if not nil, only considers transitions at or after the given time.
+	Since *time.Time
+	// Until, if not nil, only considers transitions before the given time.
+	Until *time.Time
+}
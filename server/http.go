@@ -26,6 +26,13 @@ func NewHTTPServer(cfg *config.AppConfig, handler http.Handler) (*http.Server, e
 	server.ReadTimeout = cfg.ReadTimeout()
 	server.WriteTimeout = cfg.WriteTimeout()
 	server.SetKeepAlivesEnabled(cfg.KeepAlive())
+	// HTTP/2 is negotiated on TLS listeners and accepted with prior knowledge
+	// (h2c) on plain TCP and unix sockets; this requires that streaming
+	// responses (job events) do not hijack the connection.
+	server.Protocols = new(http.Protocols)
+	server.Protocols.SetHTTP1(true)
+	server.Protocols.SetHTTP2(true)
+	server.Protocols.SetUnencryptedHTTP2(true)
 
 	if cfg.CleanupTimeout() > 0 {
 		server.IdleTimeout = cfg.CleanupTimeout()
@@ -14,7 +14,9 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"io"
 	"math/big"
+	"net"
 	"net/http"
 	"os"
 	"path"
@@ -48,6 +50,33 @@ func TestNewHTTPServer_CustomSettings(t *testing.T) {
 	assert.Equal(t, 10*time.Second, server.ReadTimeout)
 	assert.Equal(t, 5*time.Second, server.WriteTimeout)
 	assert.Equal(t, 5*time.Minute, server.IdleTimeout)
+	assert.True(t, server.Protocols.HTTP1())
+	assert.True(t, server.Protocols.HTTP2())
+	assert.True(t, server.Protocols.UnencryptedHTTP2())
+}
+
+func TestNewHTTPServer_UnencryptedHTTP2(t *testing.T) {
+	server, err := NewHTTPServer(new(config.AppConfig), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Proto))
+	}))
+	require.NoError(t, err)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(ln) }()
+	t.Cleanup(func() { _ = server.Close() })
+
+	protocols := new(http.Protocols)
+	protocols.SetUnencryptedHTTP2(true)
+	transport := &http.Transport{Protocols: protocols}
+	t.Cleanup(transport.CloseIdleConnections)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get("http://" + ln.Addr().String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "HTTP/2.0", string(body))
+	assert.Equal(t, 2, resp.ProtoMajor)
 }
 
 func TestNewHTTPServer_DefaultSettings(t *testing.T) {
@@ -0,0 +1,226 @@
+package server
+
+/*
+ * SPDX-FileCopyrightText: 2023 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/handler/job"
+	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/status"
+	"github.com/siemens/wfx/internal/handler/workflow"
+	"github.com/siemens/wfx/workflow/dau"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobEventsSubscribe(t *testing.T) {
+	db := newInMemoryDB(t)
+	wf := dau.DirectWorkflow()
+	_, err := workflow.CreateWorkflow(context.Background(), db, wf)
+	require.NoError(t, err)
+
+	north, south := createNorthAndSouth(t, db)
+
+	handlers := []http.Handler{north, south}
+	for i, name := range allAPIs {
+		handler := handlers[i]
+		t.Run(name, func(t *testing.T) {
+			clientID := "TestJobEventsSubscribe"
+
+			var jobID atomic.Pointer[string]
+
+			var wg sync.WaitGroup
+			expectedTags := []string{"tag1", "tag2"}
+			subscriber := events.AddSubscriber(t.Context(), time.Minute, events.FilterParams{ClientIDs: []string{clientID}}, expectedTags)
+			wg.Go(func() {
+				// wait for job created event
+				payload := <-subscriber.Events
+				assert.Equal(t, events.ActionCreate, payload.Action)
+				assert.Equal(t, expectedTags, payload.Tags)
+				jobID.Store(&payload.Job.ID)
+
+				// wait for event created by our status.Update below
+				<-subscriber.Events
+				// now our GET request should have received the response as well,
+				// add some extra time to be safe
+				time.Sleep(100 * time.Millisecond)
+				events.RemoveSubscriber(subscriber)
+			})
+
+			_, err := job.CreateJob(t.Context(), db, &api.JobRequest{ClientID: clientID, Workflow: wf.Name})
+			require.NoError(t, err)
+
+			wg.Go(func() {
+				// wait for subscriber which is created by our GET request below and our test goroutine above
+				for events.SubscriberCount() != 2 {
+					time.Sleep(20 * time.Millisecond)
+				}
+				// update job
+				_, err := status.Update(t.Context(), db, *jobID.Load(), &api.JobStatus{State: "INSTALLING"}, api.CLIENT)
+				require.NoError(t, err)
+			})
+
+			// wait for job id
+			for jobID.Load() == nil {
+				time.Sleep(20 * time.Millisecond)
+			}
+
+			srv := httptest.NewServer(handler)
+			defer srv.Close()
+
+			ctx, cancel := context.WithCancel(t.Context())
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/wfx/v1/jobs/events?jobIds=%s", srv.URL, *jobID.Load()), nil)
+			require.NoError(t, err)
+			resp, err := srv.Client().Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+			// set by the CORS middleware, which is no longer bypassed
+			assert.Equal(t, "Origin", resp.Header.Get("Vary"))
+
+			r := bufio.NewReader(resp.Body)
+			data, err := r.ReadString('\n')
+			require.NoError(t, err)
+			id, err := r.ReadString('\n')
+			require.NoError(t, err)
+
+			// check body starts with data:
+			require.True(t, strings.HasPrefix(data, "data: "))
+
+			// check content is a job and state is INSTALLING
+			var ev events.JobEvent
+			err = json.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), &ev)
+			require.NoError(t, err)
+			assert.Equal(t, events.ActionUpdateStatus, ev.Action)
+			assert.Equal(t, "INSTALLING", ev.Job.Status.State)
+			assert.Equal(t, wf.Name, ev.Job.Workflow.Name)
+			assert.Equal(t, clientID, ev.Job.ClientID)
+			assert.Equal(t, "id: 1\n", id)
+
+			cancel()
+			wg.Wait()
+		})
+	}
+}
+
+func TestJobEventsWebSocket(t *testing.T) {
+	db := newInMemoryDB(t)
+	wf := dau.DirectWorkflow()
+	_, err := workflow.CreateWorkflow(context.Background(), db, wf)
+	require.NoError(t, err)
+
+	north, south := createNorthAndSouth(t, db)
+
+	handlers := []http.Handler{north, south}
+	for i, name := range allAPIs {
+		handler := handlers[i]
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(handler)
+			defer srv.Close()
+
+			job, err := job.CreateJob(t.Context(), db, &api.JobRequest{ClientID: "TestJobEventsWebSocket", Workflow: wf.Name})
+			require.NoError(t, err)
+
+			url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/wfx/v1/jobs/events/ws?clientIds=unknown&tags=foo"
+			conn, _, err := websocket.Dial(t.Context(), url, nil)
+			require.NoError(t, err)
+			defer conn.CloseNow()
+
+			read := func() api.JobEventsMessage {
+				ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+				defer cancel()
+				var msg api.JobEventsMessage
+				require.NoError(t, wsjson.Read(ctx, conn, &msg))
+				return msg
+			}
+
+			msg := read()
+			assert.Equal(t, api.JobEventsMessageTypeSubscription, msg.Type)
+			assert.Equal(t, []string{"unknown"}, *msg.Subscription.ClientIDs)
+
+			require.NoError(t, wsjson.Write(t.Context(), conn, api.JobEventsCommand{Type: api.Subscribe, JobIds: &[]string{job.ID}}))
+			msg = read()
+			assert.Equal(t, []string{job.ID}, *msg.Subscription.JobIds)
+
+			_, err = status.Update(t.Context(), db, job.ID, &api.JobStatus{State: "INSTALLING"}, api.CLIENT)
+			require.NoError(t, err)
+
+			msg = read()
+			assert.Equal(t, api.JobEventsMessageTypeEvent, msg.Type)
+			assert.Equal(t, api.JobEventAction(events.ActionUpdateStatus), msg.Event.Action)
+			assert.Equal(t, "INSTALLING", msg.Event.Job.Status.State)
+			assert.Equal(t, []string{"foo"}, *msg.Event.Tags)
+
+			require.NoError(t, conn.Close(websocket.StatusNormalClosure, ""))
+			assert.Eventually(t, func() bool { return events.SubscriberCount() == 0 }, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestJobEventsWebSocket_InvalidAction(t *testing.T) {
+	north, _ := createNorthAndSouth(t, newInMemoryDB(t))
+	srv := httptest.NewServer(north)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/wfx/v1/jobs/events/ws?actions=foo"
+	_, resp, err := websocket.Dial(t.Context(), url, nil)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestJobEventsSubscribe_HTTP2(t *testing.T) {
+	db := newInMemoryDB(t)
+	north, _ := createNorthAndSouth(t, db)
+
+	srv := httptest.NewUnstartedServer(north)
+	srv.EnableHTTP2 = true
+	srv.Config.WriteTimeout = 100 * time.Millisecond
+	srv.StartTLS()
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/api/wfx/v1/jobs/events", nil)
+	require.NoError(t, err)
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, resp.ProtoMajor)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// outlive the server's write timeout
+	time.Sleep(200 * time.Millisecond)
+	job := persistJob(t, db)
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	require.NoError(t, err)
+	var ev events.JobEvent
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &ev))
+	assert.Equal(t, events.ActionCreate, ev.Action)
+	assert.Equal(t, job.ID, ev.Job.ID)
+}
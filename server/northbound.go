@@ -52,6 +52,22 @@ func (north NorthboundServer) GetJobsEvents(ctx context.Context, request api.Get
 	return resp, nil
 }
 
+func (north NorthboundServer) GetJobsEventsWs(ctx context.Context, request api.GetJobsEventsWsRequestObject) (api.GetJobsEventsWsResponseObject, error) {
+	resp, err := north.wfx.GetJobsEventsWs(ctx, request)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return resp, nil
+}
+
+func (north NorthboundServer) GetJobsStats(ctx context.Context, request api.GetJobsStatsRequestObject) (api.GetJobsStatsResponseObject, error) {
+	resp, err := north.wfx.GetJobsStats(ctx, request)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return resp, nil
+}
+
 func (north NorthboundServer) DeleteJobsId(ctx context.Context, request api.DeleteJobsIdRequestObject) (api.DeleteJobsIdResponseObject, error) {
 	resp, err := north.wfx.DeleteJobsId(ctx, request)
 	if err != nil {
@@ -156,6 +172,22 @@ func (north NorthboundServer) GetWorkflowsName(ctx context.Context, request api.
 	return resp, nil
 }
 
+func (north NorthboundServer) PutWorkflowsName(ctx context.Context, request api.PutWorkflowsNameRequestObject) (api.PutWorkflowsNameResponseObject, error) {
+	resp, err := north.wfx.PutWorkflowsName(ctx, request)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return resp, nil
+}
+
+func (north NorthboundServer) GetWorkflowsNameStats(ctx context.Context, request api.GetWorkflowsNameStatsRequestObject) (api.GetWorkflowsNameStatsResponseObject, error) {
+	resp, err := north.wfx.GetWorkflowsNameStats(ctx, request)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return resp, nil
+}
+
 func (north NorthboundServer) GetHealth(ctx context.Context, request api.GetHealthRequestObject) (api.GetHealthResponseObject, error) {
 	resp, err := north.wfx.GetHealth(ctx, request)
 	if err != nil {
@@ -244,3 +244,25 @@ func TestNorthboundDeleteJobsIDTags_InternalError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 }
+
+func TestNorthboundGetWorkflowsNameStats_InternalError(t *testing.T) {
+	dbMock := persistence.NewHealthyMockStorage(t)
+	dbMock.EXPECT().WorkflowStats(context.Background(), "foo", persistence.WorkflowStatsParams{}).Return(nil, errors.New("something went wrong"))
+
+	server := createServerForTesting(t, "north", dbMock)
+
+	resp, err := server.GetWorkflowsNameStats(context.Background(), api.GetWorkflowsNameStatsRequestObject{Name: "foo"})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestNorthboundGetJobsStats_InternalError(t *testing.T) {
+	dbMock := persistence.NewHealthyMockStorage(t)
+	dbMock.EXPECT().JobStats(context.Background(), persistence.FilterParams{}, persistence.StatsParams{}).Return(nil, errors.New("something went wrong"))
+
+	server := createServerForTesting(t, "north", dbMock)
+
+	resp, err := server.GetJobsStats(context.Background(), api.GetJobsStatsRequestObject{})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,356 @@
+package server
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/rs/zerolog/log"
+	"github.com/siemens/wfx/cmd/wfx/cmd/config"
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
+	"github.com/siemens/wfx/middleware/plugin"
+)
+
+// loadedPlugin is a plugin along with its supervision settings.
+type loadedPlugin struct {
+	plugin.Plugin
+	settings plugin.Settings
+	// routes restricts the requests passed to the plugin; nil matches all requests
+	routes *routeMatcher
+	// optional plugins are skipped if they cannot be started
+	optional bool
+}
+
+// chainedPlugin is a running plugin within a chain.
+type chainedPlugin struct {
+	mw     *plugin.Middleware
+	routes *routeMatcher
+	// permanent plugins were registered programmatically and survive a reload
+	permanent bool
+}
+
+// pluginChain is the ordered list of plugins applied to the requests of an
+// API; the first plugin sees the request first.
+type pluginChain struct {
+	plugins  []chainedPlugin
+	inflight sync.WaitGroup
+	retired  atomic.Bool
+}
+
+// newPluginChain starts the plugins. If a required plugin cannot be started,
+// all plugins started so far are stopped.
+func newPluginChain(plugins []loadedPlugin) (*pluginChain, error) {
+	chain := &pluginChain{plugins: make([]chainedPlugin, 0, len(plugins))}
+	for _, p := range plugins {
+		mw, err := plugin.NewMiddleware(p.Plugin, p.settings)
+		if err != nil {
+			if p.optional {
+				log.Warn().Err(err).Str("plugin", p.Name()).Msg("Skipping optional plugin which failed to start")
+				continue
+			}
+			chain.stop(true)
+			return nil, fault.Wrap(err)
+		}
+		chain.plugins = append(chain.plugins, chainedPlugin{mw: mw, routes: p.routes})
+	}
+	return chain, nil
+}
+
+func (chain *pluginChain) middlewares() []*plugin.Middleware {
+	result := make([]*plugin.Middleware, 0, len(chain.plugins))
+	for _, p := range chain.plugins {
+		result = append(result, p.mw)
+	}
+	return result
+}
+
+// wrap applies the plugins to next.
+func (chain *pluginChain) wrap(next http.Handler) http.Handler {
+	handler := next
+	for i := len(chain.plugins) - 1; i >= 0; i-- {
+		p := chain.plugins[i]
+		withPlugin := p.mw.Middleware()(handler)
+		if p.routes == nil {
+			handler = withPlugin
+			continue
+		}
+		withoutPlugin := handler
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if p.routes.Match(r) {
+				withPlugin.ServeHTTP(w, r)
+			} else {
+				withoutPlugin.ServeHTTP(w, r)
+			}
+		})
+	}
+	return handler
+}
+
+// stop stops the plugins which are not permanent (or all plugins if all is set).
+func (chain *pluginChain) stop(all bool) {
+	for _, p := range chain.plugins {
+		if all || !p.permanent {
+			hooks.Unregister(p.mw)
+			p.mw.Stop()
+		}
+	}
+}
+
+// apiPlugins manages the plugin chain of an API (north or south). If the
+// plugins are declared in a manifest, the chain is replaced whenever the
+// configuration is reloaded, e.g. because the manifest changed.
+type apiPlugins struct {
+	cfg      *config.AppConfig
+	dir      string
+	manifest string
+	basePath string
+	// unsubscribe stops listening for config reloads
+	unsubscribe func()
+	// chReloaded receives a signal whenever the chain has been replaced
+	chReloaded chan struct{}
+
+	// reloadMutex serializes reloads and Stop
+	reloadMutex sync.Mutex
+	stopped     bool
+
+	// mutex protects chain and routes; the handlers of the routes are swapped along with the chain
+	mutex  sync.RWMutex
+	chain  *pluginChain
+	routes []*chainedRoute
+}
+
+// chainedRoute is a handler of the API along with the plugin chain wrapping it.
+type chainedRoute struct {
+	next    http.Handler
+	current atomic.Pointer[wrappedHandler]
+}
+
+// wrappedHandler is the handler of a route wrapped by a particular chain.
+type wrappedHandler struct {
+	chain   *pluginChain
+	handler http.Handler
+}
+
+func newAPIPlugins(cfg *config.AppConfig, dir string, manifest string, basePath string, extra []plugin.Plugin) (*apiPlugins, error) {
+	ap := &apiPlugins{cfg: cfg, dir: dir, manifest: manifest, basePath: basePath, chReloaded: make(chan struct{}, 1)}
+	plugins, err := ap.load()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	chain, err := newPluginChain(plugins)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	for _, p := range extra {
+		mw, err := plugin.NewMiddleware(p, cfg.PluginSettings())
+		if err != nil {
+			chain.stop(true)
+			return nil, fault.Wrap(err)
+		}
+		chain.plugins = append(chain.plugins, chainedPlugin{mw: mw, permanent: true})
+	}
+	registerHooks(chain.middlewares())
+	ap.chain = chain
+
+	if manifest != "" {
+		ap.unsubscribe = cfg.OnReload(ap.reload)
+	}
+	return ap, nil
+}
+
+func (ap *apiPlugins) load() ([]loadedPlugin, error) {
+	if ap.manifest != "" {
+		return loadManifest(ap.manifest, ap.basePath, ap.cfg.PluginSettings())
+	}
+	return loadPlugins(ap.dir, ap.cfg.PluginSettings())
+}
+
+// reload replaces the chain with the plugins declared in the manifest. If the
+// manifest is invalid or a required plugin cannot be started, the current
+// chain is kept. Requests in flight are completed by the previous chain before
+// its plugins are stopped.
+func (ap *apiPlugins) reload() {
+	ap.reloadMutex.Lock()
+	defer ap.reloadMutex.Unlock()
+	if ap.stopped {
+		return
+	}
+
+	contextLogger := log.With().Str("manifest", ap.manifest).Logger()
+	contextLogger.Info().Msg("Reloading plugin manifest")
+
+	plugins, err := ap.load()
+	if err != nil {
+		contextLogger.Error().Err(err).Msg("Invalid plugin manifest, keeping current plugins")
+		return
+	}
+
+	chain, err := newPluginChain(plugins)
+	if err != nil {
+		contextLogger.Error().Err(err).Msg("Failed to start plugins, keeping current plugins")
+		return
+	}
+	started := chain.middlewares()
+
+	ap.mutex.Lock()
+	old := ap.chain
+	var retired []*plugin.Middleware
+	for _, p := range old.plugins {
+		if p.permanent {
+			chain.plugins = append(chain.plugins, p)
+		} else {
+			retired = append(retired, p.mw)
+		}
+	}
+	// job events are passed either to the old or to the new plugins
+	replaceHooks(retired, started)
+	ap.chain = chain
+	for _, route := range ap.routes {
+		route.current.Store(&wrappedHandler{chain: chain, handler: chain.wrap(route.next)})
+	}
+	ap.mutex.Unlock()
+
+	select {
+	case ap.chReloaded <- struct{}{}:
+	default: // the previous signal has not been consumed yet
+	}
+
+	old.retired.Store(true)
+	ap.await(old)
+	old.stop(false)
+	contextLogger.Info().Int("count", len(chain.plugins)).Msg("Plugin manifest reloaded")
+}
+
+// await waits (up to the graceful timeout) until no request is processed by the chain.
+func (ap *apiPlugins) await(chain *pluginChain) {
+	done := make(chan struct{})
+	go func() {
+		chain.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(ap.cfg.GracefulTimeout()):
+		log.Warn().Msg("Stopping plugins while requests are still in progress")
+	}
+}
+
+// current returns the current chain.
+func (ap *apiPlugins) current() *pluginChain {
+	ap.mutex.RLock()
+	defer ap.mutex.RUnlock()
+	return ap.chain
+}
+
+// acquire returns the current handler of the route; the caller must call
+// chain.inflight.Done() when finished.
+func (ap *apiPlugins) acquire(route *chainedRoute) *wrappedHandler {
+	ap.mutex.RLock()
+	defer ap.mutex.RUnlock()
+	current := route.current.Load()
+	current.chain.inflight.Add(1)
+	return current
+}
+
+// Middleware applies the current plugin chain. The handler is wrapped once per
+// chain, i.e. when the middleware is applied and whenever the chain is replaced.
+func (ap *apiPlugins) Middleware() api.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		route := &chainedRoute{next: next}
+		ap.mutex.Lock()
+		route.current.Store(&wrappedHandler{chain: ap.chain, handler: ap.chain.wrap(next)})
+		ap.routes = append(ap.routes, route)
+		ap.mutex.Unlock()
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := ap.acquire(route)
+			defer current.chain.inflight.Done()
+			current.handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Reloaded returns a channel which receives a signal whenever the chain has been replaced.
+func (ap *apiPlugins) Reloaded() <-chan struct{} {
+	return ap.chReloaded
+}
+
+// Stop stops listening for config reloads and stops all plugins.
+func (ap *apiPlugins) Stop() {
+	if ap.unsubscribe != nil {
+		ap.unsubscribe()
+	}
+	ap.reloadMutex.Lock()
+	defer ap.reloadMutex.Unlock()
+	ap.stopped = true
+	ap.current().stop(true)
+}
+
+// routeMatcher reports whether a request matches one of the routes of a plugin.
+// Routes use the syntax of http.ServeMux patterns ("[METHOD ]/path"), relative
+// to the base path of the API, e.g. "PUT /jobs/{id}/status".
+type routeMatcher struct {
+	mux *http.ServeMux
+}
+
+type routeMatch struct{}
+
+func (*routeMatch) ServeHTTP(http.ResponseWriter, *http.Request) {}
+
+// matchedRoute is registered for all routes, any other handler returned by the
+// mux (not found, redirect, method not allowed) means that no route matched.
+var matchedRoute http.Handler = &routeMatch{}
+
+func newRouteMatcher(basePath string, routes []string) (*routeMatcher, error) {
+	if len(routes) == 0 {
+		return nil, nil
+	}
+	mux := http.NewServeMux()
+	for _, route := range routes {
+		method, path, found := strings.Cut(strings.TrimSpace(route), " ")
+		if !found {
+			method, path = "", method
+		}
+		path = strings.TrimSpace(path)
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("invalid route %q: path must start with '/'", route)
+		}
+		pattern := basePath + path
+		if method != "" {
+			pattern = method + " " + pattern
+		}
+		if err := handle(mux, pattern); err != nil {
+			return nil, fmt.Errorf("invalid route %q: %v", route, err)
+		}
+	}
+	return &routeMatcher{mux: mux}, nil
+}
+
+// handle registers the pattern; the mux panics on invalid or conflicting patterns.
+func handle(mux *http.ServeMux, pattern string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	mux.Handle(pattern, matchedRoute)
+	return nil
+}
+
+func (m *routeMatcher) Match(r *http.Request) bool {
+	h, _ := m.mux.Handler(r)
+	return h == matchedRoute
+}
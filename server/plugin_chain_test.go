@@ -0,0 +1,119 @@
+package server
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/siemens/wfx/middleware/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteMatcher(t *testing.T) {
+	m, err := newRouteMatcher("/api/wfx/v1", []string{"PUT /jobs/{id}/status", "/workflows/"})
+	require.NoError(t, err)
+
+	tcs := []struct {
+		method   string
+		target   string
+		expected bool
+	}{
+		{http.MethodPut, "/api/wfx/v1/jobs/1/status", true},
+		{http.MethodGet, "/api/wfx/v1/jobs/1/status", false},
+		{http.MethodPut, "/api/wfx/v1/jobs/1/definition", false},
+		{http.MethodGet, "/api/wfx/v1/workflows/", true},
+		{http.MethodDelete, "/api/wfx/v1/workflows/foo", true},
+		// would be redirected to /workflows/
+		{http.MethodGet, "/api/wfx/v1/workflows", false},
+		{http.MethodGet, "/api/wfx/v1/jobs", false},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.expected, m.Match(httptest.NewRequest(tc.method, tc.target, nil)), "%s %s", tc.method, tc.target)
+	}
+}
+
+func TestRouteMatcher_Empty(t *testing.T) {
+	m, err := newRouteMatcher("/api/wfx/v1", nil)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+}
+
+func TestRouteMatcher_Invalid(t *testing.T) {
+	_, err := newRouteMatcher("/api/wfx/v1", []string{"GET /jobs/{id"})
+	assert.ErrorContains(t, err, `invalid route "GET /jobs/{id"`)
+}
+
+// recordingPlugin appends its name to the list of invocations.
+func recordingPlugin(name string, mutex *sync.Mutex, calls *[]string) *plugin.GoPlugin {
+	return plugin.NewGoPlugin(name, plugin.HandlerFunc(func(context.Context, *plugin.Request) (*plugin.Verdict, error) {
+		mutex.Lock()
+		*calls = append(*calls, name)
+		mutex.Unlock()
+		return nil, nil
+	}))
+}
+
+func TestPluginChain_Order(t *testing.T) {
+	var mutex sync.Mutex
+	var calls []string
+	routes, err := newRouteMatcher("", []string{"PUT /jobs/{id}/status"})
+	require.NoError(t, err)
+
+	chain, err := newPluginChain([]loadedPlugin{
+		{Plugin: recordingPlugin("b", &mutex, &calls), settings: plugin.DefaultSettings()},
+		{Plugin: recordingPlugin("a", &mutex, &calls), settings: plugin.DefaultSettings(), routes: routes},
+		{Plugin: recordingPlugin("c", &mutex, &calls), settings: plugin.DefaultSettings()},
+	})
+	require.NoError(t, err)
+	defer chain.stop(true)
+
+	handler := chain.wrap(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/jobs/1/status", nil))
+	assert.Equal(t, []string{"b", "a", "c"}, calls)
+
+	calls = nil
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/jobs/1/status", nil))
+	assert.Equal(t, []string{"b", "c"}, calls)
+}
+
+type startFailPlugin struct{}
+
+func (startFailPlugin) Name() string { return "startFailPlugin" }
+
+func (startFailPlugin) Start(chan error) (chan plugin.Message, error) {
+	return nil, assert.AnError
+}
+
+func (startFailPlugin) Stop() error { return nil }
+
+func TestNewPluginChain_StartFails(t *testing.T) {
+	ok := plugin.NewGoPlugin("ok", plugin.HandlerFunc(func(context.Context, *plugin.Request) (*plugin.Verdict, error) {
+		return nil, nil
+	}))
+
+	chain, err := newPluginChain([]loadedPlugin{
+		{Plugin: ok, settings: plugin.DefaultSettings()},
+		{Plugin: startFailPlugin{}, settings: plugin.DefaultSettings(), optional: true},
+	})
+	require.NoError(t, err)
+	assert.Len(t, chain.plugins, 1)
+	chain.stop(true)
+
+	_, err = newPluginChain([]loadedPlugin{
+		{Plugin: ok, settings: plugin.DefaultSettings()},
+		{Plugin: startFailPlugin{}, settings: plugin.DefaultSettings()},
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+}
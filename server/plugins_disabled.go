@@ -16,9 +16,16 @@ import (
 	"github.com/siemens/wfx/middleware/plugin"
 )
 
-func loadPlugins(dir string) ([]plugin.Plugin, error) {
+func loadPlugins(dir string, _ plugin.Settings) ([]loadedPlugin, error) {
 	if dir != "" {
 		return nil, errors.New("this binary was built without plugin support")
 	}
-	return []plugin.Plugin{}, nil
+	return []loadedPlugin{}, nil
+}
+
+func loadManifest(fname string, _ string, _ plugin.Settings) ([]loadedPlugin, error) {
+	if fname != "" {
+		return nil, errors.New("this binary was built without plugin support")
+	}
+	return []loadedPlugin{}, nil
 }
@@ -13,17 +13,18 @@ package server
 import (
 	"testing"
 
+	"github.com/siemens/wfx/middleware/plugin"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestLoadPlugins(t *testing.T) {
-	plugins, err := loadPlugins("/plugins")
+	plugins, err := loadPlugins("/plugins", plugin.DefaultSettings())
 	assert.Nil(t, plugins)
 	assert.ErrorContains(t, err, "this binary was built without plugin support")
 }
 
 func TestLoadNorthboundPlugins_None(t *testing.T) {
-	plugins, err := loadPlugins("")
+	plugins, err := loadPlugins("", plugin.DefaultSettings())
 	assert.NoError(t, err)
 	assert.Empty(t, plugins)
 }
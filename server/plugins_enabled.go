@@ -0,0 +1,263 @@
+//go:build !no_plugin
+
+package server
+
+/*
+ * SPDX-FileCopyrightText: 2024 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+	"github.com/rs/zerolog/log"
+	"github.com/siemens/wfx/middleware/plugin"
+)
+
+const (
+	// phasesSuffix is the suffix of the optional file declaring the phases a plugin
+	// subscribes to, e.g. "myplugin.phases" containing "request,response".
+	phasesSuffix = ".phases"
+	// settingsSuffix is the suffix of the optional file overriding the supervision
+	// settings of a plugin, e.g. "myplugin.yaml" containing "timeout: 5s".
+	settingsSuffix = ".yaml"
+	// wasmSuffix is the suffix of plugins compiled to WebAssembly.
+	wasmSuffix = ".wasm"
+)
+
+func loadPlugins(dir string, defaults plugin.Settings) ([]loadedPlugin, error) {
+	if dir == "" {
+		return []loadedPlugin{}, nil
+	}
+	log.Debug().Str("dir", dir).Msgf("Loading plugins from %q", dir)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	result := make([]loadedPlugin, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			dest, err := filepath.EvalSymlinks(path.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, fault.Wrap(err)
+			}
+			info, err := os.Stat(dest)
+			if err != nil {
+				return nil, fault.Wrap(err)
+			}
+			isSocket := info.Mode()&os.ModeSocket != 0
+			isWasm := isWasmModule(entry.Name())
+			// check if file is executable
+			if !isSocket && !isWasm && (info.Mode()&0o111) == 0 {
+				log.Debug().Str("dest", dest).Msgf("Ignoring non-executable file %q", dest)
+				continue
+			}
+			phases, err := readPhases(path.Join(dir, entry.Name()+phasesSuffix))
+			if err != nil {
+				return nil, err
+			}
+			settings, err := readSettings(path.Join(dir, entry.Name()+settingsSuffix), defaults)
+			if err != nil {
+				return nil, err
+			}
+			log.Info().Str("dest", dest).Stringer("phases", phases).Bool("socket", isSocket).Bool("wasm", isWasm).Msgf("Loading plugin %q", dest)
+			var p plugin.Plugin
+			switch {
+			case isSocket:
+				p = plugin.NewSocketPlugin(dest, settings.Connections, phases)
+			case isWasm:
+				p = plugin.NewWasmPlugin(dest, settings.MemoryLimit, phases)
+			default:
+				p = plugin.NewFBPlugin(dest, phases)
+			}
+			result = append(result, loadedPlugin{Plugin: p, settings: settings})
+		}
+	}
+	sort.Slice(result, func(i int, j int) bool { return result[i].Name() < result[j].Name() })
+	log.Debug().Int("count", len(result)).Msg("Loaded plugins")
+	return result, nil
+}
+
+// isWasmModule reports whether the plugin is a WebAssembly module, which is
+// run by wfx itself and hence need not be executable.
+func isWasmModule(fname string) bool {
+	return strings.EqualFold(filepath.Ext(fname), wasmSuffix)
+}
+
+func readPhases(fname string) (plugin.Phase, error) {
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return plugin.PhaseRequest, nil
+		}
+		return 0, fault.Wrap(err)
+	}
+	phases, err := plugin.ParsePhases(string(data))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", fname, err)
+	}
+	return phases, nil
+}
+
+func readSettings(fname string, defaults plugin.Settings) (plugin.Settings, error) {
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return defaults, nil
+		}
+		return defaults, fault.Wrap(err)
+	}
+	settings := defaults
+	if err := yaml.UnmarshalWithOptions(data, &settings, yaml.Strict()); err != nil {
+		return defaults, fmt.Errorf("%s: %w", fname, err)
+	}
+	return settings, nil
+}
+
+// pluginManifest declares the plugins of an API in the order they are applied.
+type pluginManifest struct {
+	Plugins []manifestPlugin `yaml:"plugins"`
+}
+
+type manifestPlugin struct {
+	// Path is the plugin executable or unix domain socket; relative paths are
+	// resolved against the directory of the manifest.
+	Path string `yaml:"path"`
+	// Args are passed to the plugin executable.
+	Args []string `yaml:"args"`
+	// Env is added to the environment of the plugin executable.
+	Env map[string]string `yaml:"env"`
+	// Phases the plugin subscribes to (default: request).
+	Phases []string `yaml:"phases"`
+	// Routes restrict the requests passed to the plugin, e.g. "PUT /jobs/{id}/status".
+	Routes []string `yaml:"routes"`
+	// Optional plugins are skipped if they cannot be started and fail open by default.
+	Optional bool `yaml:"optional"`
+	// Settings override the supervision settings, like a "<plugin>.yaml" file.
+	Settings rawYAML `yaml:"settings"`
+}
+
+// rawYAML defers decoding of a YAML value.
+type rawYAML []byte
+
+func (r *rawYAML) UnmarshalYAML(data []byte) error {
+	*r = slices.Clone(data)
+	return nil
+}
+
+func loadManifest(fname string, basePath string, defaults plugin.Settings) ([]loadedPlugin, error) {
+	log.Debug().Str("manifest", fname).Msgf("Loading plugins from manifest %q", fname)
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		// most likely, the file is being written; use "plugins: []" to disable all plugins
+		return nil, fmt.Errorf("%s: manifest is empty", fname)
+	}
+	var manifest pluginManifest
+	if err := yaml.UnmarshalWithOptions(data, &manifest, yaml.Strict()); err != nil {
+		return nil, fmt.Errorf("%s: %w", fname, err)
+	}
+
+	result := make([]loadedPlugin, 0, len(manifest.Plugins))
+	for i, entry := range manifest.Plugins {
+		p, err := entry.load(filepath.Dir(fname), basePath, defaults)
+		if err != nil {
+			return nil, fmt.Errorf("%s: plugin #%d: %w", fname, i+1, err)
+		}
+		if p != nil {
+			result = append(result, *p)
+		}
+	}
+	log.Debug().Int("count", len(result)).Msg("Loaded plugins")
+	return result, nil
+}
+
+// load validates the entry and creates the plugin. It returns nil if an
+// optional plugin does not exist.
+func (entry manifestPlugin) load(dir string, basePath string, defaults plugin.Settings) (*loadedPlugin, error) {
+	if entry.Path == "" {
+		return nil, errors.New("path is required")
+	}
+	fname := entry.Path
+	if !filepath.IsAbs(fname) {
+		fname = filepath.Join(dir, fname)
+	}
+
+	phases := plugin.PhaseRequest
+	if len(entry.Phases) > 0 {
+		var err error
+		if phases, err = plugin.ParsePhases(strings.Join(entry.Phases, ",")); err != nil {
+			return nil, fault.Wrap(err)
+		}
+	}
+	if len(entry.Routes) > 0 && !phases.Has(plugin.PhaseRequest) && !phases.Has(plugin.PhaseResponse) {
+		return nil, errors.New("routes require the request or response phase")
+	}
+	routes, err := newRouteMatcher(basePath, entry.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := defaults
+	if entry.Optional {
+		settings.FailurePolicy = plugin.FailOpen
+	}
+	if len(entry.Settings) > 0 {
+		if err := yaml.UnmarshalWithOptions(entry.Settings, &settings, yaml.Strict()); err != nil {
+			return nil, fmt.Errorf("settings: %w", err)
+		}
+	}
+
+	info, err := os.Stat(fname)
+	isSocket := err == nil && info.Mode()&os.ModeSocket != 0
+	isWasm := isWasmModule(fname)
+	if err == nil && !isSocket && !isWasm && info.Mode()&0o111 == 0 {
+		err = fmt.Errorf("%s is neither executable nor a unix domain socket nor a WebAssembly module", fname)
+	}
+	if err != nil {
+		if entry.Optional {
+			log.Warn().Err(err).Str("path", fname).Msgf("Skipping optional plugin %q", fname)
+			return nil, nil
+		}
+		return nil, fault.Wrap(err)
+	}
+	dest, err := filepath.EvalSymlinks(fname)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	env := make([]string, 0, len(entry.Env))
+	for k, v := range entry.Env {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	var p plugin.Plugin
+	switch {
+	case isSocket:
+		if len(entry.Args) > 0 || len(entry.Env) > 0 {
+			return nil, errors.New("args and env are not supported for socket plugins")
+		}
+		p = plugin.NewSocketPlugin(dest, settings.Connections, phases)
+	case isWasm:
+		p = plugin.NewWasmPlugin(dest, settings.MemoryLimit, phases).WithArgs(entry.Args...).WithEnv(env...)
+	default:
+		p = plugin.NewFBPlugin(dest, phases).WithArgs(entry.Args...).WithEnv(env...)
+	}
+	log.Info().Str("dest", dest).Stringer("phases", phases).Bool("socket", isSocket).Bool("wasm", isWasm).Strs("routes", entry.Routes).Msgf("Loading plugin %q", dest)
+	return &loadedPlugin{Plugin: p, settings: settings, routes: routes, optional: entry.Optional}, nil
+}
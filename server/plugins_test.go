@@ -0,0 +1,416 @@
+//go:build !no_plugin
+
+package server
+
+/*
+ * SPDX-FileCopyrightText: 2023 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/siemens/wfx/cmd/wfx/cmd/config"
+	"github.com/siemens/wfx/middleware/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPluginsEmpty(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := os.MkdirTemp("", t.Name())
+	t.Cleanup(func() {
+		_ = os.Remove(dir)
+	})
+	plugins, err := loadPlugins(dir, plugin.DefaultSettings())
+	require.NoError(t, err)
+	assert.Empty(t, plugins)
+}
+
+func TestLoadPlugins(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := os.MkdirTemp("", t.Name())
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	f, _ := os.CreateTemp(dir, "plugin")
+	_ = f.Close()
+	_ = os.Chmod(f.Name(), os.FileMode(0o700))
+
+	plugins, err := loadPlugins(dir, plugin.DefaultSettings())
+	require.NoError(t, err)
+	assert.Len(t, plugins, 1)
+	expected, _ := filepath.EvalSymlinks(f.Name())
+	is, _ := filepath.EvalSymlinks(plugins[0].Name())
+	assert.Equal(t, expected, is)
+}
+
+func TestLoadPluginsIgnoreNonExecutable(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := os.MkdirTemp("", t.Name())
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	f, _ := os.CreateTemp(dir, "plugin")
+	_ = f.Close()
+
+	plugins, err := loadPlugins(dir, plugin.DefaultSettings())
+	require.NoError(t, err)
+	assert.Len(t, plugins, 0)
+}
+
+func TestLoadPluginsSymlink(t *testing.T) {
+	t.Parallel()
+
+	baseDir, _ := os.MkdirTemp("", t.Name())
+	t.Cleanup(func() {
+		_ = os.RemoveAll(baseDir)
+	})
+
+	first, _ := os.MkdirTemp(baseDir, "first")
+	second, _ := os.MkdirTemp(baseDir, "second")
+
+	f, _ := os.CreateTemp(first, "plugin")
+	_ = f.Close()
+	_ = os.Chmod(f.Name(), os.FileMode(0o700))
+
+	// create symlink
+	dest := path.Join(second, "example")
+	_ = os.Symlink(f.Name(), dest)
+
+	plugins, err := loadPlugins(second, plugin.DefaultSettings())
+	require.NoError(t, err)
+	assert.Len(t, plugins, 1)
+	expected, _ := filepath.EvalSymlinks(f.Name())
+	is, _ := filepath.EvalSymlinks(plugins[0].Name())
+	assert.Equal(t, expected, is)
+}
+
+func TestLoadPluginsSymlinkIgnoreNonExecutable(t *testing.T) {
+	t.Parallel()
+
+	baseDir, _ := os.MkdirTemp("", t.Name())
+	t.Cleanup(func() {
+		_ = os.RemoveAll(baseDir)
+	})
+
+	first, _ := os.MkdirTemp(baseDir, "first")
+	second, _ := os.MkdirTemp(baseDir, "second")
+
+	f, _ := os.CreateTemp(first, "plugin")
+	_ = f.Close()
+
+	// create symlink
+	dest := path.Join(second, "example")
+	_ = os.Symlink(f.Name(), dest)
+
+	plugins, err := loadPlugins(second, plugin.DefaultSettings())
+	require.NoError(t, err)
+	assert.Len(t, plugins, 0)
+}
+
+func TestLoadPlugins_EmptyArg(t *testing.T) {
+	t.Parallel()
+
+	mws, err := loadPlugins("", plugin.DefaultSettings())
+	assert.Empty(t, mws)
+	assert.Nil(t, err)
+}
+
+func TestLoadPlugins_EmptyDir(t *testing.T) {
+	t.Parallel()
+
+	baseDir, _ := os.MkdirTemp("", t.Name())
+	t.Cleanup(func() {
+		_ = os.RemoveAll(baseDir)
+	})
+	mws, err := loadPlugins(baseDir, plugin.DefaultSettings())
+	assert.Empty(t, mws)
+	assert.NoError(t, err)
+}
+
+func TestLoadPlugins_DirNotExist(t *testing.T) {
+	t.Parallel()
+
+	mws, err := loadPlugins("/does/not/exist", plugin.DefaultSettings())
+	assert.Error(t, err)
+	assert.Empty(t, mws)
+}
+
+func TestLoadPluginsPhases(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fname := path.Join(dir, "plugin")
+	require.NoError(t, os.WriteFile(fname, nil, 0o700))
+	require.NoError(t, os.WriteFile(fname+".phases", []byte("request, response\n"), 0o600))
+
+	plugins, err := loadPlugins(dir, plugin.DefaultSettings())
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	subscriber, ok := plugins[0].Plugin.(plugin.PhaseSubscriber)
+	require.True(t, ok)
+	assert.Equal(t, plugin.PhaseRequest|plugin.PhaseResponse, subscriber.Phases())
+}
+
+func TestLoadPluginsPhases_Invalid(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fname := path.Join(dir, "plugin")
+	require.NoError(t, os.WriteFile(fname, nil, 0o700))
+	require.NoError(t, os.WriteFile(fname+".phases", []byte("foo"), 0o600))
+
+	_, err := loadPlugins(dir, plugin.DefaultSettings())
+	assert.ErrorContains(t, err, `unknown plugin phase "foo"`)
+}
+
+func TestLoadPluginsSettings(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fname := path.Join(dir, "plugin")
+	require.NoError(t, os.WriteFile(fname, nil, 0o700))
+	require.NoError(t, os.WriteFile(fname+".yaml", []byte("timeout: 5s\nfailurePolicy: open\nbreakerThreshold: 0\n"), 0o600))
+
+	plugins, err := loadPlugins(dir, plugin.DefaultSettings())
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+
+	expected := plugin.DefaultSettings()
+	expected.Timeout = 5 * time.Second
+	expected.FailurePolicy = plugin.FailOpen
+	expected.BreakerThreshold = 0
+	assert.Equal(t, expected, plugins[0].settings)
+}
+
+func TestLoadPluginsSettings_Invalid(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fname := path.Join(dir, "plugin")
+	require.NoError(t, os.WriteFile(fname, nil, 0o700))
+	require.NoError(t, os.WriteFile(fname+".yaml", []byte("failurePolicy: maybe\n"), 0o600))
+
+	_, err := loadPlugins(dir, plugin.DefaultSettings())
+	assert.ErrorContains(t, err, `unknown plugin failure policy "maybe"`)
+}
+
+func TestLoadPluginsSettings_UnknownField(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fname := path.Join(dir, "plugin")
+	require.NoError(t, os.WriteFile(fname, nil, 0o700))
+	require.NoError(t, os.WriteFile(fname+".yaml", []byte("timeuot: 5s\n"), 0o600))
+
+	_, err := loadPlugins(dir, plugin.DefaultSettings())
+	assert.ErrorContains(t, err, "timeuot")
+}
+
+func TestLoadPluginsSocket(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	listener, err := net.Listen("unix", path.Join(dir, "plugin.sock"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	require.NoError(t, os.WriteFile(path.Join(dir, "plugin.sock.phases"), []byte("validate"), 0o600))
+
+	plugins, err := loadPlugins(dir, plugin.DefaultSettings())
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	p, ok := plugins[0].Plugin.(*plugin.SocketPlugin)
+	require.True(t, ok)
+	assert.Equal(t, plugin.PhaseValidate, p.Phases())
+}
+
+func TestLoadPluginsWasm(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	// WebAssembly modules need not be executable
+	require.NoError(t, os.WriteFile(path.Join(dir, "plugin.wasm"), nil, 0o600))
+	require.NoError(t, os.WriteFile(path.Join(dir, "plugin.wasm.yaml"), []byte("memoryLimit: 16"), 0o600))
+
+	plugins, err := loadPlugins(dir, plugin.DefaultSettings())
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	_, ok := plugins[0].Plugin.(*plugin.WasmPlugin)
+	assert.True(t, ok)
+	assert.Equal(t, 16, plugins[0].settings.MemoryLimit)
+}
+
+// writeManifest writes a manifest and an (empty) executable plugin named "plugin" to dir.
+func writeManifest(t *testing.T, dir string, manifest string) string {
+	require.NoError(t, os.WriteFile(path.Join(dir, "plugin"), nil, 0o700))
+	fname := path.Join(dir, "plugins.yaml")
+	require.NoError(t, os.WriteFile(fname, []byte(manifest), 0o600))
+	return fname
+}
+
+func TestLoadManifest(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fname := writeManifest(t, dir, `plugins:
+  - path: plugin
+    args: [--verbose]
+    env:
+      FOO: bar
+    phases: [request, response]
+    routes:
+      - PUT /jobs/{id}/status
+    settings:
+      timeout: 5s
+  - path: plugin
+    optional: true
+`)
+	plugins, err := loadManifest(fname, "/api/wfx/v1", plugin.DefaultSettings())
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+
+	first := plugins[0]
+	expected, _ := filepath.EvalSymlinks(path.Join(dir, "plugin"))
+	assert.Equal(t, expected, first.Name())
+	assert.Equal(t, plugin.PhaseRequest|plugin.PhaseResponse, first.Plugin.(*plugin.FBPlugin).Phases())
+	assert.Equal(t, 5*time.Second, first.settings.Timeout)
+	assert.Equal(t, plugin.FailClosed, first.settings.FailurePolicy)
+	assert.False(t, first.optional)
+	require.NotNil(t, first.routes)
+	assert.True(t, first.routes.Match(httptest.NewRequest(http.MethodPut, "/api/wfx/v1/jobs/42/status", nil)))
+	assert.False(t, first.routes.Match(httptest.NewRequest(http.MethodGet, "/api/wfx/v1/jobs/42/status", nil)))
+
+	second := plugins[1]
+	assert.Nil(t, second.routes)
+	assert.True(t, second.optional)
+	// optional plugins fail open by default
+	assert.Equal(t, plugin.FailOpen, second.settings.FailurePolicy)
+}
+
+func TestLoadManifest_Invalid(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	listener, err := net.Listen("unix", path.Join(dir, "plugin.sock"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	tcs := map[string]string{
+		"":                            "manifest is empty",
+		"plugins:\n  - pth: plugin\n": "unknown field \"pth\"",
+		"plugins:\n  - args: [foo]\n": "plugin #1: path is required",
+		"plugins:\n  - path: plugin\n  - path: missing\n":                         "plugin #2:",
+		"plugins:\n  - path: plugin\n    phases: [foo]\n":                         `unknown plugin phase "foo"`,
+		"plugins:\n  - path: plugin\n    phases: [notify]\n    routes: [/jobs]\n": "routes require the request or response phase",
+		"plugins:\n  - path: plugin\n    routes: [jobs]\n":                        "path must start with '/'",
+		"plugins:\n  - path: plugin\n    routes: [/jobs, /jobs]\n":                `invalid route "/jobs"`,
+		"plugins:\n  - path: plugin\n    settings:\n      timeuot: 5s\n":          "timeuot",
+		"plugins:\n  - path: plugin.sock\n    args: [foo]\n":                      "args and env are not supported for socket plugins",
+		"plugins:\n  - path: plugins.yaml\n":                                      "neither executable nor a unix domain socket",
+	}
+	for manifest, expected := range tcs {
+		fname := writeManifest(t, dir, manifest)
+		_, err := loadManifest(fname, "/api/wfx/v1", plugin.DefaultSettings())
+		assert.ErrorContains(t, err, expected, manifest)
+	}
+}
+
+func TestLoadManifest_OptionalMissing(t *testing.T) {
+	t.Parallel()
+
+	fname := writeManifest(t, t.TempDir(), "plugins:\n  - path: missing\n    optional: true\n  - path: plugin\n")
+	plugins, err := loadManifest(fname, "/api/wfx/v1", plugin.DefaultSettings())
+	require.NoError(t, err)
+	assert.Len(t, plugins, 1)
+}
+
+func TestLoadManifest_Socket(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	listener, err := net.Listen("unix", path.Join(dir, "plugin.sock"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	fname := writeManifest(t, dir, "plugins:\n  - path: plugin.sock\n    settings:\n      connections: 2\n")
+	plugins, err := loadManifest(fname, "/api/wfx/v1", plugin.DefaultSettings())
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	_, ok := plugins[0].Plugin.(*plugin.SocketPlugin)
+	assert.True(t, ok)
+	assert.Equal(t, 2, plugins[0].settings.Connections)
+}
+
+func TestLoadManifest_Wasm(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dir, "plugin.wasm"), nil, 0o600))
+
+	fname := writeManifest(t, dir, "plugins:\n  - path: plugin.wasm\n    args: [--verbose]\n    env:\n      FOO: bar\n")
+	plugins, err := loadManifest(fname, "/api/wfx/v1", plugin.DefaultSettings())
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	_, ok := plugins[0].Plugin.(*plugin.WasmPlugin)
+	assert.True(t, ok)
+}
+
+func TestAPIPlugins_Reload(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dir, "deny"), []byte("#!/bin/sh\nexec cat\n"), 0o700))
+	fname := writeManifest(t, dir, "plugins: []\n")
+
+	f := config.NewFlagset()
+	require.NoError(t, f.Parse([]string{"--" + config.MgmtPluginsManifestFlag, fname}))
+	cfg, err := config.NewAppConfig(f)
+	require.NoError(t, err)
+	t.Cleanup(cfg.Stop)
+
+	ap, err := newAPIPlugins(cfg, "", fname, "/api/wfx/v1", nil)
+	require.NoError(t, err)
+	t.Cleanup(ap.Stop)
+	initial := ap.current()
+	assert.Empty(t, initial.plugins)
+	_ = ap.Middleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	require.Len(t, ap.routes, 1)
+	assert.Same(t, initial, ap.routes[0].current.Load().chain)
+
+	// replace the manifest atomically
+	tmp := path.Join(dir, "plugins.yaml.tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte("plugins:\n  - path: deny\n"), 0o600))
+	require.NoError(t, os.Rename(tmp, fname))
+
+	require.Eventually(t, func() bool { return len(ap.current().plugins) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, initial.retired.Load())
+	select {
+	case <-ap.Reloaded():
+	case <-time.After(time.Second):
+		assert.Fail(t, "reload was not signaled")
+	}
+	// the route is wrapped by the new chain
+	assert.Same(t, ap.current(), ap.routes[0].current.Load().chain)
+
+	// an invalid manifest keeps the current plugins
+	current := ap.current()
+	require.NoError(t, os.WriteFile(tmp, []byte("plugins:\n  - path: missing\n"), 0o600))
+	require.NoError(t, os.Rename(tmp, fname))
+	time.Sleep(100 * time.Millisecond)
+	assert.Same(t, current, ap.current())
+	assert.False(t, current.retired.Load())
+}
@@ -14,10 +14,12 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/Southclaws/fault"
+	"github.com/alexliesenfeld/health"
 	"github.com/coreos/go-systemd/v22/activation"
 	nethttpmiddleware "github.com/oapi-codegen/nethttp-middleware"
 	"github.com/rs/cors"
@@ -26,8 +28,12 @@ import (
 	"github.com/siemens/wfx/cmd/wfxctl/errutil"
 	"github.com/siemens/wfx/generated/api"
 	"github.com/siemens/wfx/internal/handler/job/events"
+	"github.com/siemens/wfx/internal/handler/job/hooks"
 	"github.com/siemens/wfx/middleware/logging"
+	"github.com/siemens/wfx/middleware/metrics"
 	"github.com/siemens/wfx/middleware/plugin"
+	"github.com/siemens/wfx/middleware/tracing"
+	"github.com/siemens/wfx/middleware/ws"
 	"github.com/siemens/wfx/persistence"
 	"github.com/siemens/wfx/spec"
 	"github.com/siemens/wfx/ui"
@@ -41,11 +47,62 @@ type ServerCollection struct {
 	North   *http.Server
 	South   *http.Server
 
-	pluginMWs    []*plugin.Middleware
-	pluginErrors []<-chan error
+	northPlugins *apiPlugins
+	southPlugins *apiPlugins
+	chStop       chan struct{}
 }
 
-func NewServerCollection(cfg *config.AppConfig, wfx api.StrictServerInterface, storage persistence.Storage) (*ServerCollection, error) {
+// Option customizes a ServerCollection, e.g. when embedding wfx as a library.
+type Option func(*options)
+
+type options struct {
+	northPlugins     []plugin.Plugin
+	southPlugins     []plugin.Plugin
+	northMiddlewares []api.MiddlewareFunc
+	southMiddlewares []api.MiddlewareFunc
+}
+
+// WithNorthPlugins registers plugins for the northbound (management) API. They
+// run after the plugins loaded from the plugin directory and are supervised
+// using the configured plugin settings.
+func WithNorthPlugins(plugins ...plugin.Plugin) Option {
+	return func(o *options) {
+		o.northPlugins = append(o.northPlugins, plugins...)
+	}
+}
+
+// WithSouthPlugins registers plugins for the southbound (client) API. See WithNorthPlugins.
+func WithSouthPlugins(plugins ...plugin.Plugin) Option {
+	return func(o *options) {
+		o.southPlugins = append(o.southPlugins, plugins...)
+	}
+}
+
+// WithNorthMiddlewares adds middlewares to the northbound (management) API.
+// They are invoked after the plugins and the request validation, i.e. only for
+// valid requests which passed all plugins.
+func WithNorthMiddlewares(mws ...api.MiddlewareFunc) Option {
+	return func(o *options) {
+		o.northMiddlewares = append(o.northMiddlewares, mws...)
+	}
+}
+
+// WithSouthMiddlewares adds middlewares to the southbound (client) API. See WithNorthMiddlewares.
+func WithSouthMiddlewares(mws ...api.MiddlewareFunc) Option {
+	return func(o *options) {
+		o.southMiddlewares = append(o.southMiddlewares, mws...)
+	}
+}
+
+// NewServerCollection creates the northbound and southbound servers. The
+// plugins found in the configured plugin directories are started right away;
+// use Stop to stop them.
+func NewServerCollection(cfg *config.AppConfig, wfx api.StrictServerInterface, storage persistence.Storage, opts ...Option) (*ServerCollection, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	swag, _ := api.GetSpec()
 	validator := nethttpmiddleware.OapiRequestValidatorWithOptions(swag,
 		&nethttpmiddleware.Options{SilenceServersWarning: true})
@@ -54,49 +111,46 @@ func NewServerCollection(cfg *config.AppConfig, wfx api.StrictServerInterface, s
 
 	// LIFO
 	middlewares := []api.MiddlewareFunc{validator, corsMW, logMW}
+	// custom middlewares are innermost, i.e. they see validated requests only
+	northMWs := slices.Concat(o.northMiddlewares, middlewares)
+	southMWs := slices.Concat(o.southMiddlewares, middlewares)
 
-	pluginMWs := make([]*plugin.Middleware, 0)
-	pluginErrors := make([]<-chan error, 0)
-
-	northPluginMWs, err := createPluginMiddlewares(cfg.MgmtPluginsDir())
+	basePath := errutil.Must(swag.Servers.BasePath())
+	northPlugins, err := newAPIPlugins(cfg, cfg.MgmtPluginsDir(), cfg.MgmtPluginsManifest(), basePath, o.northPlugins)
 	if err != nil {
 		return nil, fault.Wrap(err)
 	}
 
-	for _, mw := range northPluginMWs {
-		pluginMWs = append(pluginMWs, mw)
-		pluginErrors = append(pluginErrors, mw.Errors())
-	}
-
-	basePath := errutil.Must(swag.Servers.BasePath())
 	mux := createMux(cfg, basePath, ui.Enabled)
-	northServer, err := createServer(cfg, NewNorthboundServer(wfx), mux, middlewares, northPluginMWs)
+	// metrics are only exposed on the management interface
+	mux.Handle("GET /metrics", metrics.Handler())
+	northServer, err := createServer(cfg, "north", NewNorthboundServer(wfx), mux, northMWs, northPlugins.Middleware())
 	if err != nil {
+		northPlugins.Stop()
 		return nil, fault.Wrap(err)
 	}
 
-	southPluginMWs, err := createPluginMiddlewares(cfg.ClientPluginsDir())
+	southPlugins, err := newAPIPlugins(cfg, cfg.ClientPluginsDir(), cfg.ClientPluginsManifest(), basePath, o.southPlugins)
 	if err != nil {
+		northPlugins.Stop()
 		return nil, fault.Wrap(err)
 	}
 
-	for _, mw := range southPluginMWs {
-		pluginMWs = append(pluginMWs, mw)
-		pluginErrors = append(pluginErrors, mw.Errors())
-	}
-
 	// southbound, UI is always disabled
 	mux = createMux(cfg, basePath, false)
-	southServer, err := createServer(cfg, NewSouthboundServer(wfx), mux, middlewares, southPluginMWs)
+	southServer, err := createServer(cfg, "south", NewSouthboundServer(wfx), mux, southMWs, southPlugins.Middleware())
 	if err != nil {
+		northPlugins.Stop()
+		southPlugins.Stop()
 		return nil, fault.Wrap(err)
 	}
 
 	return &ServerCollection{
 		cfg:          cfg,
 		storage:      storage,
-		pluginMWs:    pluginMWs,
-		pluginErrors: pluginErrors,
+		northPlugins: northPlugins,
+		southPlugins: southPlugins,
+		chStop:       make(chan struct{}),
 		North:        northServer,
 		South:        southServer,
 	}, nil
@@ -198,32 +252,58 @@ func (sc *ServerCollection) Start() error {
 		})
 	}
 
-	if len(sc.pluginErrors) > 0 {
-		g.Go(func() error {
-			defer func() {
-				log.Debug().Msg("Plugin reaper finished, triggering shutdown")
-				sc.Stop()
-			}()
-
-			running := true
-			for running {
-				for _, chErr := range sc.pluginErrors {
+	g.Go(func() error {
+		defer func() {
+			log.Debug().Msg("Plugin reaper finished, triggering shutdown")
+			sc.Stop()
+		}()
+
+		// the ticker only runs while there are plugins to be reaped
+		var ticker *time.Ticker
+		var chTick <-chan time.Time
+		defer func() {
+			if ticker != nil {
+				ticker.Stop()
+			}
+		}()
+		for {
+			switch hasPlugins := sc.hasPlugins(); {
+			case hasPlugins && ticker == nil:
+				ticker = time.NewTicker(time.Millisecond * 300)
+				chTick = ticker.C
+			case !hasPlugins && ticker != nil:
+				ticker.Stop()
+				ticker, chTick = nil, nil
+			}
+			select {
+			case <-sc.chStop:
+				return nil
+			case <-sc.northPlugins.Reloaded():
+				continue
+			case <-sc.southPlugins.Reloaded():
+				continue
+			case <-chTick:
+			}
+			for _, chain := range sc.pluginChains() {
+				for _, mw := range chain.middlewares() {
 					select {
-					case err := <-chErr:
-						running = false
+					case err, ok := <-mw.Errors():
+						if !ok && chain.retired.Load() {
+							// the plugin was removed by reloading the manifest
+							continue
+						}
 						if err != nil {
 							log.Err(err).Msg("Received plugin error")
 							return err
 						}
+						return nil
 					default:
-						// no errors or channel was closed
+						// no errors
 					}
 				}
-				time.Sleep(time.Millisecond * 300)
 			}
-			return nil
-		})
-	}
+		}
+	})
 
 	log.Debug().Msg("Waiting for goroutines to finish")
 	err := g.Wait()
@@ -232,11 +312,58 @@ func (sc *ServerCollection) Start() error {
 	return fault.Wrap(err)
 }
 
+// HealthChecks returns a health check for the plugins of each API which uses
+// plugins. The checks evaluate the current plugins, i.e. they take reloads of
+// a plugin manifest into account. A plugin is considered down while it is
+// restarting or its circuit breaker is open.
+func (sc *ServerCollection) HealthChecks() []health.Check {
+	checks := make([]health.Check, 0, 2)
+	for _, entry := range []struct {
+		name string
+		ap   *apiPlugins
+	}{{"north", sc.northPlugins}, {"south", sc.southPlugins}} {
+		name, ap := entry.name, entry.ap
+		if ap.manifest == "" && len(ap.current().plugins) == 0 {
+			// the plugins of this API never change
+			continue
+		}
+		checks = append(checks, health.Check{
+			Name: "plugins " + name,
+			Check: func(ctx context.Context) error {
+				var errs []error
+				for _, mw := range ap.current().middlewares() {
+					if err := mw.CheckHealth(ctx); err != nil {
+						errs = append(errs, fmt.Errorf("plugin %s: %w", mw.Name(), err))
+					}
+				}
+				return errors.Join(errs...)
+			},
+		})
+	}
+	return checks
+}
+
+// pluginChains returns the current plugin chains of the northbound and southbound API.
+func (sc *ServerCollection) pluginChains() []*pluginChain {
+	return []*pluginChain{sc.northPlugins.current(), sc.southPlugins.current()}
+}
+
+// hasPlugins reports whether any API uses plugins.
+func (sc *ServerCollection) hasPlugins() bool {
+	for _, chain := range sc.pluginChains() {
+		if len(chain.plugins) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Stop the server collection and its associated listeners. It's safe to call this method multiple times.
 func (sc *ServerCollection) Stop() {
 	sc.once.Do(func() {
 		timeout := sc.cfg.GracefulTimeout()
 		log.Info().Dur("timeout", timeout).Msg("Shutting down server collection")
+		close(sc.chStop)
 
 		// shut down (disconnect) subscribers otherwise we cannot stop the web server due to open connections
 		events.ShutdownSubscribers()
@@ -265,24 +392,27 @@ func (sc *ServerCollection) Stop() {
 		shutdownGroup.Wait()
 
 		log.Debug().Msg("Shutting down plugin middlewares")
-		for _, mw := range sc.pluginMWs {
-			mw.Stop()
-		}
+		sc.northPlugins.Stop()
+		sc.southPlugins.Stop()
 
 		log.Info().Msg("Server collection shut down complete")
 	})
 }
 
-func createServer(cfg *config.AppConfig, ssi api.StrictServerInterface, router *http.ServeMux, baseMWs []api.MiddlewareFunc, pluginMWs []*plugin.Middleware) (*http.Server, error) {
-	combinedMWs := make([]api.MiddlewareFunc, 0, len(baseMWs)+len(pluginMWs))
+func createServer(cfg *config.AppConfig, name string, ssi api.StrictServerInterface, router *http.ServeMux, baseMWs []api.MiddlewareFunc, pluginsMW api.MiddlewareFunc) (*http.Server, error) {
+	combinedMWs := make([]api.MiddlewareFunc, 0, len(baseMWs)+3)
 	combinedMWs = append(combinedMWs, baseMWs...)
-	for _, mw := range pluginMWs {
-		combinedMWs = append(combinedMWs, mw.Middleware())
+	if pluginsMW != nil {
+		combinedMWs = append(combinedMWs, pluginsMW)
 	}
+	// outside of the plugins, so that requests answered by a plugin are counted as well
+	combinedMWs = append(combinedMWs, metrics.NewMetricsMiddleware(name))
+	// outermost, so that plugins and all other middlewares are part of the trace
+	combinedMWs = append(combinedMWs, tracing.NewTracingMiddleware(name))
 
 	swag, _ := api.GetSpec()
 	basePath := errutil.Must(swag.Servers.BasePath())
-	strictHandler := api.NewStrictHandler(ssi, nil)
+	strictHandler := api.NewStrictHandler(ssi, []api.StrictMiddlewareFunc{ws.UpgradeMiddleware})
 	router.HandleFunc("GET /version", strictHandler.GetVersion)
 	router.HandleFunc("GET /health", strictHandler.GetHealth)
 	handler := api.HandlerWithOptions(strictHandler, api.StdHTTPServerOptions{
@@ -294,22 +424,28 @@ func createServer(cfg *config.AppConfig, ssi api.StrictServerInterface, router *
 	return server, fault.Wrap(err)
 }
 
-func createPluginMiddlewares(pluginDir string) ([]*plugin.Middleware, error) {
-	plugins, err := loadPlugins(pluginDir)
-	if err != nil {
-		return nil, fault.Wrap(err)
-	}
+// registerHooks registers the plugins which subscribe to job events.
+func registerHooks(pluginMWs []*plugin.Middleware) {
+	replaceHooks(nil, pluginMWs)
+}
 
-	pluginMWs := make([]*plugin.Middleware, 0, len(plugins))
-	for _, p := range plugins {
-		chErr := make(chan error, 1)
-		mw, err := plugin.NewMiddleware(p, chErr)
-		if err != nil {
-			return nil, fault.Wrap(err)
+// replaceHooks atomically replaces the hooks of the old plugins by those of the new plugins.
+func replaceHooks(oldMWs []*plugin.Middleware, newMWs []*plugin.Middleware) {
+	old := make([]any, 0, len(oldMWs))
+	for _, mw := range oldMWs {
+		old = append(old, mw)
+	}
+	var validators []hooks.Validator
+	var notifiers []hooks.Notifier
+	for _, mw := range newMWs {
+		if mw.Phases().Has(plugin.PhaseValidate) {
+			validators = append(validators, mw)
+		}
+		if mw.Phases().Has(plugin.PhaseNotify) {
+			notifiers = append(notifiers, mw)
 		}
-		pluginMWs = append(pluginMWs, mw)
 	}
-	return pluginMWs, nil
+	hooks.Replace(old, validators, notifiers)
 }
 
 type ListenerSettings struct {
@@ -0,0 +1,249 @@
+package server
+
+/*
+ * SPDX-FileCopyrightText: 2024 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/siemens/wfx/api"
+	"github.com/siemens/wfx/cmd/wfx/cmd/config"
+	genAPI "github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/middleware/metrics"
+	"github.com/siemens/wfx/middleware/plugin"
+	"github.com/siemens/wfx/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServerCollection(t *testing.T) {
+	dbMock := persistence.NewHealthyMockStorage(t)
+	sc, err := NewServerCollection(new(config.AppConfig), nil, dbMock)
+	assert.NotNil(t, sc)
+	assert.NoError(t, err)
+}
+
+func TestCreateServer_UseMiddlewares(t *testing.T) {
+	dbMock := persistence.NewHealthyMockStorage(t)
+	// the request context carries the span created by the tracing middleware
+	dbMock.EXPECT().QueryJobs(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(genAPI.PaginatedJobList), nil)
+	wfx := api.NewWfxServer(dbMock)
+
+	var myMWCalled atomic.Bool
+	myMW := func(next http.Handler) http.Handler {
+		myMWCalled.Store(true)
+		return next
+	}
+
+	middlewares := []genAPI.MiddlewareFunc{myMW}
+	cfg := new(config.AppConfig)
+	mux := createMux(cfg, "/api/wfx/v1", false)
+	server, err := createServer(cfg, "north", NewNorthboundServer(wfx), mux, middlewares, nil)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("", "/api/wfx/v1/jobs", nil)
+
+	server.Handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	assert.True(t, myMWCalled.Load())
+}
+
+func TestOpenAPIJSON(t *testing.T) {
+	mux := createMux(new(config.AppConfig), "/api/wfx/v1", false)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/wfx/v1/openapi.json", nil))
+	result := rec.Result()
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+}
+
+func TestTopLevelNotFound(t *testing.T) {
+	mux := createMux(new(config.AppConfig), "/api/wfx/v1", false)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	result := rec.Result()
+	assert.Equal(t, http.StatusNoContent, result.StatusCode)
+	assert.Equal(t, strings.HasSuffix(result.Header.Get("Link"), `/api/wfx/v1/openapi.json>; rel="service-desc"`), true)
+}
+
+func TestDownloadRedirect(t *testing.T) {
+	mux := createMux(new(config.AppConfig), "/api/wfx/v1", false)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/download", nil))
+	result := rec.Result()
+	// this has changed from MovedPermanently to StatusTemporaryRedirect in Go 1.26, see https://go.dev/doc/go1.26
+	assert.True(t, result.StatusCode == http.StatusTemporaryRedirect || result.StatusCode == http.StatusMovedPermanently)
+	b, _ := io.ReadAll(result.Body)
+	assert.Contains(t, string(b), "/download/")
+}
+
+func TestDownload(t *testing.T) {
+	tmp := os.TempDir()
+	tmpFile, _ := os.CreateTemp(tmp, "TestDownload.*")
+	_, _ = tmpFile.Write([]byte("hello world"))
+	_ = tmpFile.Close()
+	t.Cleanup(func() { _ = os.Remove(tmpFile.Name()) })
+
+	f := config.NewFlagset()
+	_ = f.Parse([]string{"--" + config.SimpleFileServerFlag, tmp})
+	cfg, err := config.NewAppConfig(f)
+	require.NotEmpty(t, cfg.SimpleFileserver())
+	require.NoError(t, err)
+	t.Cleanup(cfg.Stop)
+
+	mux := createMux(cfg, "/api/wfx/v1", false)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/download/%s", path.Base(tmpFile.Name())), nil))
+	result := rec.Result()
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	b, _ := io.ReadAll(result.Body)
+	assert.Contains(t, string(b), "hello world")
+}
+
+func TestDownload_NotFound(t *testing.T) {
+	f := config.NewFlagset()
+	cfg, err := config.NewAppConfig(f)
+	t.Cleanup(cfg.Stop)
+	require.NoError(t, err)
+	mux := createMux(cfg, "/api/wfx/v1", false)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/download/", nil))
+	result := rec.Result()
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	dbMock := persistence.NewHealthyMockStorage(t)
+	sc, err := NewServerCollection(new(config.AppConfig), api.NewWfxServer(dbMock), dbMock)
+	require.NoError(t, err)
+
+	{
+		rec := httptest.NewRecorder()
+		sc.North.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		result := rec.Result()
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+		body, _ := io.ReadAll(result.Body)
+		assert.Contains(t, string(body), "wfx_sse_subscribers")
+	}
+	{
+		rec := httptest.NewRecorder()
+		sc.South.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	}
+}
+
+func TestHealthChecks(t *testing.T) {
+	mw, err := plugin.NewMiddleware(plugin.NewFBPlugin("cat"), plugin.DefaultSettings())
+	require.NoError(t, err)
+	defer mw.Stop()
+
+	sc := &ServerCollection{
+		northPlugins: &apiPlugins{chain: &pluginChain{plugins: []chainedPlugin{{mw: mw}}}},
+		southPlugins: &apiPlugins{chain: &pluginChain{}},
+	}
+	checks := sc.HealthChecks()
+	require.Len(t, checks, 1)
+	assert.Equal(t, "plugins north", checks[0].Name)
+	assert.NoError(t, checks[0].Check(t.Context()))
+
+	// the check evaluates the current chain, e.g. after reloading the manifest
+	stopped, err := plugin.NewMiddleware(plugin.NewFBPlugin("cat"), plugin.DefaultSettings())
+	require.NoError(t, err)
+	stopped.Stop()
+	sc.northPlugins.chain = &pluginChain{plugins: []chainedPlugin{{mw: mw}, {mw: stopped}}}
+	assert.ErrorContains(t, checks[0].Check(t.Context()), "plugin cat: plugin is unavailable")
+}
+
+func TestNewServerCollection_Options(t *testing.T) {
+	dbMock := persistence.NewHealthyMockStorage(t)
+	dbMock.EXPECT().QueryJobs(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(new(genAPI.PaginatedJobList), nil)
+	wfx := api.NewWfxServer(dbMock)
+
+	deny := plugin.NewGoPlugin("deny", plugin.HandlerFunc(func(context.Context, *plugin.Request) (*plugin.Verdict, error) {
+		return plugin.Reply(plugin.ReplyDeny, nil, nil), nil
+	}))
+	header := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Embedded", "true")
+			next.ServeHTTP(w, r)
+		})
+	}
+	sc, err := NewServerCollection(new(config.AppConfig), wfx, dbMock,
+		WithNorthPlugins(deny),
+		WithSouthMiddlewares(header))
+	require.NoError(t, err)
+	t.Cleanup(sc.Stop)
+	require.Len(t, sc.HealthChecks(), 1)
+
+	{
+		rec := httptest.NewRecorder()
+		sc.North.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/wfx/v1/jobs", nil))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Empty(t, rec.Header().Get("X-Embedded"))
+	}
+	{
+		rec := httptest.NewRecorder()
+		sc.South.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/wfx/v1/jobs", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "true", rec.Header().Get("X-Embedded"))
+	}
+}
+
+// httpRequests returns the number of requests recorded by the metrics middleware.
+func httpRequests(t *testing.T, server string, operation string, code string) float64 {
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "wfx_http_requests_total" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range m.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["server"] == server && labels["operation"] == operation && labels["code"] == code {
+				return m.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func TestNewServerCollection_MetricsIncludePlugins(t *testing.T) {
+	dbMock := persistence.NewHealthyMockStorage(t)
+	wfx := api.NewWfxServer(dbMock)
+
+	deny := plugin.NewGoPlugin("deny", plugin.HandlerFunc(func(context.Context, *plugin.Request) (*plugin.Verdict, error) {
+		return plugin.Reply(plugin.ReplyDeny, nil, nil), nil
+	}))
+	sc, err := NewServerCollection(new(config.AppConfig), wfx, dbMock, WithNorthPlugins(deny))
+	require.NoError(t, err)
+	t.Cleanup(sc.Stop)
+
+	const operation = "GET /api/wfx/v1/workflows"
+	before := httpRequests(t, "north", operation, "403")
+	rec := httptest.NewRecorder()
+	sc.North.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/wfx/v1/workflows", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	// requests answered by a plugin are counted as well
+	assert.Equal(t, before+1, httpRequests(t, "north", operation, "403"))
+}
@@ -52,6 +52,18 @@ func (south SouthboundServer) DeleteJobsId(context.Context, api.DeleteJobsIdRequ
 	return api.DeleteJobsId403Response{}, nil
 }
 
+func (south SouthboundServer) GetJobsEventsWs(ctx context.Context, request api.GetJobsEventsWsRequestObject) (api.GetJobsEventsWsResponseObject, error) {
+	resp, err := south.wfx.GetJobsEventsWs(ctx, request)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	return resp, nil
+}
+
+func (south SouthboundServer) GetJobsStats(context.Context, api.GetJobsStatsRequestObject) (api.GetJobsStatsResponseObject, error) {
+	return api.GetJobsStats403Response{}, nil
+}
+
 func (south SouthboundServer) GetJobsId(ctx context.Context, request api.GetJobsIdRequestObject) (api.GetJobsIdResponseObject, error) {
 	resp, err := south.wfx.GetJobsId(ctx, request)
 	if err != nil {
@@ -132,6 +144,14 @@ func (south SouthboundServer) GetWorkflowsName(ctx context.Context, request api.
 	return resp, nil
 }
 
+func (south SouthboundServer) PutWorkflowsName(context.Context, api.PutWorkflowsNameRequestObject) (api.PutWorkflowsNameResponseObject, error) {
+	return api.PutWorkflowsName403Response{}, nil
+}
+
+func (south SouthboundServer) GetWorkflowsNameStats(context.Context, api.GetWorkflowsNameStatsRequestObject) (api.GetWorkflowsNameStatsResponseObject, error) {
+	return api.GetWorkflowsNameStats403Response{}, nil
+}
+
 func (south SouthboundServer) GetHealth(ctx context.Context, request api.GetHealthRequestObject) (api.GetHealthResponseObject, error) {
 	resp, err := south.wfx.GetHealth(ctx, request)
 	if err != nil {
@@ -359,3 +359,36 @@ func TestVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestSouthboundGetJobsStats_Forbidden(t *testing.T) {
+	server := createServerForTesting(t, "south", persistence.NewHealthyMockStorage(t))
+
+	resp, err := server.GetJobsStats(t.Context(), api.GetJobsStatsRequestObject{})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	_ = resp.VisitGetJobsStatsResponse(recorder)
+	assert.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
+
+func TestSouthboundGetWorkflowsNameStats_Forbidden(t *testing.T) {
+	server := createServerForTesting(t, "south", persistence.NewHealthyMockStorage(t))
+
+	resp, err := server.GetWorkflowsNameStats(t.Context(), api.GetWorkflowsNameStatsRequestObject{Name: "foo"})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	_ = resp.VisitGetWorkflowsNameStatsResponse(recorder)
+	assert.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
+
+func TestSouthboundPutWorkflowsName_Forbidden(t *testing.T) {
+	server := createServerForTesting(t, "south", persistence.NewHealthyMockStorage(t))
+
+	resp, err := server.PutWorkflowsName(t.Context(), api.PutWorkflowsNameRequestObject{Name: "foo"})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	_ = resp.VisitPutWorkflowsNameResponse(recorder)
+	assert.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
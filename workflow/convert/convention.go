@@ -0,0 +1,108 @@
+package convert
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+// Rule assigns an actor (and optionally an action) to all transitions whose label matches a regular expression.
+// The label of a transition consists of its event and guard, e.g. "download_done [checksum_ok]".
+type Rule struct {
+	Match    string           `json:"match"`
+	Eligible api.EligibleEnum `json:"eligible"`
+	Action   api.ActionEnum   `json:"action,omitempty"`
+
+	re *regexp.Regexp
+}
+
+// Convention determines the eligible actor of each transition. The first matching rule wins; if no rule matches,
+// Default is used.
+type Convention struct {
+	Rules   []Rule           `json:"rules"`
+	Default api.EligibleEnum `json:"default"`
+}
+
+// DefaultConvention recognizes labels mentioning the actor, as written by wfx-viewer. All other transitions are
+// eligible for the client.
+func DefaultConvention() *Convention {
+	conv := &Convention{
+		Rules: []Rule{
+			{Match: `(?i)\bimmediate\b`, Eligible: api.WFX, Action: api.IMMEDIATE},
+			{Match: `(?i)\bwfx\b`, Eligible: api.WFX},
+			{Match: `(?i)\bclient\b`, Eligible: api.CLIENT},
+		},
+		Default: api.CLIENT,
+	}
+	_ = conv.compile()
+	return conv
+}
+
+// LoadConvention reads a convention in YAML (or JSON) format.
+func LoadConvention(r io.Reader) (*Convention, error) {
+	var conv Convention
+	if err := yaml.NewDecoder(r).Decode(&conv); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if conv.Default == "" {
+		conv.Default = api.CLIENT
+	}
+	if err := conv.compile(); err != nil {
+		return nil, err
+	}
+	return &conv, nil
+}
+
+func (c *Convention) compile() error {
+	if !validEligible(c.Default) {
+		return fmt.Errorf("invalid default actor %q", c.Default)
+	}
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		re, err := regexp.Compile(rule.Match)
+		if err != nil {
+			return fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		rule.re = re
+		if !validEligible(rule.Eligible) {
+			return fmt.Errorf("rule %d: invalid actor %q", i+1, rule.Eligible)
+		}
+		switch rule.Action {
+		case "", api.WAIT, api.IMMEDIATE:
+		default:
+			return fmt.Errorf("rule %d: invalid action %q", i+1, rule.Action)
+		}
+	}
+	return nil
+}
+
+func validEligible(eligible api.EligibleEnum) bool {
+	return eligible == api.CLIENT || eligible == api.WFX
+}
+
+// Classify returns the actor and action (nil for the default action) of a transition with the given label.
+func (c *Convention) Classify(label string) (api.EligibleEnum, *api.ActionEnum) {
+	for _, rule := range c.Rules {
+		if rule.re != nil && rule.re.MatchString(label) {
+			if rule.Action == "" {
+				return rule.Eligible, nil
+			}
+			action := rule.Action
+			return rule.Eligible, &action
+		}
+	}
+	return c.Default, nil
+}
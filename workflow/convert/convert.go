@@ -0,0 +1,249 @@
+package convert
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Southclaws/fault"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow"
+)
+
+// Format is a state machine notation which can be converted into a wfx workflow.
+type Format string
+
+const (
+	// FormatSCXML is the State Chart XML, see https://www.w3.org/TR/scxml/
+	FormatSCXML Format = "scxml"
+	// FormatMermaid is a mermaid state diagram, see https://mermaid.js.org/syntax/stateDiagram.html
+	FormatMermaid Format = "mermaid"
+	// FormatSMCat is the State Machine Cat notation, see https://state-machine-cat.js.org
+	FormatSMCat Format = "smcat"
+)
+
+// Formats contains all supported formats.
+var Formats = []Format{FormatSCXML, FormatMermaid, FormatSMCat}
+
+var extensions = map[string]Format{
+	".scxml":   FormatSCXML,
+	".xml":     FormatSCXML,
+	".mmd":     FormatMermaid,
+	".mermaid": FormatMermaid,
+	".smcat":   FormatSMCat,
+}
+
+// DetectFormat guesses the format from the file extension.
+func DetectFormat(filename string) (Format, bool) {
+	format, ok := extensions[strings.ToLower(filepath.Ext(filename))]
+	return format, ok
+}
+
+// actorOnly matches labels which merely state the actor (and action) of a transition.
+var actorOnly = regexp.MustCompile(`(?i)^(CLIENT|WFX)(\s*\[(WAIT|IMMEDIATE)\])?$`)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9\-\.]+$`)
+
+const maxNameLength = 64
+
+// Options control the conversion.
+type Options struct {
+	// Name of the workflow; if empty, the name from the source (if any) is used.
+	Name string
+	// Convention maps transition labels to actors; defaults to DefaultConvention.
+	Convention *Convention
+}
+
+// Import parses a state machine and converts it into a workflow. Compound states become groups of their direct
+// children; transitions leaving a compound state are added for each of its states and transitions entering it lead to
+// its initial state. The label of a transition (event and guard) determines the eligible actor, see Convention, and is
+// kept as description. The result is guaranteed to pass workflow.ValidateWorkflow.
+func Import(r io.Reader, format Format, opts Options) (*api.Workflow, error) {
+	var m *model
+	var err error
+	switch format {
+	case FormatSCXML:
+		m, err = parseSCXML(r)
+	case FormatMermaid:
+		m, err = parseMermaid(r)
+	case FormatSMCat:
+		m, err = parseSMCat(r)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = m.name
+	}
+	if name == "" {
+		return nil, errors.New("the state machine has no name, please provide one")
+	}
+	if len(name) > maxNameLength || !validName.MatchString(name) {
+		return nil, fmt.Errorf("invalid workflow name %q: must consist of at most %d letters, digits, dashes or dots", name, maxNameLength)
+	}
+	conv := opts.Convention
+	if conv == nil {
+		conv = DefaultConvention()
+	}
+
+	wf, err := m.build(name, conv)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if err := workflow.ValidateWorkflow(wf); err != nil {
+		return nil, fmt.Errorf("converted workflow is invalid: %w", err)
+	}
+	return wf, nil
+}
+
+// model is the format-independent representation of a parsed state machine.
+type model struct {
+	name        string
+	description string
+	states      []*state
+	byID        map[string]*state
+	transitions []transition
+}
+
+type state struct {
+	id          string
+	description string
+	parent      *state
+	children    []*state
+	// initial child of a compound state
+	initial string
+}
+
+type transition struct {
+	from, to, label string
+}
+
+func newModel() *model {
+	return &model{byID: make(map[string]*state)}
+}
+
+// state returns the state with the given ID, creating it as child of parent if it does not exist yet.
+func (m *model) state(id string, parent *state) *state {
+	if s, ok := m.byID[id]; ok {
+		return s
+	}
+	s := &state{id: id, parent: parent}
+	m.states = append(m.states, s)
+	m.byID[id] = s
+	if parent != nil {
+		parent.children = append(parent.children, s)
+	}
+	return s
+}
+
+func (m *model) addTransition(from, to, label string) {
+	m.transitions = append(m.transitions, transition{from: from, to: to, label: strings.TrimSpace(label)})
+}
+
+// resolve returns the (atomic) state which is entered when s is the target of a transition.
+func (m *model) resolve(s *state) (*state, error) {
+	for seen := map[*state]bool{}; len(s.children) > 0; {
+		if seen[s] {
+			return nil, fmt.Errorf("initial state of %s is cyclic", s.id)
+		}
+		seen[s] = true
+		next := s.children[0]
+		if s.initial != "" {
+			var ok bool
+			if next, ok = m.byID[s.initial]; !ok || next.parent != s {
+				return nil, fmt.Errorf("initial state %s of %s is not one of its children", s.initial, s.id)
+			}
+		}
+		s = next
+	}
+	return s, nil
+}
+
+// leaves returns all atomic states within s.
+func leaves(s *state) []*state {
+	if len(s.children) == 0 {
+		return []*state{s}
+	}
+	var result []*state
+	for _, child := range s.children {
+		result = append(result, leaves(child)...)
+	}
+	return result
+}
+
+func (m *model) build(name string, conv *Convention) (*api.Workflow, error) {
+	wf := &api.Workflow{Name: name, Description: m.description}
+	for _, s := range m.states {
+		if len(s.children) == 0 {
+			wf.States = append(wf.States, api.State{Name: s.id, Description: s.description})
+			continue
+		}
+		group := api.Group{Name: s.id, Description: s.description}
+		for _, child := range s.children {
+			if len(child.children) == 0 {
+				group.States = append(group.States, child.id)
+			}
+		}
+		if len(group.States) > 0 {
+			wf.Groups = append(wf.Groups, group)
+		}
+	}
+
+	type key struct {
+		from, to string
+		eligible api.EligibleEnum
+	}
+	seen := make(map[key]bool)
+	for _, t := range m.transitions {
+		from, to := m.byID[t.from], m.byID[t.to]
+		if from == nil || to == nil {
+			return nil, fmt.Errorf("transition %s -> %s refers to an unknown state", t.from, t.to)
+		}
+		target, err := m.resolve(to)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		eligible, action := conv.Classify(t.label)
+		description := t.label
+		if actorOnly.MatchString(description) {
+			// nothing worth keeping, e.g. the output of wfx-viewer
+			description = ""
+		}
+		for _, source := range leaves(from) {
+			k := key{from: source.id, to: target.id, eligible: eligible}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			wf.Transitions = append(wf.Transitions, api.Transition{
+				From:        source.id,
+				To:          target.id,
+				Eligible:    eligible,
+				Action:      action,
+				Description: description,
+			})
+		}
+	}
+	return wf, nil
+}
+
+// unsupported returns an error for state machine features which have no equivalent in wfx.
+func unsupported(feature string) error {
+	return fmt.Errorf("%s are not supported by wfx", feature)
+}
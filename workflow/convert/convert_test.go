@@ -0,0 +1,331 @@
+package convert
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+)
+
+const scxml = `<?xml version="1.0" encoding="UTF-8"?>
+<scxml xmlns="http://www.w3.org/2005/07/scxml" version="1.0" name="legacy.updater" initial="IDLE">
+  <state id="IDLE">
+    <transition event="start" target="UPDATE"/>
+  </state>
+  <state id="UPDATE" initial="DOWNLOADING">
+    <transition event="abort" target="FAILED"/>
+    <state id="DOWNLOADING">
+      <transition event="progress" target="DOWNLOADING"/>
+      <transition event="done" cond="checksum_ok" target="INSTALLING"/>
+      <onentry><log expr="'downloading'"/></onentry>
+    </state>
+    <state id="INSTALLING">
+      <transition event="done" target="DONE"/>
+    </state>
+  </state>
+  <final id="DONE"/>
+  <final id="FAILED"/>
+</scxml>
+`
+
+func transitions(wf *api.Workflow) []string {
+	result := make([]string, 0, len(wf.Transitions))
+	for _, t := range wf.Transitions {
+		s := t.From + " -> " + t.To + " " + string(t.Eligible)
+		if t.Action != nil {
+			s += " " + string(*t.Action)
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+func stateNames(wf *api.Workflow) []string {
+	result := make([]string, 0, len(wf.States))
+	for _, s := range wf.States {
+		result = append(result, s.Name)
+	}
+	return result
+}
+
+func TestImportSCXML(t *testing.T) {
+	conv := DefaultConvention()
+	conv.Rules = append(conv.Rules, Rule{Match: `^start$`, Eligible: api.WFX})
+	require.NoError(t, conv.compile())
+
+	wf, err := Import(strings.NewReader(scxml), FormatSCXML, Options{Convention: conv})
+	require.NoError(t, err)
+	assert.Equal(t, "legacy.updater", wf.Name)
+	assert.Equal(t, []string{"IDLE", "DOWNLOADING", "INSTALLING", "DONE", "FAILED"}, stateNames(wf))
+	assert.Equal(t, []api.Group{{Name: "UPDATE", States: []string{"DOWNLOADING", "INSTALLING"}}}, wf.Groups)
+	assert.Equal(t, []string{
+		// entering the compound state leads to its initial state
+		"IDLE -> DOWNLOADING WFX",
+		// leaving the compound state is possible from each of its states
+		"DOWNLOADING -> FAILED CLIENT",
+		"INSTALLING -> FAILED CLIENT",
+		"DOWNLOADING -> DOWNLOADING CLIENT",
+		"DOWNLOADING -> INSTALLING CLIENT",
+		"INSTALLING -> DONE CLIENT",
+	}, transitions(wf))
+	assert.Equal(t, "done [checksum_ok]", wf.Transitions[4].Description)
+}
+
+func TestImportSCXML_Unsupported(t *testing.T) {
+	_, err := Import(strings.NewReader(`<scxml name="x"><parallel id="P"/></scxml>`), FormatSCXML, Options{})
+	require.ErrorContains(t, err, "parallel states are not supported")
+
+	_, err = Import(strings.NewReader(`<scxml name="x"><state id="A"><transition target="B C"/></state></scxml>`), FormatSCXML, Options{})
+	require.ErrorContains(t, err, "multiple targets")
+
+	_, err = Import(strings.NewReader(`<foo/>`), FormatSCXML, Options{})
+	require.ErrorContains(t, err, "expected <scxml> root element")
+}
+
+// output of wfx-viewer, shortened and with the action of the immediate transition
+const directMermaid = `stateDiagram-v2
+    [*] --> INSTALL
+    INSTALL --> INSTALLING: CLIENT
+    INSTALL --> TERMINATED: CLIENT
+    INSTALLING --> INSTALLING: CLIENT
+    INSTALLING --> TERMINATED: CLIENT
+    INSTALLING --> INSTALLED: CLIENT
+    INSTALLED --> ACTIVATE: WFX [IMMEDIATE]
+    ACTIVATE --> ACTIVATING: CLIENT
+    ACTIVATE --> TERMINATED: CLIENT
+    ACTIVATING --> ACTIVATING: CLIENT
+    ACTIVATING --> TERMINATED: CLIENT
+    ACTIVATING --> ACTIVATED: CLIENT<br/>12x, p50 3s
+    ACTIVATED --> [*]
+    TERMINATED --> [*]
+    classDef cl_INSTALL color:black,fill:#00cc00
+    class INSTALL cl_INSTALL
+    Note right of INSTALL: <b>Group to Color Mapping</b><br/>
+`
+
+func TestImportMermaid_RoundTrip(t *testing.T) {
+	wf, err := Import(strings.NewReader(directMermaid), FormatMermaid, Options{Name: "wfx.workflow.dau.direct"})
+	require.NoError(t, err)
+	expected := dau.DirectWorkflow()
+	// states are listed in order of appearance
+	assert.ElementsMatch(t, stateNames(expected), stateNames(wf))
+	assert.Equal(t, transitions(expected), transitions(wf))
+	for _, tr := range wf.Transitions[:10] {
+		assert.Empty(t, tr.Description)
+	}
+}
+
+func TestImportMermaid_Composite(t *testing.T) {
+	const diagram = `stateDiagram-v2
+    direction LR
+    %% a comment
+    state "Waiting for the device" as IDLE
+    [*] --> IDLE
+    IDLE --> Update: wfx schedule
+    state Update {
+        [*] --> Download
+        Download --> Install: client done
+        Install: Installing the artifact
+    }
+    Update --> Failed: client error
+    note right of Failed
+        multi-line
+    end note
+    Failed --> [*]
+`
+	wf, err := Import(strings.NewReader(diagram), FormatMermaid, Options{Name: "composite"})
+	require.NoError(t, err)
+	assert.Equal(t, []api.State{
+		{Name: "IDLE", Description: "Waiting for the device"},
+		{Name: "Download"},
+		{Name: "Install", Description: "Installing the artifact"},
+		{Name: "Failed"},
+	}, wf.States)
+	assert.Equal(t, []api.Group{{Name: "Update", States: []string{"Download", "Install"}}}, wf.Groups)
+	assert.Equal(t, []string{
+		"IDLE -> Download WFX",
+		"Download -> Install CLIENT",
+		"Download -> Failed CLIENT",
+		"Install -> Failed CLIENT",
+	}, transitions(wf))
+}
+
+func TestImportMermaid_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		diagram string
+		err     string
+	}{
+		"header":    {diagram: "flowchart TD\nA --> B\n", err: "expected stateDiagram"},
+		"choice":    {diagram: "stateDiagram-v2\nstate check <<choice>>\n", err: "choice states are not supported"},
+		"fork":      {diagram: "stateDiagram-v2\nstate f <<fork>>\n", err: "fork states are not supported"},
+		"regions":   {diagram: "stateDiagram-v2\nstate A {\nB\n--\nC\n}\n", err: "concurrent regions are not supported"},
+		"unclosed":  {diagram: "stateDiagram-v2\nstate A {\nB --> C\n", err: "composite state A is not closed"},
+		"garbage":   {diagram: "stateDiagram-v2\nA -> B -> C\n", err: `line 2: cannot parse`},
+		"no name":   {diagram: "stateDiagram-v2\nA --> B\n", err: "has no name"},
+		"immediate": {diagram: "stateDiagram-v2\nA --> B: immediate\nA --> C: immediate\n", err: "converted workflow is invalid"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := Options{}
+			if name != "no name" {
+				opts.Name = "test"
+			}
+			_, err := Import(strings.NewReader(tc.diagram), FormatMermaid, opts)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+// output of wfx-viewer, with the action of the immediate transition
+const directSMCat = `initial,
+INSTALL [color="#00cc00"],
+INSTALLING [color="#00cc00"],
+INSTALLED [color="#00cc00"],
+ACTIVATE [color="#00cc00"],
+ACTIVATING [color="#00cc00"],
+ACTIVATED [color="#4993dd"],
+TERMINATED [color="#9393dd"],
+final;
+
+initial => INSTALL;
+INSTALL => INSTALLING: CLIENT;
+INSTALL => TERMINATED: CLIENT;
+INSTALLING => INSTALLING: CLIENT;
+INSTALLING => TERMINATED: CLIENT;
+INSTALLING => INSTALLED: CLIENT;
+INSTALLED => ACTIVATE: WFX [IMMEDIATE];
+ACTIVATE => ACTIVATING: CLIENT;
+ACTIVATE => TERMINATED: CLIENT;
+ACTIVATING => ACTIVATING: CLIENT;
+ACTIVATING => TERMINATED: CLIENT;
+ACTIVATING => ACTIVATED: CLIENT;
+ACTIVATED => final;
+TERMINATED => final;
+`
+
+func TestImportSMCat_RoundTrip(t *testing.T) {
+	wf, err := Import(strings.NewReader(directSMCat), FormatSMCat, Options{Name: "wfx.workflow.dau.direct"})
+	require.NoError(t, err)
+	expected := dau.DirectWorkflow()
+	assert.Equal(t, stateNames(expected), stateNames(wf))
+	assert.Equal(t, transitions(expected), transitions(wf))
+}
+
+func TestImportSMCat_Composite(t *testing.T) {
+	const diagram = `
+# comment
+idle: waiting for the device,
+"update job" [label="the update"] {
+  initial => download;
+  download -> install: done [checksum ok];
+  /* block
+     comment */
+  install;
+},
+failed;
+
+idle => "update job": wfx schedule;
+"update job" => failed: error;
+failed <= idle;
+install => final;
+`
+	wf, err := Import(strings.NewReader(diagram), FormatSMCat, Options{Name: "composite"})
+	require.NoError(t, err)
+	assert.Equal(t, []api.State{
+		{Name: "idle", Description: "waiting for the device"},
+		{Name: "download"},
+		{Name: "install"},
+		{Name: "failed"},
+	}, wf.States)
+	assert.Equal(t, []api.Group{{Name: "update job", Description: "the update", States: []string{"download", "install"}}}, wf.Groups)
+	assert.Equal(t, []string{
+		"download -> install CLIENT",
+		"idle -> download WFX",
+		"download -> failed CLIENT",
+		"install -> failed CLIENT",
+		"idle -> failed CLIENT",
+	}, transitions(wf))
+	assert.Equal(t, "done [checksum ok]", wf.Transitions[0].Description)
+}
+
+func TestImportSMCat_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		diagram string
+		err     string
+	}{
+		"choice":   {diagram: "a => ^check;", err: "choice states are not supported"},
+		"fork":     {diagram: "a => ]fork;", err: "fork and join states are not supported"},
+		"history":  {diagram: "a { history; };", err: "history states are not supported"},
+		"unclosed": {diagram: "a { b;", err: "composite state a is not closed"},
+		"string":   {diagram: `"a => b;`, err: "unterminated string"},
+		"unknown":  {diagram: "a => b;\n{", err: "line 2: expected a state name"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Import(strings.NewReader(tc.diagram), FormatSMCat, Options{Name: "test"})
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestImport_InvalidName(t *testing.T) {
+	_, err := Import(strings.NewReader(directSMCat), FormatSMCat, Options{Name: "no spaces"})
+	assert.ErrorContains(t, err, `invalid workflow name "no spaces"`)
+	_, err = Import(strings.NewReader(directSMCat), "dot", Options{Name: "test"})
+	assert.ErrorContains(t, err, `unsupported format "dot"`)
+}
+
+func TestLoadConvention(t *testing.T) {
+	conv, err := LoadConvention(strings.NewReader(`
+rules:
+  - match: '^(auto|timeout)'
+    eligible: WFX
+    action: IMMEDIATE
+  - match: '^schedule'
+    eligible: WFX
+default: CLIENT
+`))
+	require.NoError(t, err)
+
+	eligible, action := conv.Classify("timeout [after 5m]")
+	assert.Equal(t, api.WFX, eligible)
+	require.NotNil(t, action)
+	assert.Equal(t, api.IMMEDIATE, *action)
+
+	eligible, action = conv.Classify("schedule")
+	assert.Equal(t, api.WFX, eligible)
+	assert.Nil(t, action)
+
+	eligible, _ = conv.Classify("progress")
+	assert.Equal(t, api.CLIENT, eligible)
+
+	_, err = LoadConvention(strings.NewReader("rules: [{match: '(', eligible: WFX}]"))
+	assert.ErrorContains(t, err, "rule 1")
+	_, err = LoadConvention(strings.NewReader("rules: [{match: 'x', eligible: DEVICE}]"))
+	assert.ErrorContains(t, err, `invalid actor "DEVICE"`)
+	_, err = LoadConvention(strings.NewReader("rules: [{match: 'x', eligible: WFX, action: LATER}]"))
+	assert.ErrorContains(t, err, `invalid action "LATER"`)
+	_, err = LoadConvention(strings.NewReader("default: DEVICE"))
+	assert.ErrorContains(t, err, `invalid default actor "DEVICE"`)
+}
+
+func TestDetectFormat(t *testing.T) {
+	format, ok := DetectFormat("legacy/updater.SCXML")
+	assert.True(t, ok)
+	assert.Equal(t, FormatSCXML, format)
+	format, ok = DetectFormat("diagram.mmd")
+	assert.True(t, ok)
+	assert.Equal(t, FormatMermaid, format)
+	_, ok = DetectFormat("workflow.yml")
+	assert.False(t, ok)
+}
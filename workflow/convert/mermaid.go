@@ -0,0 +1,146 @@
+package convert
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/Southclaws/fault"
+)
+
+const mermaidMarker = "[*]"
+
+var (
+	mermaidTransition = regexp.MustCompile(`^(\S+)\s*-->\s*(\S+)\s*(?::(.*))?$`)
+	// state "description" as ID {
+	mermaidStateAs = regexp.MustCompile(`^state\s+"([^"]*)"\s+as\s+(\S+?)\s*(\{)?$`)
+	// state ID {
+	mermaidState = regexp.MustCompile(`^state\s+(\S+?)\s*(<<\w+>>)?\s*(\{)?$`)
+	// ID : description
+	mermaidDescription = regexp.MustCompile(`^([^\s:]+)\s*:(.*)$`)
+	mermaidID          = regexp.MustCompile(`^[^\s:{}"]+$`)
+)
+
+func parseMermaid(r io.Reader) (*model, error) {
+	m := newModel()
+	var stack []*state
+	parent := func() *state {
+		if len(stack) == 0 {
+			return nil
+		}
+		return stack[len(stack)-1]
+	}
+	// add returns the state with the given ID (which may have a :::class suffix)
+	add := func(id string) *state {
+		id, _, _ = strings.Cut(id, ":::")
+		return m.state(id, parent())
+	}
+
+	scanner := bufio.NewScanner(r)
+	header := false
+	inNote := false
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "%%") {
+			continue
+		}
+		if !header {
+			if line != "stateDiagram" && line != "stateDiagram-v2" {
+				return nil, fmt.Errorf("line %d: expected stateDiagram, found %q", lineNo, line)
+			}
+			header = true
+			continue
+		}
+		lower := strings.ToLower(line)
+		switch {
+		case inNote:
+			inNote = lower != "end note"
+			continue
+		case strings.HasPrefix(lower, "note "):
+			// single-line notes contain a colon
+			inNote = !strings.Contains(line, ":")
+			continue
+		case strings.HasPrefix(line, "direction "), strings.HasPrefix(line, "classDef "),
+			strings.HasPrefix(line, "class "), strings.HasPrefix(line, "style "),
+			strings.HasPrefix(line, "title "), strings.HasPrefix(line, "accTitle"), strings.HasPrefix(line, "accDescr"):
+			continue
+		case line == "--":
+			return nil, unsupported("concurrent regions")
+		case line == "}":
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: unexpected }", lineNo)
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		if match := mermaidTransition.FindStringSubmatch(line); match != nil {
+			from, to, label := match[1], match[2], match[3]
+			switch {
+			case from == mermaidMarker && to == mermaidMarker:
+			case from == mermaidMarker:
+				// start of the diagram or of a composite state
+				s := add(to)
+				if p := parent(); p != nil && p.initial == "" {
+					p.initial = s.id
+				}
+			case to == mermaidMarker:
+				add(from)
+			default:
+				m.addTransition(add(from).id, add(to).id, label)
+			}
+			continue
+		}
+		if match := mermaidStateAs.FindStringSubmatch(line); match != nil {
+			s := add(match[2])
+			s.description = match[1]
+			if match[3] != "" {
+				stack = append(stack, s)
+			}
+			continue
+		}
+		if match := mermaidState.FindStringSubmatch(line); match != nil {
+			if match[2] != "" {
+				return nil, unsupported(strings.Trim(match[2], "<>") + " states")
+			}
+			s := add(match[1])
+			if match[3] != "" {
+				stack = append(stack, s)
+			}
+			continue
+		}
+		if match := mermaidDescription.FindStringSubmatch(line); match != nil {
+			s := add(match[1])
+			s.description = strings.TrimSpace(match[2])
+			continue
+		}
+		if mermaidID.MatchString(line) {
+			add(line)
+			continue
+		}
+		return nil, fmt.Errorf("line %d: cannot parse %q", lineNo, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if !header {
+		return nil, errors.New("expected stateDiagram")
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("composite state %s is not closed", parent().id)
+	}
+	return m, nil
+}
@@ -0,0 +1,106 @@
+package convert
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Southclaws/fault"
+)
+
+// scxmlElement is any element of an SCXML document; children are kept in document order.
+type scxmlElement struct {
+	XMLName  xml.Name
+	ID       string         `xml:"id,attr"`
+	Name     string         `xml:"name,attr"`
+	Initial  string         `xml:"initial,attr"`
+	Event    string         `xml:"event,attr"`
+	Cond     string         `xml:"cond,attr"`
+	Target   string         `xml:"target,attr"`
+	Children []scxmlElement `xml:",any"`
+}
+
+func parseSCXML(r io.Reader) (*model, error) {
+	var root scxmlElement
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if root.XMLName.Local != "scxml" {
+		return nil, fmt.Errorf("expected <scxml> root element, found <%s>", root.XMLName.Local)
+	}
+	m := newModel()
+	m.name = root.Name
+	if err := m.scxmlStates(&root, nil); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	if len(m.states) == 0 {
+		return nil, errors.New("no states found")
+	}
+	return m, nil
+}
+
+// scxmlStates adds the states and transitions within the element.
+func (m *model) scxmlStates(elem *scxmlElement, parent *state) error {
+	if parent != nil {
+		parent.initial = strings.TrimSpace(elem.Initial)
+	}
+	for i := range elem.Children {
+		child := &elem.Children[i]
+		switch child.XMLName.Local {
+		case "state", "final":
+			if child.ID == "" {
+				return fmt.Errorf("<%s> without id", child.XMLName.Local)
+			}
+			if _, exists := m.byID[child.ID]; exists {
+				return fmt.Errorf("duplicate state %s", child.ID)
+			}
+			s := m.state(child.ID, parent)
+			if err := m.scxmlStates(child, s); err != nil {
+				return fault.Wrap(err)
+			}
+		case "initial":
+			// <initial><transition target="..."/></initial>
+			if parent == nil {
+				continue
+			}
+			for _, t := range child.Children {
+				if t.XMLName.Local == "transition" {
+					parent.initial = strings.TrimSpace(t.Target)
+				}
+			}
+		case "transition":
+			if parent == nil {
+				continue
+			}
+			targets := strings.Fields(child.Target)
+			switch len(targets) {
+			case 0:
+				// targetless transitions do not change the state
+				continue
+			case 1:
+			default:
+				return unsupported("transitions with multiple targets")
+			}
+			label := child.Event
+			if child.Cond != "" {
+				label += " [" + child.Cond + "]"
+			}
+			m.addTransition(parent.id, targets[0], label)
+		case "parallel":
+			return unsupported("parallel states")
+		case "history":
+			return unsupported("history states")
+		}
+	}
+	return nil
+}
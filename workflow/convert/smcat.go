@@ -0,0 +1,335 @@
+package convert
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Southclaws/fault"
+)
+
+// smcat pseudo states are recognized by their name
+const (
+	smcatInitial = "initial"
+	smcatFinal   = "final"
+)
+
+func parseSMCat(r io.Reader) (*model, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	p := &smcatParser{src: []rune(string(raw)), line: 1, m: newModel()}
+	if err := p.statements(nil); err != nil {
+		return nil, fmt.Errorf("line %d: %w", p.line, err)
+	}
+	if !p.eof() {
+		return nil, fmt.Errorf("line %d: unexpected %q", p.line, p.peek())
+	}
+	if len(p.m.states) == 0 {
+		return nil, errors.New("no states found")
+	}
+	return p.m, nil
+}
+
+type smcatParser struct {
+	src  []rune
+	pos  int
+	line int
+	m    *model
+}
+
+func (p *smcatParser) eof() bool {
+	p.skip()
+	return p.pos >= len(p.src)
+}
+
+func (p *smcatParser) peek() rune {
+	p.skip()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *smcatParser) next() rune {
+	r := p.peek()
+	if r != 0 {
+		p.advance()
+	}
+	return r
+}
+
+func (p *smcatParser) advance() {
+	if p.src[p.pos] == '\n' {
+		p.line++
+	}
+	p.pos++
+}
+
+func (p *smcatParser) hasPrefix(s string) bool {
+	p.skip()
+	return strings.HasPrefix(string(p.src[p.pos:min(p.pos+len(s), len(p.src))]), s)
+}
+
+// skip skips whitespace and comments.
+func (p *smcatParser) skip() {
+	for p.pos < len(p.src) {
+		switch {
+		case p.src[p.pos] == ' ', p.src[p.pos] == '\t', p.src[p.pos] == '\r', p.src[p.pos] == '\n':
+			p.advance()
+		case p.src[p.pos] == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.advance()
+			}
+		case p.src[p.pos] == '/' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '*':
+			for p.pos < len(p.src) && !(p.src[p.pos] == '*' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '/') {
+				p.advance()
+			}
+			p.pos = min(p.pos+2, len(p.src))
+		default:
+			return
+		}
+	}
+}
+
+// statements parses a list of states and transitions, up to the end of input or a closing brace.
+func (p *smcatParser) statements(parent *state) error {
+	for !p.eof() && p.peek() != '}' {
+		if err := p.statement(parent); err != nil {
+			return err
+		}
+		switch p.peek() {
+		case ',', ';':
+			p.next()
+		case '}', 0:
+		default:
+			return fmt.Errorf("expected , or ; but found %q", p.peek())
+		}
+	}
+	return nil
+}
+
+var smcatArrows = []struct {
+	token   string
+	reverse bool
+}{
+	{token: "=>"}, {token: "->"}, {token: "<=", reverse: true}, {token: "<-", reverse: true},
+}
+
+func (p *smcatParser) statement(parent *state) error {
+	from, err := p.name()
+	if err != nil {
+		return err
+	}
+	for _, arrow := range smcatArrows {
+		if !p.hasPrefix(arrow.token) {
+			continue
+		}
+		p.skip()
+		p.pos += len(arrow.token)
+		to, err := p.name()
+		if err != nil {
+			return err
+		}
+		if _, err := p.attributes(); err != nil {
+			return err
+		}
+		label := ""
+		if p.peek() == ':' {
+			p.next()
+			label = p.text(";")
+		}
+		if arrow.reverse {
+			from, to = to, from
+		}
+		return p.transition(parent, from, to, label)
+	}
+
+	// state declaration
+	attrs, err := p.attributes()
+	if err != nil {
+		return err
+	}
+	if err := checkSMCatName(from, attrs["type"]); err != nil {
+		return err
+	}
+	if isSMCatPseudo(from, attrs["type"]) {
+		if p.peek() == ':' {
+			p.next()
+			p.text(",;")
+		}
+		return nil
+	}
+	s := p.m.state(from, parent)
+	if label := attrs["label"]; label != "" {
+		s.description = label
+	}
+	if p.peek() == ':' {
+		p.next()
+		if activities := p.text(",;{"); activities != "" {
+			s.description = activities
+		}
+	}
+	if p.peek() == '{' {
+		p.next()
+		if err := p.statements(s); err != nil {
+			return err
+		}
+		if p.next() != '}' {
+			return fmt.Errorf("composite state %s is not closed", from)
+		}
+	}
+	return nil
+}
+
+func (p *smcatParser) transition(parent *state, from, to, label string) error {
+	for _, name := range []string{from, to} {
+		if err := checkSMCatName(name, ""); err != nil {
+			return err
+		}
+	}
+	switch {
+	case isSMCatPseudo(from, "") && isSMCatPseudo(to, ""):
+	case isSMCatPseudo(from, ""):
+		s := p.m.state(to, parent)
+		if parent != nil && parent.initial == "" {
+			parent.initial = s.id
+		}
+	case isSMCatPseudo(to, ""):
+		p.m.state(from, parent)
+	default:
+		p.m.addTransition(p.m.state(from, parent).id, p.m.state(to, parent).id, label)
+	}
+	return nil
+}
+
+func isSMCatPseudo(name, typ string) bool {
+	return typ == smcatInitial || typ == smcatFinal || name == smcatInitial || name == smcatFinal
+}
+
+// checkSMCatName rejects the pseudo states which have no equivalent in wfx.
+func checkSMCatName(name, typ string) error {
+	switch {
+	case strings.HasPrefix(name, "^") || typ == "choice":
+		return unsupported("choice states")
+	case strings.HasPrefix(name, "]") || typ == "fork" || typ == "join" || typ == "forkjoin":
+		return unsupported("fork and join states")
+	case strings.Contains(name, "history") || strings.Contains(typ, "history"):
+		return unsupported("history states")
+	case typ == "parallel":
+		return unsupported("parallel states")
+	}
+	return nil
+}
+
+// name parses a (possibly quoted) state name.
+func (p *smcatParser) name() (string, error) {
+	if p.peek() == '"' {
+		return p.quoted()
+	}
+	start := p.pos
+	if p.src[p.pos] == ']' {
+		// fork and join states are prefixed with ]
+		p.advance()
+	}
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		if strings.ContainsRune(" \t\r\n,;{}[]:=\"", r) || p.arrowAhead() {
+			break
+		}
+		p.advance()
+	}
+	if p.pos == start {
+		if p.pos >= len(p.src) {
+			return "", errors.New("unexpected end of input")
+		}
+		return "", fmt.Errorf("expected a state name but found %q", p.src[p.pos])
+	}
+	return string(p.src[start:p.pos]), nil
+}
+
+func (p *smcatParser) arrowAhead() bool {
+	rest := string(p.src[p.pos:min(p.pos+2, len(p.src))])
+	for _, arrow := range smcatArrows {
+		if rest == arrow.token {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *smcatParser) quoted() (string, error) {
+	p.next()
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		p.advance()
+		switch r {
+		case '\\':
+			if p.pos < len(p.src) {
+				b.WriteRune(p.src[p.pos])
+				p.advance()
+			}
+		case '"':
+			return b.String(), nil
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return "", errors.New("unterminated string")
+}
+
+// attributes parses an optional attribute list such as [color="red" label="foo"].
+func (p *smcatParser) attributes() (map[string]string, error) {
+	attrs := map[string]string{}
+	if p.peek() != '[' {
+		return attrs, nil
+	}
+	p.next()
+	for p.peek() != ']' {
+		key, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != '=' {
+			return nil, fmt.Errorf("expected = after attribute %s", key)
+		}
+		var value string
+		if p.peek() == '"' {
+			value, err = p.quoted()
+		} else {
+			value, err = p.name()
+		}
+		if err != nil {
+			return nil, err
+		}
+		attrs[key] = value
+	}
+	p.next()
+	return attrs, nil
+}
+
+// text returns the raw text up to (not including) one of the terminators.
+func (p *smcatParser) text(terminators string) string {
+	p.skip()
+	if p.peek() == '"' {
+		if s, err := p.quoted(); err == nil {
+			return s
+		}
+	}
+	start := p.pos
+	for p.pos < len(p.src) && !strings.ContainsRune(terminators, p.src[p.pos]) {
+		p.advance()
+	}
+	return strings.TrimSpace(string(p.src[start:p.pos]))
+}
@@ -0,0 +1,318 @@
+package workflow
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+// Severity of a lint finding. The values correspond to the SARIF result levels.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+)
+
+// Rank orders severities, higher values are more severe.
+func (s Severity) Rank() int {
+	switch s {
+	case SeverityError:
+		return 3
+	case SeverityWarning:
+		return 2
+	case SeverityNote:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Rule is a check performed by Lint.
+type Rule struct {
+	ID          string
+	Severity    Severity
+	Description string
+}
+
+const (
+	RuleInvalidWorkflow       = "invalid-workflow"
+	RuleUnreachableState      = "unreachable-state"
+	RuleUngroupedState        = "ungrouped-state"
+	RuleFinalStateNotTerminal = "final-state-not-terminal"
+	RuleClientDeadEnd         = "client-dead-end"
+	RuleWaitSingleActor       = "wait-state-single-actor"
+	RuleMissingDescription    = "missing-description"
+)
+
+// Rules lists all rules checked by Lint.
+var Rules = []Rule{
+	{
+		ID:          RuleInvalidWorkflow,
+		Severity:    SeverityError,
+		Description: "The workflow is rejected by wfx (see ValidateWorkflow).",
+	},
+	{
+		ID:          RuleUnreachableState,
+		Severity:    SeverityWarning,
+		Description: "The state cannot be reached from the initial state.",
+	},
+	{
+		ID:          RuleUngroupedState,
+		Severity:    SeverityWarning,
+		Description: "The workflow uses groups, but the state does not belong to any group, so jobs in this state are missed by group queries.",
+	},
+	{
+		ID:          RuleFinalStateNotTerminal,
+		Severity:    SeverityWarning,
+		Description: "The final state belongs to a group which also contains non-final states, so finished jobs cannot be told apart from running ones by group.",
+	},
+	{
+		ID:          RuleClientDeadEnd,
+		Severity:    SeverityWarning,
+		Description: "The non-final state can only be left by the client; if the client disappears, the job is stuck since there is no WFX transition to abort it.",
+	},
+	{
+		ID:          RuleWaitSingleActor,
+		Severity:    SeverityWarning,
+		Description: "The state waits for WFX, but has no CLIENT transition, so the client cannot report problems (e.g. failures) while waiting.",
+	},
+	{
+		ID:          RuleMissingDescription,
+		Severity:    SeverityNote,
+		Description: "The workflow, state, transition or group has no description.",
+	},
+}
+
+// FindRule returns the rule with the given ID or nil if there is no such rule.
+func FindRule(id string) *Rule {
+	for i := range Rules {
+		if Rules[i].ID == id {
+			return &Rules[i]
+		}
+	}
+	return nil
+}
+
+// Finding is a problem detected by Lint.
+type Finding struct {
+	RuleID   string   `json:"ruleId"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	// Subject identifies the affected element: a state or group name, "FROM -> TO" for transitions, or empty for
+	// the workflow itself.
+	Subject string `json:"subject,omitempty"`
+	// Path locates the affected element within the workflow document, e.g. "$.states[2]".
+	Path string `json:"path"`
+}
+
+// Suppression silences findings of a rule, either for all elements or only for the given subject.
+type Suppression struct {
+	RuleID  string
+	Subject string
+}
+
+// ParseSuppression parses a suppression of the form "rule" or "rule:subject".
+func ParseSuppression(s string) (Suppression, error) {
+	id, subject, _ := strings.Cut(s, ":")
+	if FindRule(id) == nil {
+		return Suppression{}, fmt.Errorf("unknown rule %q", id)
+	}
+	return Suppression{RuleID: id, Subject: subject}, nil
+}
+
+func (s Suppression) matches(f Finding) bool {
+	return s.RuleID == f.RuleID && (s.Subject == "" || s.Subject == f.Subject)
+}
+
+type LintOptions struct {
+	Suppressions []Suppression
+}
+
+// Lint checks the workflow for problems which are accepted by ValidateWorkflow, but are likely mistakes. Invalid
+// workflows are reported by the rule RuleInvalidWorkflow; the remaining rules are checked nonetheless.
+// The findings are ordered by their position in the workflow document.
+func Lint(workflow *api.Workflow, opts LintOptions) []Finding {
+	l := newLinter(workflow)
+	if err := ValidateWorkflow(workflow); err != nil {
+		l.report(RuleInvalidWorkflow, "", "$.name", "workflow is invalid: %s", err)
+	}
+	l.checkStates()
+	l.checkTransitions()
+	l.checkGroups()
+
+	result := make([]Finding, 0, len(l.findings))
+	for _, f := range l.findings {
+		if !slices.ContainsFunc(opts.Suppressions, func(s Suppression) bool { return s.matches(f) }) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+type linter struct {
+	workflow *api.Workflow
+	findings []Finding
+	// outgoing non-trivial transitions per state
+	outgoing map[string][]api.Transition
+	// group name per state
+	groups map[string]string
+}
+
+func newLinter(workflow *api.Workflow) *linter {
+	l := &linter{
+		workflow: workflow,
+		outgoing: make(map[string][]api.Transition, len(workflow.States)),
+		groups:   make(map[string]string, len(workflow.States)),
+	}
+	for _, t := range workflow.Transitions {
+		if t.From != t.To {
+			l.outgoing[t.From] = append(l.outgoing[t.From], t)
+		}
+	}
+	for _, g := range workflow.Groups {
+		for _, s := range g.States {
+			l.groups[s] = g.Name
+		}
+	}
+	return l
+}
+
+func (l *linter) report(ruleID string, subject string, path string, format string, args ...any) {
+	l.findings = append(l.findings, Finding{
+		RuleID:   ruleID,
+		Severity: FindRule(ruleID).Severity,
+		Message:  fmt.Sprintf(format, args...),
+		Subject:  subject,
+		Path:     path,
+	})
+}
+
+func (l *linter) isFinal(state string) bool {
+	return len(l.outgoing[state]) == 0
+}
+
+func (l *linter) checkStates() {
+	if l.workflow.Description == "" {
+		l.report(RuleMissingDescription, "", "$.name", "workflow %s has no description", l.workflow.Name)
+	}
+
+	reachable := l.reachableStates()
+	for i, s := range l.workflow.States {
+		path := fmt.Sprintf("$.states[%d]", i)
+		if reachable != nil && !reachable[s.Name] {
+			l.report(RuleUnreachableState, s.Name, path, "state %s is not reachable from the initial state", s.Name)
+		}
+
+		group, grouped := l.groups[s.Name]
+		if len(l.workflow.Groups) > 0 && !grouped {
+			l.report(RuleUngroupedState, s.Name, path, "state %s does not belong to any group", s.Name)
+		}
+
+		if l.isFinal(s.Name) {
+			if grouped && !l.isTerminalGroup(group) {
+				l.report(RuleFinalStateNotTerminal, s.Name, path,
+					"final state %s belongs to group %s, which also contains non-final states", s.Name, group)
+			}
+		} else {
+			l.checkActors(s.Name, path)
+		}
+
+		if s.Description == "" {
+			l.report(RuleMissingDescription, s.Name, path, "state %s has no description", s.Name)
+		}
+	}
+}
+
+func (l *linter) checkActors(state string, path string) {
+	var client, wfx, wait bool
+	for _, t := range l.outgoing[state] {
+		switch t.Eligible {
+		case api.CLIENT:
+			client = true
+		case api.WFX:
+			wfx = true
+			if t.Action == nil || *t.Action == api.WAIT {
+				wait = true
+			}
+		}
+	}
+	if client && !wfx {
+		l.report(RuleClientDeadEnd, state, path, "state %s can only be left by the client (no WFX transition)", state)
+	}
+	if wait && !client {
+		l.report(RuleWaitSingleActor, state, path, "state %s waits for WFX, but has no CLIENT transition", state)
+	}
+}
+
+func (l *linter) checkTransitions() {
+	for i, t := range l.workflow.Transitions {
+		if t.Description == "" {
+			subject := fmt.Sprintf("%s -> %s", t.From, t.To)
+			l.report(RuleMissingDescription, subject, fmt.Sprintf("$.transitions[%d]", i), "transition %s has no description", subject)
+		}
+	}
+}
+
+func (l *linter) checkGroups() {
+	for i, g := range l.workflow.Groups {
+		if g.Description == "" {
+			l.report(RuleMissingDescription, g.Name, fmt.Sprintf("$.groups[%d]", i), "group %s has no description", g.Name)
+		}
+	}
+}
+
+func (l *linter) isTerminalGroup(name string) bool {
+	for _, g := range l.workflow.Groups {
+		if g.Name == name {
+			return !slices.ContainsFunc(g.States, func(s string) bool { return !l.isFinal(s) })
+		}
+	}
+	return false
+}
+
+// reachableStates returns the states which are reachable from the initial state, i.e. the state without incoming
+// transitions. It returns nil if there is no unique initial state.
+func (l *linter) reachableStates() map[string]bool {
+	incoming := make(map[string]bool, len(l.workflow.States))
+	for _, transitions := range l.outgoing {
+		for _, t := range transitions {
+			incoming[t.To] = true
+		}
+	}
+	var queue []string
+	for _, s := range l.workflow.States {
+		if !incoming[s.Name] {
+			queue = append(queue, s.Name)
+		}
+	}
+	// without a unique initial state, reachability is meaningless (and the workflow is invalid anyway)
+	if len(queue) != 1 {
+		return nil
+	}
+
+	reachable := make(map[string]bool, len(l.workflow.States))
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		if reachable[state] {
+			continue
+		}
+		reachable[state] = true
+		for _, t := range l.outgoing[state] {
+			queue = append(queue, t.To)
+		}
+	}
+	return reachable
+}
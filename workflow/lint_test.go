@@ -0,0 +1,137 @@
+package workflow
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+)
+
+func ruleIDs(findings []Finding) []string {
+	result := make([]string, 0, len(findings))
+	for _, f := range findings {
+		result = append(result, f.RuleID+":"+f.Subject)
+	}
+	return result
+}
+
+func TestLint_Dau(t *testing.T) {
+	findings := Lint(dau.DirectWorkflow(), LintOptions{})
+	assert.Equal(t, []string{
+		"client-dead-end:INSTALL",
+		"client-dead-end:INSTALLING",
+		"client-dead-end:ACTIVATE",
+		"client-dead-end:ACTIVATING",
+	}, ruleIDs(findings))
+	assert.Equal(t, "$.states[0]", findings[0].Path)
+	assert.Equal(t, SeverityWarning, findings[0].Severity)
+}
+
+func TestLint(t *testing.T) {
+	wait := api.WAIT
+	wf := &api.Workflow{
+		Name: name,
+		States: []api.State{
+			{Name: "NEW", Description: "new"},
+			{Name: "REVIEW", Description: "review"},
+			{Name: "DONE"},
+			{Name: "FAILED", Description: "failed"},
+		},
+		Transitions: []api.Transition{
+			{From: "NEW", To: "REVIEW", Eligible: api.CLIENT, Description: "submit"},
+			{From: "REVIEW", To: "DONE", Eligible: api.WFX, Action: &wait, Description: "approve"},
+			{From: "NEW", To: "FAILED", Eligible: api.CLIENT},
+		},
+		Groups: []api.Group{
+			{Name: "OPEN", States: []string{"NEW", "REVIEW", "FAILED"}, Description: "open"},
+		},
+	}
+
+	findings := Lint(wf, LintOptions{})
+	assert.Equal(t, []string{
+		"missing-description:",
+		"client-dead-end:NEW",
+		"wait-state-single-actor:REVIEW",
+		"ungrouped-state:DONE",
+		"missing-description:DONE",
+		"final-state-not-terminal:FAILED",
+		"missing-description:NEW -> FAILED",
+	}, ruleIDs(findings))
+	assert.Equal(t, "$.transitions[2]", findings[6].Path)
+	assert.Equal(t, SeverityNote, findings[6].Severity)
+}
+
+func TestLint_Invalid(t *testing.T) {
+	wf := &api.Workflow{
+		Name:        name,
+		Description: "invalid",
+		States: []api.State{
+			{Name: state1, Description: "1"},
+			{Name: state2, Description: "2"},
+			{Name: state3, Description: "3"},
+		},
+		Transitions: []api.Transition{
+			{From: state1, To: state2, Eligible: api.WFX, Description: "1 -> 2"},
+			{From: state2, To: state3, Eligible: api.WFX, Description: "2 -> 3"},
+			{From: state3, To: state2, Eligible: api.WFX, Description: "3 -> 2"},
+		},
+	}
+	findings := Lint(wf, LintOptions{})
+	require.NotEmpty(t, findings)
+	assert.Equal(t, RuleInvalidWorkflow, findings[0].RuleID)
+	assert.Equal(t, SeverityError, findings[0].Severity)
+}
+
+func TestLint_Unreachable(t *testing.T) {
+	wf := &api.Workflow{
+		Name:        name,
+		Description: "unreachable",
+		States: []api.State{
+			{Name: state1, Description: "1"},
+			{Name: state2, Description: "2"},
+			{Name: state3, Description: "3"},
+			{Name: state4, Description: "4"},
+		},
+		Transitions: []api.Transition{
+			{From: state1, To: state2, Eligible: api.WFX, Description: "1 -> 2"},
+			{From: state3, To: state4, Eligible: api.WFX, Description: "3 -> 4"},
+			{From: state4, To: state3, Eligible: api.WFX, Description: "4 -> 3"},
+		},
+	}
+	findings := Lint(wf, LintOptions{Suppressions: []Suppression{{RuleID: RuleInvalidWorkflow}, {RuleID: RuleWaitSingleActor}}})
+	assert.Equal(t, []string{"unreachable-state:state3", "unreachable-state:state4"}, ruleIDs(findings))
+}
+
+func TestLint_Suppressions(t *testing.T) {
+	all := Lint(dau.DirectWorkflow(), LintOptions{})
+
+	s, err := ParseSuppression("client-dead-end:INSTALL")
+	require.NoError(t, err)
+	findings := Lint(dau.DirectWorkflow(), LintOptions{Suppressions: []Suppression{s}})
+	assert.Len(t, findings, len(all)-1)
+
+	s, err = ParseSuppression("client-dead-end")
+	require.NoError(t, err)
+	findings = Lint(dau.DirectWorkflow(), LintOptions{Suppressions: []Suppression{s}})
+	assert.Empty(t, findings)
+
+	_, err = ParseSuppression("foo")
+	assert.ErrorContains(t, err, "unknown rule")
+}
+
+func TestSeverity_Rank(t *testing.T) {
+	assert.Greater(t, SeverityError.Rank(), SeverityWarning.Rank())
+	assert.Greater(t, SeverityWarning.Rank(), SeverityNote.Rank())
+	assert.Greater(t, SeverityNote.Rank(), Severity("").Rank())
+}
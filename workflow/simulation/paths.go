@@ -0,0 +1,164 @@
+package simulation
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siemens/wfx/generated/api"
+	internalWorkflow "github.com/siemens/wfx/internal/workflow"
+	"github.com/siemens/wfx/workflow"
+)
+
+// ErrTooManyPaths is returned by Paths if the workflow has more paths than the given limit.
+var ErrTooManyPaths = errors.New("too many paths")
+
+// Hop is a transition taken on a path.
+type Hop struct {
+	From  string           `json:"from"`
+	To    string           `json:"to"`
+	Actor api.EligibleEnum `json:"actor"`
+	// Immediate indicates that wfx takes the transition automatically, i.e. no status update is needed.
+	Immediate bool `json:"immediate,omitempty"`
+}
+
+// Path is a sequence of transitions leading from the initial state to a state which cannot be left.
+// Self-loops (progress updates) are included once for each state in which the job rests.
+type Path struct {
+	Hops []Hop `json:"hops"`
+}
+
+// States returns the states visited on the path, without repetitions caused by self-loops.
+func (p Path) States() []string {
+	var result []string
+	for _, hop := range p.Hops {
+		if len(result) == 0 {
+			result = append(result, hop.From)
+		}
+		if hop.To != result[len(result)-1] {
+			result = append(result, hop.To)
+		}
+	}
+	return result
+}
+
+// Actors returns the actors involved in the path, in order of their first appearance.
+func (p Path) Actors() []api.EligibleEnum {
+	var result []api.EligibleEnum
+	for _, hop := range p.Hops {
+		if !slices.Contains(result, hop.Actor) {
+			result = append(result, hop.Actor)
+		}
+	}
+	return result
+}
+
+// String formats the path like "INSTALL -[CLIENT]-> INSTALLING -[WFX, immediate]-> ...".
+func (p Path) String() string {
+	if len(p.Hops) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(p.Hops[0].From)
+	for _, hop := range p.Hops {
+		sb.WriteString(" -[")
+		sb.WriteString(string(hop.Actor))
+		if hop.Immediate {
+			sb.WriteString(", immediate")
+		}
+		sb.WriteString("]-> ")
+		sb.WriteString(hop.To)
+	}
+	return sb.String()
+}
+
+// Script converts the path into a script containing a status update for each transition which is not taken
+// automatically by wfx. Each step expects the state in which the job rests after the update.
+func (p Path) Script(name string) *Script {
+	script := &Script{Name: name}
+	for _, hop := range p.Hops {
+		if hop.Immediate {
+			if n := len(script.Steps); n > 0 {
+				script.Steps[n-1].Expect = hop.To
+			}
+			continue
+		}
+		script.Steps = append(script.Steps, Step{Actor: hop.Actor, State: hop.To, Expect: hop.To})
+	}
+	return script
+}
+
+// Paths enumerates all paths of the workflow, starting at the initial state. Since jobs never rest in a state which
+// has an immediate transition, paths always follow immediate transitions. If limit is positive and the workflow has
+// more paths, ErrTooManyPaths is returned.
+func Paths(wf *api.Workflow, limit int) ([]Path, error) {
+	if err := workflow.ValidateWorkflow(wf); err != nil {
+		return nil, fmt.Errorf("invalid workflow: %w", err)
+	}
+	initial := internalWorkflow.FindInitialState(wf)
+	if initial == nil {
+		return nil, errors.New("workflow has no initial state")
+	}
+
+	e := enumerator{workflow: wf, limit: limit}
+	state, hops := e.follow(*initial, nil)
+	if err := e.visit(state, hops); err != nil {
+		return nil, err
+	}
+	return e.paths, nil
+}
+
+type enumerator struct {
+	workflow *api.Workflow
+	limit    int
+	paths    []Path
+}
+
+// visit extends the path by each transition leaving the state in which the job rests.
+func (e *enumerator) visit(state string, hops []Hop) error {
+	var next []api.Transition
+	for _, t := range e.workflow.Transitions {
+		if t.From != state {
+			continue
+		}
+		if t.To == state {
+			hops = append(hops, Hop{From: state, To: state, Actor: t.Eligible})
+			continue
+		}
+		next = append(next, t)
+	}
+	if len(next) == 0 {
+		if e.limit > 0 && len(e.paths) == e.limit {
+			return fmt.Errorf("%w: more than %d", ErrTooManyPaths, e.limit)
+		}
+		e.paths = append(e.paths, Path{Hops: slices.Clone(hops)})
+		return nil
+	}
+	for _, t := range next {
+		// clip to ensure that the sibling paths do not share the backing array
+		extended := append(slices.Clip(hops), Hop{From: t.From, To: t.To, Actor: t.Eligible})
+		to, extended := e.follow(t.To, extended)
+		if err := e.visit(to, extended); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// follow appends the immediate transitions starting at the given state.
+func (e *enumerator) follow(from string, hops []Hop) (string, []Hop) {
+	path := internalWorkflow.ImmediatePath(e.workflow, from)
+	for i := 1; i < len(path); i++ {
+		hops = append(hops, Hop{From: path[i-1], To: path[i], Actor: api.WFX, Immediate: true})
+	}
+	return path[len(path)-1], hops
+}
@@ -0,0 +1,64 @@
+package simulation
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+)
+
+func TestPaths(t *testing.T) {
+	paths, err := Paths(dau.DirectWorkflow(), 0)
+	require.NoError(t, err)
+	require.Len(t, paths, 5)
+
+	last := paths[len(paths)-1]
+	assert.Equal(t, "INSTALL -[CLIENT]-> TERMINATED", last.String())
+	assert.Equal(t, []string{"INSTALL", "TERMINATED"}, last.States())
+
+	p := paths[2]
+	assert.Equal(t, "INSTALL -[CLIENT]-> INSTALLING -[CLIENT]-> INSTALLING -[CLIENT]-> INSTALLED -[WFX, immediate]-> ACTIVATE -[CLIENT]-> ACTIVATING -[CLIENT]-> ACTIVATING -[CLIENT]-> ACTIVATED", p.String())
+	assert.Equal(t, []string{"INSTALL", "INSTALLING", "INSTALLED", "ACTIVATE", "ACTIVATING", "ACTIVATED"}, p.States())
+	assert.Equal(t, []api.EligibleEnum{api.CLIENT, api.WFX}, p.Actors())
+
+	script := p.Script("happy path")
+	assert.Equal(t, "happy path", script.Name)
+	assert.Equal(t, Step{Actor: api.CLIENT, State: "INSTALLED", Expect: "ACTIVATE"}, script.Steps[2])
+	assert.Len(t, script.Steps, 6)
+}
+
+func TestPaths_Replay(t *testing.T) {
+	for _, wf := range []*api.Workflow{dau.DirectWorkflow(), dau.PhasedWorkflow()} {
+		t.Run(wf.Name, func(t *testing.T) {
+			paths, err := Paths(wf, 0)
+			require.NoError(t, err)
+
+			sim, err := New(wf)
+			require.NoError(t, err)
+			for i, p := range paths {
+				report, err := sim.Run(p.Script(fmt.Sprintf("path %d", i+1)))
+				require.NoError(t, err)
+				assert.False(t, report.Failed, "path %d failed: %+v", i+1, report.Steps)
+			}
+			// the generated scenarios exercise every transition
+			assert.Empty(t, sim.Coverage().Missed())
+		})
+	}
+}
+
+func TestPaths_Limit(t *testing.T) {
+	_, err := Paths(dau.PhasedWorkflow(), 3)
+	assert.ErrorIs(t, err, ErrTooManyPaths)
+}
@@ -0,0 +1,95 @@
+package simulation
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"fmt"
+
+	"github.com/Southclaws/fault"
+	"github.com/goccy/go-yaml"
+
+	"github.com/siemens/wfx/generated/api"
+)
+
+// Step is a status update sent to wfx on behalf of an actor.
+type Step struct {
+	Actor api.EligibleEnum `json:"actor"`
+	State string           `json:"state"`
+	// Expect is the state the job is expected to be in after the step (optional).
+	Expect string `json:"expect,omitempty"`
+	// Reject indicates that the step is expected to be rejected.
+	Reject bool `json:"reject,omitempty"`
+}
+
+// Script is a sequence of steps applied to a new job.
+type Script struct {
+	Name  string `json:"name,omitempty"`
+	Steps []Step `json:"steps"`
+}
+
+// ParseScript parses a script in YAML or JSON format.
+func ParseScript(raw []byte) (*Script, error) {
+	var script Script
+	if err := yaml.Unmarshal(raw, &script); err != nil {
+		return nil, fault.Wrap(err)
+	}
+	for i, step := range script.Steps {
+		if step.Actor != api.CLIENT && step.Actor != api.WFX {
+			return nil, fmt.Errorf("step %d: invalid actor %q, expected %s or %s", i+1, step.Actor, api.CLIENT, api.WFX)
+		}
+		if step.State == "" {
+			return nil, fmt.Errorf("step %d: state missing", i+1)
+		}
+	}
+	return &script, nil
+}
+
+// StepResult is the outcome of a step.
+type StepResult struct {
+	Step
+	From string `json:"from"`
+	// To is the state of the job after the step.
+	To string `json:"to"`
+	// Error explains why the step was rejected; empty if the step was accepted.
+	Error string `json:"error,omitempty"`
+	// Failed indicates that the outcome does not match the expectations of the step.
+	Failed bool `json:"failed"`
+}
+
+// Report is the outcome of a script.
+type Report struct {
+	Name         string       `json:"name,omitempty"`
+	InitialState string       `json:"initialState"`
+	Steps        []StepResult `json:"steps"`
+	Failed       bool         `json:"failed"`
+}
+
+// Run applies the script to a new job.
+func (s *Simulator) Run(script *Script) (*Report, error) {
+	if err := s.Reset(); err != nil {
+		return nil, err
+	}
+	report := &Report{
+		Name:         script.Name,
+		InitialState: s.State(),
+		Steps:        make([]StepResult, 0, len(script.Steps)),
+	}
+	for _, step := range script.Steps {
+		result := StepResult{Step: step, From: s.State()}
+		to, err := s.Update(step.Actor, step.State)
+		result.To = to
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.Failed = (err != nil) != step.Reject || (step.Expect != "" && step.Expect != to)
+		report.Failed = report.Failed || result.Failed
+		report.Steps = append(report.Steps, result)
+	}
+	return report, nil
+}
@@ -0,0 +1,134 @@
+package simulation
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siemens/wfx/generated/api"
+	internalWorkflow "github.com/siemens/wfx/internal/workflow"
+	"github.com/siemens/wfx/workflow"
+)
+
+// Simulator moves an in-memory job through a workflow using the same rules as wfx: jobs start in the initial state,
+// status updates are checked against the transitions of the workflow and immediate WFX transitions are followed
+// automatically. The transitions taken are recorded in order to compute the coverage.
+type Simulator struct {
+	workflow *api.Workflow
+	state    string
+	// number of times each transition of the workflow was taken, indexed like workflow.Transitions
+	counts []int
+}
+
+// New validates the workflow and creates a simulator with a job in the initial state.
+func New(wf *api.Workflow) (*Simulator, error) {
+	if err := workflow.ValidateWorkflow(wf); err != nil {
+		return nil, fmt.Errorf("invalid workflow: %w", err)
+	}
+	s := &Simulator{
+		workflow: wf,
+		counts:   make([]int, len(wf.Transitions)),
+	}
+	if err := s.Reset(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reset starts over with a new job in the initial state. The coverage is retained.
+func (s *Simulator) Reset() error {
+	initial := internalWorkflow.FindInitialState(s.workflow)
+	if initial == nil {
+		return errors.New("workflow has no initial state")
+	}
+	s.state = s.follow(*initial)
+	return nil
+}
+
+// State returns the current state of the job.
+func (s *Simulator) State() string {
+	return s.state
+}
+
+// Update asks to move the job to the given state on behalf of the actor, exactly like a status update sent to wfx.
+// It returns the resulting state, which differs from the requested one if immediate transitions were followed.
+// If the transition is rejected, the job remains in its current state and the error explains why.
+func (s *Simulator) Update(actor api.EligibleEnum, to string) (string, error) {
+	if err := internalWorkflow.CheckTransition(s.workflow, s.state, to, actor); err != nil {
+		return s.state, err
+	}
+	s.record(s.state, to, actor)
+	s.state = s.follow(to)
+	return s.state, nil
+}
+
+// follow follows the immediate transitions starting at the given state and records them.
+func (s *Simulator) follow(from string) string {
+	path := internalWorkflow.ImmediatePath(s.workflow, from)
+	for i := 1; i < len(path); i++ {
+		s.record(path[i-1], path[i], api.WFX)
+	}
+	return path[len(path)-1]
+}
+
+func (s *Simulator) record(from string, to string, actor api.EligibleEnum) {
+	for i, t := range s.workflow.Transitions {
+		if t.From == from && t.To == to && t.Eligible == actor {
+			s.counts[i]++
+		}
+	}
+}
+
+// TransitionCoverage counts how often a transition was taken.
+type TransitionCoverage struct {
+	api.Transition
+	Count int `json:"count"`
+}
+
+// Coverage summarizes which transitions of the workflow were exercised.
+type Coverage struct {
+	Transitions []TransitionCoverage `json:"transitions"`
+	Covered     int                  `json:"covered"`
+	Total       int                  `json:"total"`
+}
+
+// Percent returns the percentage of transitions which were taken at least once.
+func (c Coverage) Percent() float64 {
+	if c.Total == 0 {
+		return 100
+	}
+	return 100 * float64(c.Covered) / float64(c.Total)
+}
+
+// Missed returns the transitions which were never taken.
+func (c Coverage) Missed() []api.Transition {
+	var result []api.Transition
+	for _, t := range c.Transitions {
+		if t.Count == 0 {
+			result = append(result, t.Transition)
+		}
+	}
+	return result
+}
+
+// Coverage returns the coverage of all jobs simulated so far.
+func (s *Simulator) Coverage() Coverage {
+	result := Coverage{
+		Transitions: make([]TransitionCoverage, 0, len(s.workflow.Transitions)),
+		Total:       len(s.workflow.Transitions),
+	}
+	for i, t := range s.workflow.Transitions {
+		result.Transitions = append(result.Transitions, TransitionCoverage{Transition: t, Count: s.counts[i]})
+		if s.counts[i] > 0 {
+			result.Covered++
+		}
+	}
+	return result
+}
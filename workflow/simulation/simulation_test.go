@@ -0,0 +1,110 @@
+package simulation
+
+/*
+ * SPDX-FileCopyrightText: 2026 Siemens AG
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Author: Michael Adler <michael.adler@siemens.com>
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siemens/wfx/generated/api"
+	"github.com/siemens/wfx/workflow/dau"
+)
+
+func TestSimulator(t *testing.T) {
+	sim, err := New(dau.DirectWorkflow())
+	require.NoError(t, err)
+	assert.Equal(t, "INSTALL", sim.State())
+
+	state, err := sim.Update(api.CLIENT, "INSTALLING")
+	require.NoError(t, err)
+	assert.Equal(t, "INSTALLING", state)
+
+	// progress update
+	state, err = sim.Update(api.CLIENT, "INSTALLING")
+	require.NoError(t, err)
+	assert.Equal(t, "INSTALLING", state)
+
+	// immediate transition INSTALLED -> ACTIVATE
+	state, err = sim.Update(api.CLIENT, "INSTALLED")
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVATE", state)
+
+	state, err = sim.Update(api.WFX, "ACTIVATING")
+	assert.EqualError(t, err, "transition from 'ACTIVATE' to 'ACTIVATING' is not allowed for actor 'WFX'")
+	assert.Equal(t, "ACTIVATE", state)
+
+	_, err = sim.Update(api.CLIENT, "INSTALL")
+	assert.EqualError(t, err, "transition from 'ACTIVATE' to 'INSTALL' does not exist")
+
+	coverage := sim.Coverage()
+	assert.Equal(t, 11, coverage.Total)
+	assert.Equal(t, 4, coverage.Covered)
+	assert.InDelta(t, 36.36, coverage.Percent(), 0.01)
+	assert.Len(t, coverage.Missed(), 7)
+	for _, tc := range coverage.Transitions {
+		if tc.From == "INSTALLING" && tc.To == "INSTALLING" {
+			assert.Equal(t, 1, tc.Count)
+		}
+	}
+}
+
+func TestSimulator_Invalid(t *testing.T) {
+	_, err := New(&api.Workflow{Name: "empty"})
+	assert.ErrorContains(t, err, "invalid workflow")
+}
+
+func TestRun(t *testing.T) {
+	script, err := ParseScript([]byte(`
+name: happy path
+steps:
+  - actor: CLIENT
+    state: INSTALLING
+  - actor: CLIENT
+    state: INSTALLED
+    expect: ACTIVATE
+  - actor: WFX
+    state: ACTIVATING
+    reject: true
+  - actor: CLIENT
+    state: ACTIVATING
+  - actor: CLIENT
+    state: ACTIVATED
+    expect: TERMINATED
+`))
+	require.NoError(t, err)
+
+	sim, err := New(dau.DirectWorkflow())
+	require.NoError(t, err)
+	report, err := sim.Run(script)
+	require.NoError(t, err)
+
+	assert.Equal(t, "happy path", report.Name)
+	assert.Equal(t, "INSTALL", report.InitialState)
+	require.Len(t, report.Steps, 5)
+	assert.Equal(t, StepResult{Step: script.Steps[1], From: "INSTALLING", To: "ACTIVATE"}, report.Steps[1])
+	assert.False(t, report.Steps[2].Failed)
+	assert.NotEmpty(t, report.Steps[2].Error)
+	assert.True(t, report.Steps[4].Failed)
+	assert.True(t, report.Failed)
+
+	// coverage accumulates across runs
+	_, err = sim.Run(&Script{Steps: []Step{{Actor: api.CLIENT, State: "TERMINATED"}}})
+	require.NoError(t, err)
+	assert.Equal(t, 6, sim.Coverage().Covered)
+}
+
+func TestParseScript_Invalid(t *testing.T) {
+	_, err := ParseScript([]byte("steps:\n  - actor: OPERATOR\n    state: FOO\n"))
+	assert.ErrorContains(t, err, `step 1: invalid actor "OPERATOR"`)
+
+	_, err = ParseScript([]byte("steps:\n  - actor: CLIENT\n"))
+	assert.ErrorContains(t, err, "step 1: state missing")
+}